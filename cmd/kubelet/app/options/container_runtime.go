@@ -35,6 +35,7 @@ var (
 // default values.
 func NewContainerRuntimeOptions() *config.ContainerRuntimeOptions {
 	return &config.ContainerRuntimeOptions{
-		PodSandboxImage: defaultPodSandboxImage,
+		PodSandboxImage:                     defaultPodSandboxImage,
+		ImageCredentialProviderConfigStrict: true,
 	}
 }
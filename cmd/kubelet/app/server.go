@@ -94,6 +94,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/legacyscheme"
 	"k8s.io/kubernetes/pkg/capabilities"
 	"k8s.io/kubernetes/pkg/credentialprovider"
+	credentialproviderplugin "k8s.io/kubernetes/pkg/credentialprovider/plugin"
 	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/kubelet"
 	kubeletconfiginternal "k8s.io/kubernetes/pkg/kubelet/apis/config"
@@ -924,6 +925,10 @@ func run(ctx context.Context, s *options.KubeletServer, kubeDeps *kubelet.Depend
 		break
 	}
 
+	// Drain in-flight credential provider plugin invocations so plugin
+	// processes are not orphaned and their on-disk state stays consistent.
+	credentialproviderplugin.Shutdown()
+
 	return nil
 }
 
@@ -1291,6 +1296,7 @@ func createAndInitKubelet(kubeServer *options.KubeletServer,
 		kubeServer.PodLogsDir,
 		kubeServer.ImageCredentialProviderConfigPath,
 		kubeServer.ImageCredentialProviderBinDir,
+		kubeServer.ImageCredentialProviderConfigStrict,
 		kubeServer.RegisterNode,
 		kubeServer.RegisterWithTaints,
 		kubeServer.AllowedUnsafeSysctls,
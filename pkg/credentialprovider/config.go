@@ -54,6 +54,28 @@ type DockerConfigEntry struct {
 	Password string
 	Email    string
 	Provider DockerConfigProvider
+	// Headers are optional HTTP headers to attach to registry requests. They are
+	// not part of the docker config file format and can only originate from exec
+	// credential provider plugin responses.
+	Headers []AuthConfigHeader
+	// CredentialUse lists the purposes the credentials may be used for. It is not
+	// part of the docker config file format and can only originate from exec
+	// credential provider plugin responses. Empty means pull credentials.
+	CredentialUse []AuthConfigCredentialUse
+	// PullEndpoint is an optional registry endpoint to prefer when pulling
+	// images the credentials match, such as a geo-local registry replica. It is
+	// not part of the docker config file format and can only originate from
+	// exec credential provider plugin responses.
+	PullEndpoint string
+	// TLS is optional client TLS material to present to registries requiring
+	// mutual TLS. It is not part of the docker config file format and can only
+	// originate from exec credential provider plugin responses.
+	TLS *AuthConfigTLS
+	// Signing is an optional set of request signing parameters the kubelet
+	// resolves through a registered signer instead of presenting static
+	// credentials. It is not part of the docker config file format and can only
+	// originate from exec credential provider plugin responses.
+	Signing *AuthConfigSigning
 }
 
 var (
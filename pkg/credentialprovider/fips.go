@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialprovider
+
+// fipsApprovedSigningAlgorithms lists the request signing algorithms whose
+// underlying primitives are FIPS-approved. Algorithms may only be added here
+// when every primitive they use (hash, MAC, cipher) is approved under FIPS
+// 140-3; the checksums and cache encryption used elsewhere in the credential
+// subsystem are SHA-256 and AES-256-GCM exclusively and need no gating.
+var fipsApprovedSigningAlgorithms = map[string]bool{
+	// aws-sigv4 derives request signatures with HMAC-SHA256.
+	"aws-sigv4": true,
+	// hmac-sha256 signs requests with a plain HMAC-SHA256 over the request.
+	"hmac-sha256": true,
+}
+
+// StrictFIPSMode reports whether the kubelet was built for strict FIPS
+// compatibility, which kubelet FIPS builds select with
+// GOEXPERIMENT=boringcrypto (carrying the goexperiment.boringcrypto build
+// tag). In strict mode the credential subsystem refuses signing algorithms
+// whose primitives are not FIPS-approved, both at signer registration and
+// when a plugin response requests one.
+func StrictFIPSMode() bool {
+	return strictFIPSMode
+}
+
+// FIPSApprovedSigningAlgorithm reports whether the given request signing
+// algorithm only uses FIPS-approved primitives and may be used in strict
+// FIPS mode.
+func FIPSApprovedSigningAlgorithm(algorithm string) bool {
+	return fipsApprovedSigningAlgorithms[algorithm]
+}
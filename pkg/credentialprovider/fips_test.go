@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialprovider
+
+import (
+	"testing"
+)
+
+// withStrictFIPSMode forces the strict FIPS mode for the duration of a test,
+// regardless of the build tags the test binary was built with.
+func withStrictFIPSMode(t *testing.T, strict bool) {
+	t.Helper()
+	previous := strictFIPSMode
+	strictFIPSMode = strict
+	t.Cleanup(func() { strictFIPSMode = previous })
+}
+
+func TestFIPSApprovedSigningAlgorithm(t *testing.T) {
+	for _, algorithm := range []string{"aws-sigv4", "hmac-sha256"} {
+		if !FIPSApprovedSigningAlgorithm(algorithm) {
+			t.Errorf("expected %q to be FIPS-approved", algorithm)
+		}
+	}
+	for _, algorithm := range []string{"", "hmac-md5", "custom-algorithm"} {
+		if FIPSApprovedSigningAlgorithm(algorithm) {
+			t.Errorf("expected %q not to be FIPS-approved", algorithm)
+		}
+	}
+}
+
+func TestRegisterRequestSignerStrictFIPSMode(t *testing.T) {
+	withStrictFIPSMode(t, true)
+
+	RegisterRequestSigner("hmac-md5", &fakeRegistryRequestSigner{token: "refused"})
+	if signer := RequestSignerFor("hmac-md5"); signer != nil {
+		t.Errorf("expected registration of a non-approved algorithm to be refused in strict FIPS mode, got %v", signer)
+	}
+
+	approved := &fakeRegistryRequestSigner{token: "approved"}
+	RegisterRequestSigner("aws-sigv4", approved)
+	if signer := RequestSignerFor("aws-sigv4"); signer != approved {
+		t.Errorf("expected registration of an approved algorithm to succeed in strict FIPS mode, got %v", signer)
+	}
+}
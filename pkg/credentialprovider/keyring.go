@@ -20,6 +20,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"net"
 	"net/url"
 	"path/filepath"
@@ -88,6 +89,12 @@ func NewTrackedAuthConfig(c *AuthConfig, src *CredentialSource) *TrackedAuthConf
 
 type CredentialSource struct {
 	Secret SecretCoordinates
+	// Provider is the name of the credential provider plugin the credential was
+	// obtained from, or empty when the credential came from another source.
+	Provider string
+	// ServiceAccount is set when the credential was obtained from a credential
+	// provider plugin using the identity of the pod's service account.
+	ServiceAccount *ServiceAccountCoordinates
 }
 
 type SecretCoordinates struct {
@@ -96,6 +103,12 @@ type SecretCoordinates struct {
 	Name      string
 }
 
+type ServiceAccountCoordinates struct {
+	UID       string
+	Namespace string
+	Name      string
+}
+
 // AuthConfig contains authorization information for connecting to a Registry
 // This type mirrors "github.com/docker/docker/api/types.AuthConfig"
 type AuthConfig struct {
@@ -116,6 +129,88 @@ type AuthConfig struct {
 
 	// RegistryToken is a bearer token to be sent to a registry
 	RegistryToken string `json:"registrytoken,omitempty"`
+
+	// Headers are optional HTTP headers to attach to registry requests. They are
+	// not part of the docker config file format and can only originate from exec
+	// credential provider plugin responses.
+	Headers []AuthConfigHeader `json:"headers,omitempty"`
+
+	// CredentialUse lists the purposes the credentials may be used for. It is not
+	// part of the docker config file format and can only originate from exec
+	// credential provider plugin responses. Empty means pull credentials.
+	CredentialUse []AuthConfigCredentialUse `json:"credentialUse,omitempty"`
+
+	// PullEndpoint is an optional registry endpoint to prefer when pulling
+	// images the credentials match, such as a geo-local registry replica. It is
+	// not part of the docker config file format and can only originate from
+	// exec credential provider plugin responses.
+	PullEndpoint string `json:"pullendpoint,omitempty"`
+
+	// TLS is optional client TLS material to present to registries requiring
+	// mutual TLS. It is not part of the docker config file format and can only
+	// originate from exec credential provider plugin responses.
+	TLS *AuthConfigTLS `json:"tls,omitempty"`
+
+	// Signing is an optional set of request signing parameters the kubelet
+	// resolves through a registered signer instead of presenting static
+	// credentials. It is not part of the docker config file format and can only
+	// originate from exec credential provider plugin responses.
+	Signing *AuthConfigSigning `json:"signing,omitempty"`
+}
+
+// AuthConfigTLS is client TLS material presented to a registry requiring
+// mutual TLS, given either inline as PEM or as paths to files on the node.
+type AuthConfigTLS struct {
+	ClientCertificate     string `json:"clientCertificate,omitempty"`
+	ClientKey             string `json:"clientKey,omitempty" datapolicy:"security-key"`
+	ClientCertificateFile string `json:"clientCertificateFile,omitempty"`
+	ClientKeyFile         string `json:"clientKeyFile,omitempty"`
+}
+
+// AuthConfigSigning is a set of request signing parameters for registries that
+// require each request to be signed instead of accepting static credentials.
+type AuthConfigSigning struct {
+	// Algorithm identifies the signing scheme, for example "aws-sigv4". The
+	// kubelet must have a signer registered for the algorithm for the
+	// credential to be usable.
+	Algorithm string `json:"algorithm"`
+	// KeyReference names the signing key the signer should use, interpreted
+	// per algorithm.
+	KeyReference string `json:"keyReference,omitempty"`
+}
+
+// AuthConfigHeader is a single HTTP header attached to registry requests.
+type AuthConfigHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// AuthConfigCredentialUse is a purpose credentials may be used for.
+type AuthConfigCredentialUse string
+
+const (
+	// PullAuthConfigCredentialUse means the credentials may be presented to the
+	// image registry to pull image content.
+	PullAuthConfigCredentialUse AuthConfigCredentialUse = "Pull"
+	// SignatureFetchAuthConfigCredentialUse means the credentials may be presented
+	// when fetching image signatures or attestations, which may be served from a
+	// different endpoint than the image registry.
+	SignatureFetchAuthConfigCredentialUse AuthConfigCredentialUse = "SignatureFetch"
+)
+
+// UsableFor reports whether the credentials may be used for the given purpose.
+// Credentials that do not declare any uses are pull credentials.
+func (a AuthConfig) UsableFor(use AuthConfigCredentialUse) bool {
+	if len(a.CredentialUse) == 0 {
+		return use == PullAuthConfigCredentialUse
+	}
+
+	for _, u := range a.CredentialUse {
+		if u == use {
+			return true
+		}
+	}
+	return false
 }
 
 // Add inserts the docker config `cfg` into the basic docker keyring. It attaches
@@ -128,9 +223,14 @@ func (dk *BasicDockerKeyring) Add(src *CredentialSource, cfg DockerConfig) {
 	}
 	for loc, ident := range cfg {
 		creds := AuthConfig{
-			Username: ident.Username,
-			Password: ident.Password,
-			Email:    ident.Email,
+			Username:      ident.Username,
+			Password:      ident.Password,
+			Email:         ident.Email,
+			Headers:       ident.Headers,
+			CredentialUse: ident.CredentialUse,
+			PullEndpoint:  ident.PullEndpoint,
+			TLS:           ident.TLS,
+			Signing:       ident.Signing,
 		}
 
 		value := loc
@@ -153,11 +253,12 @@ func (dk *BasicDockerKeyring) Add(src *CredentialSource, cfg DockerConfig) {
 		if strings.HasPrefix(effectivePath, "/v2/") || strings.HasPrefix(effectivePath, "/v1/") {
 			effectivePath = effectivePath[3:]
 		}
+		host := normalizeRegistryHost(CanonicalizeRegistryHost(parsed.Host))
 		var key string
 		if (len(effectivePath) > 0) && (effectivePath != "/") {
-			key = parsed.Host + effectivePath
+			key = host + effectivePath
 		} else {
-			key = parsed.Host
+			key = host
 		}
 		trackedCreds := NewTrackedAuthConfig(&creds, src)
 
@@ -169,10 +270,29 @@ func (dk *BasicDockerKeyring) Add(src *CredentialSource, cfg DockerConfig) {
 	dk.index = eliminateDupes.List()
 
 	// Update the index used to identify which credentials to use for a given
-	// image. The index is reverse-sorted so more specific paths are matched
-	// first. For example, if for the given image "gcr.io/etcd-development/etcd",
-	// credentials for "quay.io/coreos" should match before "quay.io".
-	sort.Sort(sort.Reverse(sort.StringSlice(dk.index)))
+	// image. Keys with deeper repository paths come first so the most
+	// specifically scoped credentials are attempted before broader ones
+	// (longest prefix wins), e.g. "registry.io/team-a/*" before
+	// "registry.io/*" even when the hosts differ lexicographically. Ties are
+	// broken by reverse lexicographic order so longer keys and non-wildcard
+	// keys with the same prefix keep their historical precedence.
+	sort.Slice(dk.index, func(i, j int) bool {
+		di, dj := keyPathDepth(dk.index[i]), keyPathDepth(dk.index[j])
+		if di != dj {
+			return di > dj
+		}
+		return dk.index[i] > dk.index[j]
+	})
+}
+
+// keyPathDepth returns the number of repository path segments of an index key,
+// after the registry host.
+func keyPathDepth(key string) int {
+	_, keyPath, ok := strings.Cut(key, "/")
+	if !ok {
+		return 0
+	}
+	return len(splitPath(keyPath))
 }
 
 const (
@@ -207,16 +327,41 @@ func isDefaultRegistryMatch(image string) bool {
 	return !strings.ContainsAny(parts[0], ".:")
 }
 
+// bracketIPv6Host wraps an unbracketed IPv6 literal host in the square
+// brackets URL syntax requires, so "2001:db8::1/repo" parses the same as
+// "[2001:db8::1]/repo". A literal carrying a port must already be bracketed,
+// as the port would otherwise be indistinguishable from the final address
+// group of the literal.
+func bracketIPv6Host(schemelessURL string) string {
+	host, path, hasPath := strings.Cut(schemelessURL, "/")
+	if strings.HasPrefix(host, "[") || !strings.Contains(host, ":") {
+		return schemelessURL
+	}
+	if ip := net.ParseIP(host); ip == nil || ip.To4() != nil {
+		return schemelessURL
+	}
+	if hasPath {
+		return "[" + host + "]/" + path
+	}
+	return "[" + host + "]"
+}
+
 // ParseSchemelessURL parses a schemeless url and returns a url.URL
 // url.Parse require a scheme, but ours don't have schemes.  Adding a
 // scheme to make url.Parse happy, then clear out the resulting scheme.
+// IPv6 literal hosts are accepted both bracketed and unbracketed and
+// canonicalize to the same bracketed spelling.
 func ParseSchemelessURL(schemelessURL string) (*url.URL, error) {
-	parsed, err := url.Parse("https://" + schemelessURL)
+	parsed, err := url.Parse("https://" + bracketIPv6Host(schemelessURL))
 	if err != nil {
 		return nil, err
 	}
 	// clear out the resulting scheme
 	parsed.Scheme = ""
+	// Canonicalize the host so spellings of the same registry endpoint compare
+	// equal, e.g. "Registry.io:443" against "registry.io" or "[2001:DB8::1]"
+	// against "[2001:db8:0:0::1]".
+	parsed.Host = CanonicalizeRegistryHost(parsed.Host)
 	return parsed, nil
 }
 
@@ -227,6 +372,10 @@ func SplitURL(url *url.URL) (parts []string, port string) {
 		// could not parse port
 		host, port = url.Host, ""
 	}
+	// Strip the brackets of IPv6 literals without a port (SplitHostPort strips
+	// them when a port is present) so the literal does not read as a character
+	// class when host parts are glob-matched.
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
 	return strings.Split(host, "."), port
 }
 
@@ -244,14 +393,21 @@ func URLsMatchStr(glob string, target string) (bool, error) {
 }
 
 // URLsMatch checks whether the given target url matches the glob url, which may have
-// glob wild cards in the host name.
+// glob wild cards in the host name and path.
 //
 // Examples:
 //
-//	globURL=*.docker.io, targetURL=blah.docker.io => match
-//	globURL=*.docker.io, targetURL=not.right.io   => no match
+//	globURL=*.docker.io, targetURL=blah.docker.io       => match
+//	globURL=*.docker.io, targetURL=not.right.io         => no match
+//	globURL=registry.io/*, targetURL=registry.io/a      => match
+//	globURL=registry.io/*, targetURL=registry.io/a/b    => no match
+//	globURL=registry.io/**, targetURL=registry.io/a/b   => match
 //
-// Note that we don't support wildcards in ports and paths yet.
+// Paths without wild cards keep the historical prefix-matching semantics. Paths
+// with wild cards are matched segment by segment: "*" matches exactly one path
+// segment and "**", which may only appear as the final segment, matches any
+// number of remaining segments, including none. Wildcards in ports are not
+// supported.
 func URLsMatch(globURL *url.URL, targetURL *url.URL) (bool, error) {
 	globURLParts, globPort := SplitURL(globURL)
 	targetURLParts, targetPort := SplitURL(targetURL)
@@ -263,9 +419,8 @@ func URLsMatch(globURL *url.URL, targetURL *url.URL) (bool, error) {
 		// host name does not have the same number of parts
 		return false, nil
 	}
-	if !strings.HasPrefix(targetURL.Path, globURL.Path) {
-		// the path of the credential must be a prefix
-		return false, nil
+	if matched, err := pathMatches(globURL.Path, targetURL.Path); err != nil || !matched {
+		return false, err
 	}
 	for k, globURLPart := range globURLParts {
 		targetURLPart := targetURLParts[k]
@@ -282,10 +437,78 @@ func URLsMatch(globURL *url.URL, targetURL *url.URL) (bool, error) {
 	return true, nil
 }
 
+// pathMatches reports whether the target path matches the glob path using the
+// semantics documented on URLsMatch.
+func pathMatches(globPath, targetPath string) (bool, error) {
+	if !strings.ContainsAny(globPath, "*?[") {
+		// the path of the credential must be a prefix
+		return strings.HasPrefix(targetPath, globPath), nil
+	}
+
+	globParts := splitPath(globPath)
+	targetParts := splitPath(targetPath)
+
+	for i, globPart := range globParts {
+		if globPart == "**" {
+			if i != len(globParts)-1 {
+				return false, fmt.Errorf("%q is only supported as the final path segment", "**")
+			}
+			return true, nil
+		}
+		if i >= len(targetParts) {
+			return false, nil
+		}
+		matched, err := filepath.Match(globPart, targetParts[i])
+		if err != nil || !matched {
+			return matched, err
+		}
+	}
+
+	return len(globParts) == len(targetParts), nil
+}
+
+// splitPath splits a URL path into its segments, ignoring leading and trailing
+// slashes. An empty or root path has no segments.
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// ValidateMatchImagePattern checks that a matchImages pattern is parseable and
+// uses supported wildcard semantics: "**" must be a full path segment and may
+// only appear as the final segment of the pattern.
+func ValidateMatchImagePattern(pattern string) error {
+	parsed, err := ParseSchemelessURL(pattern)
+	if err != nil {
+		return err
+	}
+
+	segments := splitPath(parsed.Path)
+	for i, segment := range segments {
+		if segment == "**" {
+			if i != len(segments)-1 {
+				return fmt.Errorf("%q is only supported as the final path segment", "**")
+			}
+			continue
+		}
+		if strings.Contains(segment, "**") {
+			return fmt.Errorf("%q must be a full path segment", "**")
+		}
+	}
+	return nil
+}
+
 // Lookup implements the DockerKeyring method for fetching credentials based on image name.
 // Multiple credentials may be returned if there are multiple potentially valid credentials
 // available.  This allows for rotation.
 func (dk *BasicDockerKeyring) Lookup(image string) ([]TrackedAuthConfig, bool) {
+	// Known Docker Hub hostname aliases are rewritten to the canonical host before
+	// matching when canonicalization is enabled.
+	image = normalizeImageHost(image)
+
 	// range over the index as iterating over a map does not provide a predictable ordering
 	ret := []TrackedAuthConfig{}
 	for _, k := range dk.index {
@@ -119,6 +119,49 @@ func TestURLsMatch(t *testing.T) {
 			targetURL:     "quay.io",
 			matchExpected: false,
 		},
+		// single-segment path wildcard
+		{
+			globURL:       "registry.io/*",
+			targetURL:     "registry.io/foo",
+			matchExpected: true,
+		},
+		{
+			globURL:       "registry.io/*",
+			targetURL:     "registry.io/foo/bar",
+			matchExpected: false,
+		},
+		{
+			globURL:       "registry.io/foo/*",
+			targetURL:     "registry.io/foo/bar",
+			matchExpected: true,
+		},
+		// multi-segment path wildcard
+		{
+			globURL:       "registry.io/**",
+			targetURL:     "registry.io/foo/bar/baz",
+			matchExpected: true,
+		},
+		{
+			globURL:       "registry.io/**",
+			targetURL:     "registry.io",
+			matchExpected: true,
+		},
+		{
+			globURL:       "registry.io/foo/**",
+			targetURL:     "registry.io/foo/bar/baz",
+			matchExpected: true,
+		},
+		{
+			globURL:       "registry.io/foo/**",
+			targetURL:     "registry.io/other/bar",
+			matchExpected: false,
+		},
+		// "**" is only supported as the final path segment
+		{
+			globURL:       "registry.io/**/foo",
+			targetURL:     "registry.io/bar/foo",
+			matchExpected: false,
+		},
 		// no match when ports mismatch
 		{
 			globURL:       "*.kubernetes.io:1234/blah",
@@ -130,6 +173,80 @@ func TestURLsMatch(t *testing.T) {
 			targetURL:     "prefix.kubernetes.io:1111/foo/bar",
 			matchExpected: false,
 		},
+		// hosts are canonicalized before matching: case, the default port 443
+		// and IPv6 literal spellings do not affect the result
+		{
+			globURL:       "Registry.IO/foo",
+			targetURL:     "registry.io/foo",
+			matchExpected: true,
+		},
+		{
+			globURL:       "registry.io:443/foo",
+			targetURL:     "registry.io/foo",
+			matchExpected: true,
+		},
+		{
+			globURL:       "registry.io/foo",
+			targetURL:     "registry.io:443/foo",
+			matchExpected: true,
+		},
+		{
+			globURL:       "registry.io:443/foo",
+			targetURL:     "registry.io:5000/foo",
+			matchExpected: false,
+		},
+		{
+			globURL:       "[::1]/foo",
+			targetURL:     "[::1]/foo",
+			matchExpected: true,
+		},
+		{
+			globURL:       "[::1]:5000/foo",
+			targetURL:     "[0:0:0:0:0:0:0:1]:5000/foo",
+			matchExpected: true,
+		},
+		{
+			globURL:       "[2001:db8::1]:443/foo",
+			targetURL:     "[2001:DB8:0:0::1]/foo",
+			matchExpected: true,
+		},
+		{
+			globURL:       "[::1]:5000/foo",
+			targetURL:     "[::2]:5000/foo",
+			matchExpected: false,
+		},
+		{
+			globURL:       "[::1]:5000/foo",
+			targetURL:     "[::1]:1111/foo",
+			matchExpected: false,
+		},
+		// unbracketed IPv6 literals canonicalize to the bracketed spelling
+		{
+			globURL:       "2001:db8::1/foo",
+			targetURL:     "[2001:db8::1]/foo",
+			matchExpected: true,
+		},
+		{
+			globURL:       "[2001:db8::1]:5000/foo",
+			targetURL:     "[2001:DB8:0:0::1]:5000/foo/bar",
+			matchExpected: true,
+		},
+		{
+			globURL:       "2001:db8::1/foo",
+			targetURL:     "2001:db8:0:0::1/foo",
+			matchExpected: true,
+		},
+		{
+			globURL:       "2001:db8::1/foo",
+			targetURL:     "2001:db8::2/foo",
+			matchExpected: false,
+		},
+		// an IPv6 literal host never matches a hostname pattern
+		{
+			globURL:       "*.registry.io/foo",
+			targetURL:     "[2001:db8::1]/foo",
+			matchExpected: false,
+		},
 	}
 	for _, test := range tests {
 		matched, _ := URLsMatchStr(test.globURL, test.targetURL)
@@ -569,6 +686,50 @@ func TestDockerKeyringLookup(t *testing.T) {
 	}
 }
 
+func TestDockerKeyringLookupRepositoryScoped(t *testing.T) {
+	dk := &BasicDockerKeyring{}
+	dk.Add(nil, DockerConfig{
+		"registry.io/team-a/*": DockerConfigEntry{Username: "team-a"},
+		"registry.io/**":       DockerConfigEntry{Username: "catch-all"},
+		// A deeper path on a host glob must still win over a shallower path on
+		// a concrete host.
+		"*.mirrors.io/team-b/**":  DockerConfigEntry{Username: "team-b"},
+		"prod.mirrors.io/**":      DockerConfigEntry{Username: "mirror-all"},
+		"registry.io/team-a/sub/": DockerConfigEntry{Username: "team-a-sub"},
+	})
+
+	tests := []struct {
+		image     string
+		usernames []string
+	}{
+		// repository-scoped key is attempted before the registry-wide one
+		{"registry.io/team-a/app", []string{"team-a", "catch-all"}},
+
+		// images outside the scoped namespace only match the registry-wide key
+		{"registry.io/team-b/app", []string{"catch-all"}},
+
+		// the deepest matching path wins regardless of host glob or key order
+		{"registry.io/team-a/sub/app", []string{"team-a-sub", "catch-all"}},
+		{"prod.mirrors.io/team-b/app", []string{"team-b", "mirror-all"}},
+	}
+
+	for i, tt := range tests {
+		match, ok := dk.Lookup(tt.image)
+		if !ok {
+			t.Errorf("case %d: expected a match for %s", i, tt.image)
+			continue
+		}
+
+		usernames := make([]string, 0, len(match))
+		for _, m := range match {
+			usernames = append(usernames, m.Username)
+		}
+		if !reflect.DeepEqual(tt.usernames, usernames) {
+			t.Errorf("case %d: expected credentials in order %v, got %v", i, tt.usernames, usernames)
+		}
+	}
+}
+
 // This validates that dockercfg entries with a scheme and url path are properly matched
 // by images that only match the hostname.
 // NOTE: the above covers the case of a more specific match trumping just hostname.
@@ -615,3 +776,44 @@ func TestIssue3797(t *testing.T) {
 		}
 	}
 }
+
+func TestAuthConfigUsableFor(t *testing.T) {
+	tests := []struct {
+		name          string
+		credentialUse []AuthConfigCredentialUse
+		use           AuthConfigCredentialUse
+		want          bool
+	}{
+		{
+			name: "no declared uses are pull credentials",
+			use:  PullAuthConfigCredentialUse,
+			want: true,
+		},
+		{
+			name: "no declared uses are not signature fetch credentials",
+			use:  SignatureFetchAuthConfigCredentialUse,
+			want: false,
+		},
+		{
+			name:          "signature fetch only is not usable for pulls",
+			credentialUse: []AuthConfigCredentialUse{SignatureFetchAuthConfigCredentialUse},
+			use:           PullAuthConfigCredentialUse,
+			want:          false,
+		},
+		{
+			name:          "declared use matches",
+			credentialUse: []AuthConfigCredentialUse{PullAuthConfigCredentialUse, SignatureFetchAuthConfigCredentialUse},
+			use:           SignatureFetchAuthConfigCredentialUse,
+			want:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := AuthConfig{CredentialUse: tt.credentialUse}
+			if got := cfg.UsableFor(tt.use); got != tt.want {
+				t.Errorf("UsableFor(%q) = %t, want %t", tt.use, got, tt.want)
+			}
+		})
+	}
+}
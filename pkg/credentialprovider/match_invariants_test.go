@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialprovider
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// These tests pin down invariants of the matchImages pattern semantics rather
+// than individual examples, so a matcher redesign that accidentally changes
+// the semantics fails loudly instead of only on the cases someone thought to
+// enumerate.
+
+// Test_URLsMatchStr_defaultPortNormalization asserts that matching is
+// invariant to spelling out the default registry port: ":443" on either the
+// pattern or the target names the same endpoint as the bare host.
+func Test_URLsMatchStr_defaultPortNormalization(t *testing.T) {
+	testcases := []struct {
+		glob   string
+		target string
+	}{
+		{glob: "registry.io", target: "registry.io"},
+		{glob: "*.registry.io", target: "test.registry.io"},
+		{glob: "registry.io/path", target: "registry.io/path/to/repo"},
+		{glob: "registry.io/*", target: "registry.io/repo"},
+		{glob: "registry.io", target: "other.io"},
+		{glob: "[2001:db8::1]", target: "[2001:DB8:0:0::1]"},
+	}
+
+	withDefaultPort := func(s string) string {
+		host, path, hasPath := strings.Cut(s, "/")
+		if hasPath {
+			return host + ":443/" + path
+		}
+		return host + ":443"
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.glob+" vs "+tc.target, func(t *testing.T) {
+			base, err := URLsMatchStr(tc.glob, tc.target)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, variant := range []struct {
+				glob   string
+				target string
+			}{
+				{glob: withDefaultPort(tc.glob), target: tc.target},
+				{glob: tc.glob, target: withDefaultPort(tc.target)},
+				{glob: withDefaultPort(tc.glob), target: withDefaultPort(tc.target)},
+			} {
+				got, err := URLsMatchStr(variant.glob, variant.target)
+				if err != nil {
+					t.Fatalf("unexpected error matching %q against %q: %v", variant.glob, variant.target, err)
+				}
+				if got != base {
+					t.Errorf("expected %q vs %q to match the same as the bare spelling (%v), got %v", variant.glob, variant.target, base, got)
+				}
+			}
+		})
+	}
+}
+
+// Test_URLsMatchStr_wildcardBoundaries asserts that a single wildcard never
+// crosses a separator: "*" in the host matches exactly one subdomain segment
+// and "*" in the path exactly one path segment; only "**" as the final path
+// segment may span multiple segments.
+func Test_URLsMatchStr_wildcardBoundaries(t *testing.T) {
+	// The invariant is asserted over every pairing of these segments rather
+	// than a single hand-picked example.
+	segments := []string{"a", "repo", "team-a", "x9", "deep"}
+
+	for _, first := range segments {
+		for _, second := range segments {
+			t.Run(first+"/"+second, func(t *testing.T) {
+				// Host: one glob segment must not swallow two subdomains.
+				if matched, err := URLsMatchStr("*.registry.io", fmt.Sprintf("%s.%s.registry.io", first, second)); err != nil || matched {
+					t.Errorf("expected host wildcard not to cross subdomain separators, got matched=%v err=%v", matched, err)
+				}
+				if matched, err := URLsMatchStr("*.registry.io", first+".registry.io"); err != nil || !matched {
+					t.Errorf("expected host wildcard to match a single subdomain, got matched=%v err=%v", matched, err)
+				}
+
+				// Path: "*" matches exactly one segment, "**" any remainder.
+				if matched, err := URLsMatchStr("registry.io/*", fmt.Sprintf("registry.io/%s/%s", first, second)); err != nil || matched {
+					t.Errorf("expected path wildcard not to cross path separators, got matched=%v err=%v", matched, err)
+				}
+				if matched, err := URLsMatchStr("registry.io/*", "registry.io/"+first); err != nil || !matched {
+					t.Errorf("expected path wildcard to match a single segment, got matched=%v err=%v", matched, err)
+				}
+				if matched, err := URLsMatchStr("registry.io/**", fmt.Sprintf("registry.io/%s/%s", first, second)); err != nil || !matched {
+					t.Errorf("expected trailing double wildcard to span segments, got matched=%v err=%v", matched, err)
+				}
+			})
+		}
+	}
+}
+
+// FuzzURLsMatchStr asserts that matching arbitrary pattern/target pairs never
+// panics, is deterministic, and stays invariant to default-port spelling.
+func FuzzURLsMatchStr(f *testing.F) {
+	f.Add("*.docker.io", "blah.docker.io")
+	f.Add("registry.io/*", "registry.io/a/b")
+	f.Add("registry.io/**", "registry.io/a/b")
+	f.Add("registry.io:8080/path", "registry.io:8080/path/to/repo")
+	f.Add("[2001:db8::1]:443", "[2001:DB8:0:0::1]")
+	f.Add("k8s.*", "k8s.io")
+
+	f.Fuzz(func(t *testing.T, glob, target string) {
+		matched, err := URLsMatchStr(glob, target)
+
+		// Matching must be deterministic.
+		matchedAgain, errAgain := URLsMatchStr(glob, target)
+		if matched != matchedAgain || (err == nil) != (errAgain == nil) {
+			t.Errorf("matching %q against %q is not deterministic: (%v, %v) then (%v, %v)", glob, target, matched, err, matchedAgain, errAgain)
+		}
+		if err != nil {
+			return
+		}
+
+		// Spelling out the default port on a portless target must not change
+		// the result.
+		targetURL, parseErr := ParseSchemelessURL(target)
+		if parseErr != nil || len(targetURL.Host) == 0 {
+			return
+		}
+		if _, port := SplitURL(targetURL); len(port) > 0 {
+			return
+		}
+		withPort := targetURL.Host + ":443" + targetURL.Path
+		matchedWithPort, err := URLsMatchStr(glob, withPort)
+		if err != nil {
+			return
+		}
+		if matched != matchedWithPort {
+			t.Errorf("matching %q against %q (%v) differs from %q (%v)", glob, target, matched, withPort, matchedWithPort)
+		}
+	})
+}
+
+// referencePathMatches is a direct transcription of the path matching
+// semantics documented on URLsMatch, maintained independently of the
+// production matcher. The differential fuzz target below compares the two, so
+// a matcher redesign that drifts from the documented semantics is flagged on
+// the exact diverging input.
+func referencePathMatches(globPath, targetPath string) (bool, error) {
+	if !strings.ContainsAny(globPath, "*?[") {
+		return strings.HasPrefix(targetPath, globPath), nil
+	}
+
+	globParts := splitPath(globPath)
+	targetParts := splitPath(targetPath)
+
+	for i := 0; ; i++ {
+		switch {
+		case i == len(globParts):
+			return i == len(targetParts), nil
+		case globParts[i] == "**":
+			if i != len(globParts)-1 {
+				return false, fmt.Errorf("%q is only supported as the final path segment", "**")
+			}
+			return true, nil
+		case i == len(targetParts):
+			return false, nil
+		}
+		matched, err := filepath.Match(globParts[i], targetParts[i])
+		if err != nil || !matched {
+			return matched, err
+		}
+	}
+}
+
+// FuzzPathMatchesDifferential compares the production path matcher against
+// the independent reference transcription on arbitrary inputs.
+func FuzzPathMatchesDifferential(f *testing.F) {
+	f.Add("/path/to/repo", "/path/to/repo/image")
+	f.Add("/*", "/a")
+	f.Add("/*", "/a/b")
+	f.Add("/**", "/a/b")
+	f.Add("/**/x", "/a/x")
+	f.Add("/team-*/repo", "/team-a/repo")
+	f.Add("", "/anything")
+
+	f.Fuzz(func(t *testing.T, globPath, targetPath string) {
+		matched, err := pathMatches(globPath, targetPath)
+		expected, expectedErr := referencePathMatches(globPath, targetPath)
+		if matched != expected || (err == nil) != (expectedErr == nil) {
+			t.Errorf("pathMatches(%q, %q) = (%v, %v), reference semantics say (%v, %v)", globPath, targetPath, matched, err, expected, expectedErr)
+		}
+	})
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialprovider
+
+import (
+	"net"
+	"strings"
+	"sync"
+)
+
+// RegistryAliasBehavior controls how the hostnames Docker Hub is known by are
+// normalized before credential matching. Docker Hub is reachable as docker.io,
+// index.docker.io and registry-1.docker.io, which frequently breaks matchImages
+// configurations that only list one of the hostnames.
+type RegistryAliasBehavior string
+
+const (
+	// RegistryAliasPreserve matches image references against credential keys exactly
+	// as configured, without rewriting Docker Hub hostnames. This is the library
+	// default and matches the historical behavior.
+	RegistryAliasPreserve RegistryAliasBehavior = "Preserve"
+	// RegistryAliasCanonicalize rewrites the known Docker Hub hostnames (docker.io,
+	// registry-1.docker.io) to the canonical index.docker.io host before matching,
+	// both for configured credential keys and for looked-up image references.
+	RegistryAliasCanonicalize RegistryAliasBehavior = "Canonicalize"
+)
+
+// defaultRegistryAliases maps the hostnames Docker Hub is known by to the
+// canonical default registry host.
+var defaultRegistryAliases = map[string]string{
+	"docker.io":            defaultRegistryHost,
+	"registry-1.docker.io": defaultRegistryHost,
+}
+
+var (
+	registryAliasMutex    sync.RWMutex
+	registryAliasBehavior = RegistryAliasPreserve
+)
+
+// SetRegistryAliasBehavior configures how Docker Hub hostnames are normalized before
+// credential matching. It is intended to be called once during process setup, before
+// keyrings are constructed.
+func SetRegistryAliasBehavior(behavior RegistryAliasBehavior) {
+	registryAliasMutex.Lock()
+	defer registryAliasMutex.Unlock()
+	registryAliasBehavior = behavior
+}
+
+// getRegistryAliasBehavior returns the configured Docker Hub alias behavior.
+func getRegistryAliasBehavior() RegistryAliasBehavior {
+	registryAliasMutex.RLock()
+	defer registryAliasMutex.RUnlock()
+	return registryAliasBehavior
+}
+
+// normalizeRegistryHost rewrites the known Docker Hub hostnames to the canonical
+// default registry host when canonicalization is enabled. All other hostnames are
+// returned unchanged.
+func normalizeRegistryHost(host string) string {
+	if getRegistryAliasBehavior() != RegistryAliasCanonicalize {
+		return host
+	}
+
+	if canonical, ok := defaultRegistryAliases[host]; ok {
+		return canonical
+	}
+
+	return host
+}
+
+// CanonicalizeRegistryHost returns the canonical form of a registry host, so
+// hosts that differ only in spelling compare equal during credential matching:
+// hostnames are lowercased, the default registry port 443 is stripped, and
+// IPv6 literals are rewritten to their canonical textual form, e.g.
+// "[2001:DB8:0:0::1]:443" becomes "[2001:db8::1]". Hosts containing wildcard
+// characters pass through with only lowercasing applied. The repository path
+// is not part of the host and must be split off by the caller.
+func CanonicalizeRegistryHost(host string) string {
+	hostname, port, err := net.SplitHostPort(host)
+	if err != nil {
+		// No port, or an IPv6 literal without one.
+		hostname, port = host, ""
+	}
+	hostname = strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(hostname, "["), "]"))
+	if strings.Contains(hostname, ":") {
+		if ip := net.ParseIP(hostname); ip != nil {
+			hostname = ip.String()
+		}
+		hostname = "[" + hostname + "]"
+	}
+	// Registries are served over HTTPS, so an explicit :443 names the same
+	// endpoint as the bare host.
+	if port == "443" {
+		port = ""
+	}
+	if port != "" {
+		return hostname + ":" + port
+	}
+	return hostname
+}
+
+// normalizeImageHost rewrites the registry hostname portion of an image reference
+// when canonicalization is enabled, leaving the repository path untouched.
+func normalizeImageHost(image string) string {
+	parts := strings.SplitN(image, "/", 2)
+	normalized := normalizeRegistryHost(parts[0])
+	if normalized == parts[0] {
+		return image
+	}
+
+	if len(parts) == 1 {
+		return normalized
+	}
+
+	return normalized + "/" + parts[1]
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialprovider
+
+import (
+	"testing"
+)
+
+func TestNormalizeImageHost(t *testing.T) {
+	testcases := []struct {
+		name     string
+		behavior RegistryAliasBehavior
+		image    string
+		expected string
+	}{
+		{
+			name:     "preserve leaves docker.io untouched",
+			behavior: RegistryAliasPreserve,
+			image:    "docker.io/library/busybox",
+			expected: "docker.io/library/busybox",
+		},
+		{
+			name:     "canonicalize rewrites docker.io",
+			behavior: RegistryAliasCanonicalize,
+			image:    "docker.io/library/busybox",
+			expected: "index.docker.io/library/busybox",
+		},
+		{
+			name:     "canonicalize rewrites registry-1.docker.io",
+			behavior: RegistryAliasCanonicalize,
+			image:    "registry-1.docker.io/library/busybox",
+			expected: "index.docker.io/library/busybox",
+		},
+		{
+			name:     "canonicalize leaves index.docker.io untouched",
+			behavior: RegistryAliasCanonicalize,
+			image:    "index.docker.io/library/busybox",
+			expected: "index.docker.io/library/busybox",
+		},
+		{
+			name:     "canonicalize leaves other registries untouched",
+			behavior: RegistryAliasCanonicalize,
+			image:    "gcr.io/foo/bar",
+			expected: "gcr.io/foo/bar",
+		},
+		{
+			name:     "canonicalize handles bare hostname",
+			behavior: RegistryAliasCanonicalize,
+			image:    "docker.io",
+			expected: "index.docker.io",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetRegistryAliasBehavior(tc.behavior)
+			defer SetRegistryAliasBehavior(RegistryAliasPreserve)
+
+			if got := normalizeImageHost(tc.image); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeRegistryHost(t *testing.T) {
+	testcases := []struct {
+		name     string
+		host     string
+		expected string
+	}{
+		{
+			name:     "plain hostname untouched",
+			host:     "registry.io",
+			expected: "registry.io",
+		},
+		{
+			name:     "hostname lowercased",
+			host:     "Registry.IO",
+			expected: "registry.io",
+		},
+		{
+			name:     "default port stripped",
+			host:     "registry.io:443",
+			expected: "registry.io",
+		},
+		{
+			name:     "non-default port kept",
+			host:     "registry.io:5000",
+			expected: "registry.io:5000",
+		},
+		{
+			name:     "lowercased with port kept",
+			host:     "Registry.IO:5000",
+			expected: "registry.io:5000",
+		},
+		{
+			name:     "IPv4 with default port stripped",
+			host:     "192.168.1.1:443",
+			expected: "192.168.1.1",
+		},
+		{
+			name:     "IPv4 with non-default port kept",
+			host:     "192.168.1.1:5000",
+			expected: "192.168.1.1:5000",
+		},
+		{
+			name:     "IPv6 literal untouched",
+			host:     "[::1]",
+			expected: "[::1]",
+		},
+		{
+			name:     "IPv6 literal with non-default port kept",
+			host:     "[::1]:5000",
+			expected: "[::1]:5000",
+		},
+		{
+			name:     "IPv6 literal with default port stripped",
+			host:     "[::1]:443",
+			expected: "[::1]",
+		},
+		{
+			name:     "IPv6 literal lowercased",
+			host:     "[2001:DB8::1]",
+			expected: "[2001:db8::1]",
+		},
+		{
+			name:     "IPv6 literal zero runs compressed",
+			host:     "[2001:db8:0:0:0:0:0:1]",
+			expected: "[2001:db8::1]",
+		},
+		{
+			name:     "IPv6 literal canonicalized with port kept",
+			host:     "[2001:DB8:0:0::1]:5000",
+			expected: "[2001:db8::1]:5000",
+		},
+		{
+			name:     "unbracketed IPv6 literal bracketed",
+			host:     "2001:DB8:0:0::1",
+			expected: "[2001:db8::1]",
+		},
+		{
+			name:     "wildcard hostname untouched",
+			host:     "*.registry.io",
+			expected: "*.registry.io",
+		},
+		{
+			name:     "wildcard hostname with port kept",
+			host:     "*.registry.io:5000",
+			expected: "*.registry.io:5000",
+		},
+		{
+			name:     "wildcard hostname lowercased",
+			host:     "*.Registry.IO",
+			expected: "*.registry.io",
+		},
+		{
+			name:     "empty host untouched",
+			host:     "",
+			expected: "",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := CanonicalizeRegistryHost(tc.host); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestKeyringCanonicalHostLookup(t *testing.T) {
+	keyring := &BasicDockerKeyring{}
+	keyring.Add(nil, DockerConfig{
+		"Registry.IO:443":   DockerConfigEntry{Username: "user", Password: "password"},
+		"[2001:DB8::1]:443": DockerConfigEntry{Username: "v6user", Password: "password"},
+	})
+
+	testcases := []struct {
+		image    string
+		expected string
+	}{
+		{image: "registry.io/library/busybox", expected: "user"},
+		{image: "registry.io:443/library/busybox", expected: "user"},
+		{image: "Registry.IO/library/busybox", expected: "user"},
+		{image: "[2001:db8::1]/library/busybox", expected: "v6user"},
+		{image: "[2001:db8:0:0:0:0:0:1]:443/library/busybox", expected: "v6user"},
+	}
+
+	for _, tc := range testcases {
+		creds, found := keyring.Lookup(tc.image)
+		if !found {
+			t.Errorf("expected credentials for %q, got none", tc.image)
+			continue
+		}
+		if len(creds) != 1 || creds[0].Username != tc.expected {
+			t.Errorf("unexpected credentials for %q: %v", tc.image, creds)
+		}
+	}
+}
+
+func TestKeyringDockerHubAliasLookup(t *testing.T) {
+	SetRegistryAliasBehavior(RegistryAliasCanonicalize)
+	defer SetRegistryAliasBehavior(RegistryAliasPreserve)
+
+	keyring := &BasicDockerKeyring{}
+	keyring.Add(nil, DockerConfig{
+		"docker.io": DockerConfigEntry{
+			Username: "user",
+			Password: "password",
+		},
+	})
+
+	for _, image := range []string{
+		"docker.io/library/busybox",
+		"index.docker.io/library/busybox",
+		"registry-1.docker.io/library/busybox",
+	} {
+		creds, found := keyring.Lookup(image)
+		if !found {
+			t.Errorf("expected credentials for %q, got none", image)
+			continue
+		}
+		if len(creds) != 1 || creds[0].Username != "user" {
+			t.Errorf("unexpected credentials for %q: %v", image, creds)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+// credentialSaturationThreshold is the number of concurrently executing
+// plugin invocations at or above which credential resolution is considered
+// saturated. Mass pod placements onto a fresh node can queue far more
+// invocations than plugins can serve promptly; beyond this point new image
+// pulls are better off waiting briefly than starting with credentials that
+// are minted mid-flight.
+var credentialSaturationThreshold int64 = 16
+
+// CredentialResolutionSaturated reports whether credential provider plugin
+// invocations are saturated. The image pull manager consults it before
+// starting new pulls so pulls are delayed up front instead of timing out
+// mid-flight waiting for registry credentials.
+func CredentialResolutionSaturated() bool {
+	return inFlightInvocationCount.Load() >= credentialSaturationThreshold
+}
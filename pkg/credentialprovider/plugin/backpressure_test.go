@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"testing"
+)
+
+func Test_CredentialResolutionSaturated(t *testing.T) {
+	originalThreshold := credentialSaturationThreshold
+	credentialSaturationThreshold = 2
+	t.Cleanup(func() {
+		credentialSaturationThreshold = originalThreshold
+	})
+
+	if CredentialResolutionSaturated() {
+		t.Fatal("expected credential resolution not to be saturated with no invocations in flight")
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := beginPluginInvocation("test"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if !CredentialResolutionSaturated() {
+		t.Error("expected credential resolution to be saturated at the threshold")
+	}
+
+	endPluginInvocation()
+	if CredentialResolutionSaturated() {
+		t.Error("expected credential resolution to recover once below the threshold")
+	}
+	endPluginInvocation()
+}
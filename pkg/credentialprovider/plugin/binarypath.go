@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// currentPlatformKey identifies the platform the kubelet runs on in the form
+// used by binaryByPlatform keys and per-platform bin subdirectories, such as
+// linux-amd64. It is a variable so tests can exercise other platforms.
+var currentPlatformKey = runtime.GOOS + "-" + runtime.GOARCH
+
+// pluginBinaryCandidates returns the paths, relative to the plugin bin
+// directory, that may hold the provider's binary on the current platform. An
+// explicit binaryByPlatform entry names the binary outright and is never
+// overridden by a fallback, so a configuration mistake fails instead of
+// silently executing a different binary. Without an entry, a per-platform
+// subdirectory such as linux-arm64 takes precedence over a binary named after
+// the provider, so one shared bin directory can serve a mixed-architecture
+// fleet.
+func pluginBinaryCandidates(name string, binaryByPlatform map[string]string) []string {
+	if binary, ok := binaryByPlatform[currentPlatformKey]; ok {
+		return []string{binary}
+	}
+	return []string{filepath.Join(currentPlatformKey, name), name}
+}
+
+// resolvePluginBinary locates the provider's binary in the plugin bin
+// directory and verifies it is safe to execute: after resolving symlinks the
+// final path must still be inside the bin directory and must be a regular
+// file. This keeps a symlink or a `..` traversal staged in the bin directory
+// from redirecting the kubelet into executing an arbitrary file elsewhere on
+// the host. The resolved path is returned for execution so the check and the
+// exec operate on the same target.
+func resolvePluginBinary(pluginBinDir, name string, binaryByPlatform map[string]string) (string, error) {
+	var pluginBin string
+	var err error
+	for _, candidate := range pluginBinaryCandidates(name, binaryByPlatform) {
+		// Considering Windows binary with suffix ".exe", LookPath() helps to find
+		// the correct path, matching how the binary is located at registration time.
+		pluginBin, err = exec.LookPath(filepath.Join(pluginBinDir, candidate))
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("error locating binary for credential provider plugin %s: %w", name, err)
+	}
+
+	resolvedBin, err := filepath.EvalSymlinks(pluginBin)
+	if err != nil {
+		return "", fmt.Errorf("error resolving binary for credential provider plugin %s: %w", name, err)
+	}
+	// The bin directory itself may legitimately be reached through a symlink,
+	// so the containment check compares fully resolved paths on both sides.
+	resolvedBinDir, err := filepath.EvalSymlinks(pluginBinDir)
+	if err != nil {
+		return "", fmt.Errorf("error resolving plugin binary directory %s: %w", pluginBinDir, err)
+	}
+
+	rel, err := filepath.Rel(resolvedBinDir, resolvedBin)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("binary for credential provider plugin %s resolves to %s, outside of the plugin binary directory %s", name, resolvedBin, pluginBinDir)
+	}
+
+	info, err := os.Stat(resolvedBin)
+	if err != nil {
+		return "", fmt.Errorf("error inspecting binary for credential provider plugin %s: %w", name, err)
+	}
+	if !info.Mode().IsRegular() {
+		return "", fmt.Errorf("binary for credential provider plugin %s resolves to %s, which is not a regular file", name, resolvedBin)
+	}
+
+	return resolvedBin, nil
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_resolvePluginBinary(t *testing.T) {
+	binDir := t.TempDir()
+	outsideDir := t.TempDir()
+
+	writeExecutable := func(path string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte("#!/bin/sh\n"), 0755); err != nil {
+			t.Fatalf("unexpected error writing binary: %v", err)
+		}
+	}
+
+	writeExecutable(filepath.Join(binDir, "good-provider"))
+	writeExecutable(filepath.Join(outsideDir, "outside-binary"))
+	if err := os.Symlink(filepath.Join(outsideDir, "outside-binary"), filepath.Join(binDir, "escaping-provider")); err != nil {
+		t.Fatalf("unexpected error creating symlink: %v", err)
+	}
+	if err := os.Symlink("good-provider", filepath.Join(binDir, "aliased-provider")); err != nil {
+		t.Fatalf("unexpected error creating symlink: %v", err)
+	}
+	if err := os.Mkdir(filepath.Join(binDir, "dir-provider"), 0755); err != nil {
+		t.Fatalf("unexpected error creating directory: %v", err)
+	}
+
+	testcases := []struct {
+		name         string
+		provider     string
+		wantResolved string
+		wantErr      string
+	}{
+		{
+			name:         "regular binary in the bin directory",
+			provider:     "good-provider",
+			wantResolved: "good-provider",
+		},
+		{
+			name:         "symlink resolving within the bin directory",
+			provider:     "aliased-provider",
+			wantResolved: "good-provider",
+		},
+		{
+			name:     "symlink escaping the bin directory",
+			provider: "escaping-provider",
+			wantErr:  "outside of the plugin binary directory",
+		},
+		{
+			name:     "dot-dot traversal in the provider name",
+			provider: filepath.Join("..", filepath.Base(outsideDir), "outside-binary"),
+			wantErr:  "outside of the plugin binary directory",
+		},
+		{
+			name:     "missing binary",
+			provider: "no-such-provider",
+			wantErr:  "error locating binary for credential provider plugin no-such-provider",
+		},
+		{
+			name:     "directory instead of a binary",
+			provider: "dir-provider",
+			wantErr:  "error locating binary for credential provider plugin dir-provider",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			resolved, err := resolvePluginBinary(binDir, testcase.provider, nil)
+			if len(testcase.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), testcase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testcase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if filepath.Base(resolved) != testcase.wantResolved {
+				t.Errorf("expected resolved binary %q, got %q", testcase.wantResolved, resolved)
+			}
+		})
+	}
+}
+
+func Test_resolvePluginBinary_multiPlatform(t *testing.T) {
+	binDir := t.TempDir()
+	outsideDir := t.TempDir()
+	platformDir := filepath.Join(binDir, currentPlatformKey)
+	if err := os.Mkdir(platformDir, 0755); err != nil {
+		t.Fatalf("unexpected error creating platform directory: %v", err)
+	}
+
+	writeExecutable := func(path string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte("#!/bin/sh\n"), 0755); err != nil {
+			t.Fatalf("unexpected error writing binary: %v", err)
+		}
+	}
+
+	writeExecutable(filepath.Join(binDir, "dual-provider"))
+	writeExecutable(filepath.Join(platformDir, "dual-provider"))
+	writeExecutable(filepath.Join(platformDir, "sub-only-provider"))
+	writeExecutable(filepath.Join(binDir, "mapped-binary"))
+	writeExecutable(filepath.Join(outsideDir, "outside-binary"))
+
+	// t.TempDir may itself be reached through symlinks, so expectations are
+	// compared against fully resolved paths like resolvePluginBinary returns.
+	wantPath := func(path string) string {
+		t.Helper()
+		resolved, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			t.Fatalf("unexpected error resolving %s: %v", path, err)
+		}
+		return resolved
+	}
+
+	testcases := []struct {
+		name             string
+		provider         string
+		binaryByPlatform map[string]string
+		wantResolved     string
+		wantErr          string
+	}{
+		{
+			name:         "per-platform subdirectory takes precedence over the flat binary",
+			provider:     "dual-provider",
+			wantResolved: filepath.Join(platformDir, "dual-provider"),
+		},
+		{
+			name:         "binary only present in the per-platform subdirectory",
+			provider:     "sub-only-provider",
+			wantResolved: filepath.Join(platformDir, "sub-only-provider"),
+		},
+		{
+			name:             "binaryByPlatform entry selects the configured binary",
+			provider:         "dual-provider",
+			binaryByPlatform: map[string]string{currentPlatformKey: "mapped-binary"},
+			wantResolved:     filepath.Join(binDir, "mapped-binary"),
+		},
+		{
+			name:             "binaryByPlatform without an entry for the current platform falls back",
+			provider:         "dual-provider",
+			binaryByPlatform: map[string]string{"plan9-mips": "mapped-binary"},
+			wantResolved:     filepath.Join(platformDir, "dual-provider"),
+		},
+		{
+			name:             "binaryByPlatform entry naming a missing binary does not fall back",
+			provider:         "dual-provider",
+			binaryByPlatform: map[string]string{currentPlatformKey: "no-such-binary"},
+			wantErr:          "error locating binary for credential provider plugin dual-provider",
+		},
+		{
+			name:             "binaryByPlatform entry escaping the bin directory",
+			provider:         "dual-provider",
+			binaryByPlatform: map[string]string{currentPlatformKey: filepath.Join("..", filepath.Base(outsideDir), "outside-binary")},
+			wantErr:          "outside of the plugin binary directory",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			resolved, err := resolvePluginBinary(binDir, testcase.provider, testcase.binaryByPlatform)
+			if len(testcase.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), testcase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testcase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if want := wantPath(testcase.wantResolved); resolved != want {
+				t.Errorf("expected resolved binary %q, got %q", want, resolved)
+			}
+		})
+	}
+}
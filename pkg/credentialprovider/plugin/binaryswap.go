@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/klog/v2"
+	credentialproviderapi "k8s.io/kubelet/pkg/apis/credentialprovider"
+)
+
+const (
+	// stagedBinarySuffix is the suffix under which a new plugin binary is
+	// staged next to the active one, e.g. acr-credential-provider.new. The
+	// kubelet health-probes staged binaries and atomically renames them over
+	// the active binary on success, so plugin upgrades pushed by a DaemonSet
+	// never leave a window where image pulls fail against a half-written or
+	// broken binary.
+	stagedBinarySuffix = ".new"
+
+	// rejectedBinarySuffix is the suffix a staged binary is renamed to when it
+	// fails the health probe, so the DaemonSet that pushed it can observe the
+	// rejection and the kubelet does not re-probe the same broken binary every
+	// interval.
+	rejectedBinarySuffix = ".rejected"
+
+	// stagedBinaryCheckInterval is how often the kubelet looks for staged
+	// plugin binaries.
+	stagedBinaryCheckInterval = time.Minute
+
+	// swapProbeImage is the synthetic image reference sent to a staged binary
+	// during the health probe. The .invalid TLD guarantees it never names a
+	// real registry, so a correct plugin responds without contacting one.
+	swapProbeImage = "registry.invalid/kubelet-credential-provider-probe"
+
+	// swapProbeTimeout bounds the health probe of a staged binary. Probing only
+	// delays the swap, never an image pull, so it gets a short budget.
+	swapProbeTimeout = 30 * time.Second
+)
+
+// watchStagedPluginBinaries periodically checks for staged plugin binaries and
+// swaps them in, until kubelet shutdown begins.
+func watchStagedPluginBinaries() {
+	for {
+		select {
+		case <-shutdownCh:
+			return
+		case <-time.After(stagedBinaryCheckInterval):
+		}
+
+		checkStagedPluginBinaries()
+	}
+}
+
+// checkStagedPluginBinaries probes and swaps in staged binaries for every
+// registered provider that is backed by a binary in the plugin bin directory.
+func checkStagedPluginBinaries() {
+	providersMutex.RLock()
+	snapshot := make([]provider, len(providers))
+	copy(snapshot, providers)
+	providersMutex.RUnlock()
+
+	for _, p := range snapshot {
+		maybeSwapPluginBinary(p.impl)
+	}
+}
+
+// maybeSwapPluginBinary swaps a staged binary in for the provider's active one
+// if a staged binary exists and passes the health probe. A staged binary that
+// fails the probe is renamed aside so it is not re-probed, and the active
+// binary stays in place either way until a healthy replacement takes over.
+func maybeSwapPluginBinary(impl *pluginProvider) {
+	e := execBackend(impl.plugin)
+	if e == nil || len(e.command) > 0 {
+		// Script-based providers are invoked via an interpreter and have no
+		// named binary to swap.
+		return
+	}
+
+	activePath := filepath.Join(e.pluginBinDir, e.name)
+	stagedPath := activePath + stagedBinarySuffix
+	if _, err := os.Stat(stagedPath); err != nil {
+		if !os.IsNotExist(err) {
+			klog.ErrorS(err, "Error inspecting staged credential provider plugin binary", "provider", e.name, "path", stagedPath)
+		}
+		return
+	}
+
+	if err := probeStagedPluginBinary(e, stagedPath); err != nil {
+		klog.ErrorS(err, "Staged credential provider plugin binary failed its health probe, rejecting it", "provider", e.name, "path", stagedPath)
+		kubeletCredentialProviderBinarySwaps.WithLabelValues(e.name, "rejected").Inc()
+		if err := os.Rename(stagedPath, activePath+rejectedBinarySuffix); err != nil {
+			klog.ErrorS(err, "Error renaming rejected credential provider plugin binary", "provider", e.name, "path", stagedPath)
+		}
+		return
+	}
+
+	if err := os.Rename(stagedPath, activePath); err != nil {
+		klog.ErrorS(err, "Error swapping in staged credential provider plugin binary", "provider", e.name, "path", stagedPath)
+		return
+	}
+	kubeletCredentialProviderBinarySwaps.WithLabelValues(e.name, "swapped").Inc()
+	klog.InfoS("Swapped in staged credential provider plugin binary", "provider", e.name, "path", activePath)
+
+	// A reused plugin process still runs the old binary; stop it so the next
+	// invocation starts the new one.
+	if dp, ok := impl.plugin.(interface{ shutdown() }); ok {
+		dp.shutdown()
+	}
+}
+
+// execBackend returns the execPlugin backing the given plugin, or nil for
+// implementations without one, such as test fakes.
+func execBackend(p Plugin) *execPlugin {
+	switch impl := p.(type) {
+	case *execPlugin:
+		return impl
+	case *daemonPlugin:
+		return impl.base
+	case *faultInjectingPlugin:
+		return execBackend(impl.base)
+	default:
+		return nil
+	}
+}
+
+// probeStagedPluginBinary invokes the staged binary with a synthetic
+// credential request and verifies it produces a well-formed response for the
+// provider's configured API version. The probe exercises the full request
+// cycle a real image pull would, so a binary that cannot execute, crashes, or
+// speaks the wrong API version never replaces a working one.
+func probeStagedPluginBinary(e *execPlugin, stagedPath string) error {
+	probeRequest := &credentialproviderapi.CredentialProviderRequest{
+		Image:       swapProbeImage,
+		RequestHash: computeRequestHash(swapProbeImage, nil),
+	}
+	data, err := e.encodeRequest(probeRequest)
+	if err != nil {
+		return fmt.Errorf("failed to encode probe request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), swapProbeTimeout)
+	defer cancel()
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	cmd := exec.CommandContext(ctx, stagedPath, e.args...)
+	cmd.Stdout, cmd.Stderr, cmd.Stdin = stdout, stderr, bytes.NewBuffer(data)
+	cmd.Env = mergeEnvVars(e.environ(), e.configEnv())
+
+	// The probe runs under the same isolation the real invocations will, so a
+	// binary that cannot function inside it is rejected up front.
+	if err := e.applyIsolation(cmd); err != nil {
+		return err
+	}
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error execing staged binary: %w: %s", err, stderr.String())
+	}
+
+	gvk, err := json.DefaultMetaFactory.Interpret(stdout.Bytes())
+	if err != nil {
+		return fmt.Errorf("error reading GVK from probe response: %w", err)
+	}
+	if gvk.GroupVersion().String() != e.apiVersion {
+		return fmt.Errorf("apiVersion from probe response did not match expected apiVersion:%s, actual apiVersion:%s", e.apiVersion, gvk.GroupVersion().String())
+	}
+	if _, err := e.decodeResponse(stdout.Bytes()); err != nil {
+		// err is explicitly not wrapped since it may contain credentials in the response.
+		return fmt.Errorf("error decoding probe response from stdout")
+	}
+
+	return nil
+}
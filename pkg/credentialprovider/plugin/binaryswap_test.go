@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	credentialproviderv1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1"
+)
+
+const healthyProbeScript = `#!/bin/sh
+cat > /dev/null
+echo '{"kind":"CredentialProviderResponse","apiVersion":"credentialprovider.kubelet.k8s.io/v1","cacheKeyType":"Registry"}'
+`
+
+const brokenProbeScript = `#!/bin/sh
+exit 1
+`
+
+func Test_maybeSwapPluginBinary(t *testing.T) {
+	if goruntime.GOOS == "windows" {
+		t.Skip("test uses shell script plugins")
+	}
+
+	testcases := []struct {
+		name           string
+		stagedScript   string
+		expectSwapped  bool
+		expectRejected bool
+	}{
+		{
+			name: "no staged binary is a no-op",
+		},
+		{
+			name:          "healthy staged binary is swapped in",
+			stagedScript:  healthyProbeScript + "# v2\n",
+			expectSwapped: true,
+		},
+		{
+			name:           "staged binary failing the probe is rejected",
+			stagedScript:   brokenProbeScript,
+			expectRejected: true,
+		},
+		{
+			name:           "staged binary with a mismatched apiVersion is rejected",
+			stagedScript:   `#!/bin/sh` + "\n" + `echo '{"kind":"CredentialProviderResponse","apiVersion":"credentialprovider.kubelet.k8s.io/v1alpha1","cacheKeyType":"Registry"}'` + "\n",
+			expectRejected: true,
+		},
+	}
+
+	mediaType := "application/json"
+	info, ok := runtime.SerializerInfoForMediaType(codecs.SupportedMediaTypes(), mediaType)
+	if !ok {
+		t.Fatalf("unsupported media type: %s", mediaType)
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			pluginBinDir := t.TempDir()
+			activePath := filepath.Join(pluginBinDir, "test-plugin")
+			if err := os.WriteFile(activePath, []byte(healthyProbeScript), 0755); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(testcase.stagedScript) > 0 {
+				if err := os.WriteFile(activePath+stagedBinarySuffix, []byte(testcase.stagedScript), 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			e := &execPlugin{
+				name:         "test-plugin",
+				apiVersion:   credentialproviderv1.SchemeGroupVersion.String(),
+				encoder:      codecs.EncoderForVersion(info.Serializer, credentialproviderv1.SchemeGroupVersion),
+				pluginBinDir: pluginBinDir,
+				environ:      os.Environ,
+			}
+			maybeSwapPluginBinary(&pluginProvider{name: "test-plugin", plugin: e})
+
+			if _, err := os.Stat(activePath + stagedBinarySuffix); !os.IsNotExist(err) {
+				if len(testcase.stagedScript) > 0 {
+					t.Errorf("expected staged binary to be moved, stat returned %v", err)
+				}
+			}
+
+			_, err := os.Stat(activePath + rejectedBinarySuffix)
+			if rejected := err == nil; rejected != testcase.expectRejected {
+				t.Errorf("rejected binary present: %v, expected: %v", rejected, testcase.expectRejected)
+			}
+
+			active, err := os.ReadFile(activePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if testcase.expectSwapped && string(active) != testcase.stagedScript {
+				t.Error("expected the staged binary to replace the active one")
+			}
+			if !testcase.expectSwapped && string(active) != healthyProbeScript {
+				t.Error("expected the active binary to remain in place")
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+
+	configv1 "k8s.io/kubelet/config/v1"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+// ConfigBuilder assembles a CredentialProviderConfig programmatically, for
+// cluster provisioners that generate the configuration in Go rather than
+// templating YAML. Build applies the same defaulting and validation as loading
+// the configuration from disk, so a config that builds successfully is also
+// accepted by the kubelet.
+type ConfigBuilder struct {
+	providers []configv1.CredentialProvider
+	pins      []configv1.CredentialProviderPin
+}
+
+// NewConfigBuilder returns an empty ConfigBuilder.
+func NewConfigBuilder() *ConfigBuilder {
+	return &ConfigBuilder{}
+}
+
+// AddProvider appends a provider to the configuration and returns the builder
+// for chaining.
+func (b *ConfigBuilder) AddProvider(provider configv1.CredentialProvider) *ConfigBuilder {
+	b.providers = append(b.providers, provider)
+	return b
+}
+
+// AddPin appends a digest-pinned image to provider pin to the configuration
+// and returns the builder for chaining.
+func (b *ConfigBuilder) AddPin(pin configv1.CredentialProviderPin) *ConfigBuilder {
+	b.pins = append(b.pins, pin)
+	return b
+}
+
+// Build defaults, converts and validates the assembled configuration and
+// returns the internal CredentialProviderConfig representation the kubelet
+// consumes. The defaulting and validation are identical to those applied when
+// loading configuration from disk.
+func (b *ConfigBuilder) Build() (*kubeletconfig.CredentialProviderConfig, error) {
+	externalConfig := &configv1.CredentialProviderConfig{
+		Providers: b.providers,
+		Pins:      b.pins,
+	}
+	scheme.Default(externalConfig)
+
+	internalConfig := &kubeletconfig.CredentialProviderConfig{}
+	if err := scheme.Convert(externalConfig, internalConfig, nil); err != nil {
+		return nil, fmt.Errorf("error converting credential provider config: %w", err)
+	}
+
+	if errs := validateCredentialProviderConfig(internalConfig, saTokenForCredentialProvidersEnabled()); len(errs) > 0 {
+		return nil, fmt.Errorf("failed to validate credential provider config: %v", errs.ToAggregate())
+	}
+
+	return internalConfig, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	configv1 "k8s.io/kubelet/config/v1"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+func Test_ConfigBuilder(t *testing.T) {
+	config, err := NewConfigBuilder().
+		AddProvider(configv1.CredentialProvider{
+			Name:                 "provider-a",
+			MatchImages:          []string{"test.registry.io"},
+			DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+			APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+		}).
+		AddProvider(configv1.CredentialProvider{
+			Name:                 "provider-b",
+			MatchImages:          []string{"registry.io/foobar*"},
+			DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+			APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+		}).
+		AddPin(configv1.CredentialProviderPin{
+			Image:    "test.registry.io/pause@sha256:7031c1b283388d2c2e09b57badb803c05ebed362dc88d84b480cc47f72a21097",
+			Provider: "provider-a",
+		}).
+		Build()
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	want := &kubeletconfig.CredentialProviderConfig{
+		Providers: []kubeletconfig.CredentialProvider{
+			{
+				Name:                 "provider-a",
+				MatchImages:          []string{"test.registry.io"},
+				DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+				APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			},
+			{
+				Name:                 "provider-b",
+				MatchImages:          []string{"registry.io/foobar*"},
+				DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+				APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			},
+		},
+		Pins: []kubeletconfig.CredentialProviderPin{
+			{
+				Image:    "test.registry.io/pause@sha256:7031c1b283388d2c2e09b57badb803c05ebed362dc88d84b480cc47f72a21097",
+				Provider: "provider-a",
+			},
+		},
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("expected config %#v, got %#v", want, config)
+	}
+}
+
+func Test_ConfigBuilder_validation(t *testing.T) {
+	testcases := []struct {
+		name      string
+		builder   *ConfigBuilder
+		expectErr string
+	}{
+		{
+			name:      "no providers",
+			builder:   NewConfigBuilder(),
+			expectErr: "providers: Required value",
+		},
+		{
+			name: "provider missing matchImages",
+			builder: NewConfigBuilder().AddProvider(configv1.CredentialProvider{
+				Name:                 "provider-a",
+				DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+				APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			}),
+			expectErr: "providers.matchImages: Required value: at least 1 item in matchImages is required",
+		},
+		{
+			name: "pin to unknown provider",
+			builder: NewConfigBuilder().
+				AddProvider(configv1.CredentialProvider{
+					Name:                 "provider-a",
+					MatchImages:          []string{"test.registry.io"},
+					DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+					APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+				}).
+				AddPin(configv1.CredentialProviderPin{
+					Image:    "test.registry.io/pause@sha256:7031c1b283388d2c2e09b57badb803c05ebed362dc88d84b480cc47f72a21097",
+					Provider: "unknown",
+				}),
+			expectErr: `pins.provider: Invalid value: "unknown": pinned provider is not a configured provider`,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			config, err := testcase.builder.Build()
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testcase.expectErr)
+			}
+			if !strings.Contains(err.Error(), testcase.expectErr) {
+				t.Errorf("expected error containing %q, got %q", testcase.expectErr, err.Error())
+			}
+			if config != nil {
+				t.Errorf("expected no config to be returned, got %#v", config)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"strings"
+	"text/template/parse"
+
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// cacheKeyTemplateVars are the variables a cacheKeyTemplate may reference for
+// the pull being served.
+type cacheKeyTemplateVars struct {
+	// Image is the image reference credentials are being resolved for.
+	Image string
+	// Registry is the registry host portion of the image reference.
+	Registry string
+	// Namespace is the namespace of the pod the image is pulled for, or empty
+	// for pulls without a pod identity.
+	Namespace string
+	// ServiceAccountName is the name of the pod's service account, or empty
+	// for pulls without one.
+	ServiceAccountName string
+}
+
+// allowedCacheKeyTemplateVars lists the variable names a cacheKeyTemplate may
+// reference, in the order they are documented.
+var allowedCacheKeyTemplateVars = []string{"Image", "Registry", "Namespace", "ServiceAccountName"}
+
+// cacheKeyTemplateSegment is one piece of a parsed cacheKeyTemplate: either a
+// run of literal text or a single variable reference.
+type cacheKeyTemplateSegment struct {
+	literal  string
+	variable string
+}
+
+// cacheKeyTemplate is a parsed and validated cacheKeyTemplate from the
+// credential provider config. It is a deliberately restricted template: only
+// literal text and references to the allowed variables are permitted, so a
+// config cannot smuggle in function calls or control structures, and keys can
+// be built with every variable value bound unambiguously.
+type cacheKeyTemplate struct {
+	segments []cacheKeyTemplateSegment
+}
+
+// parseCacheKeyTemplate parses text as a restricted cache key template,
+// rejecting anything beyond literal text and simple references to the allowed
+// variables.
+func parseCacheKeyTemplate(text string) (*cacheKeyTemplate, error) {
+	trees := make(map[string]*parse.Tree)
+	tree := parse.New("cacheKeyTemplate")
+	tree.Mode = parse.SkipFuncCheck
+	if _, err := tree.Parse(text, "{{", "}}", trees); err != nil {
+		return nil, fmt.Errorf("invalid template: %v", err)
+	}
+	if len(trees) > 1 {
+		return nil, fmt.Errorf("template definitions are not allowed")
+	}
+
+	tmpl := &cacheKeyTemplate{}
+	for _, node := range tree.Root.Nodes {
+		switch node := node.(type) {
+		case *parse.TextNode:
+			tmpl.segments = append(tmpl.segments, cacheKeyTemplateSegment{literal: string(node.Text)})
+		case *parse.ActionNode:
+			variable, err := cacheKeyTemplateVariable(node)
+			if err != nil {
+				return nil, err
+			}
+			tmpl.segments = append(tmpl.segments, cacheKeyTemplateSegment{variable: variable})
+		default:
+			return nil, fmt.Errorf("control structures are not allowed, found %q", node.String())
+		}
+	}
+	return tmpl, nil
+}
+
+// cacheKeyTemplateVariable extracts the variable name from a template action,
+// requiring the action to be a bare reference to one of the allowed variables.
+func cacheKeyTemplateVariable(node *parse.ActionNode) (string, error) {
+	if len(node.Pipe.Decl) > 0 || len(node.Pipe.Cmds) != 1 || len(node.Pipe.Cmds[0].Args) != 1 {
+		return "", fmt.Errorf("pipelines and function calls are not allowed, found %q", node.String())
+	}
+	field, ok := node.Pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	if !ok || len(field.Ident) != 1 {
+		return "", fmt.Errorf("only simple variable references are allowed, found %q", node.String())
+	}
+	for _, allowed := range allowedCacheKeyTemplateVars {
+		if field.Ident[0] == allowed {
+			return allowed, nil
+		}
+	}
+	return "", fmt.Errorf("unknown variable %q, allowed variables are %s", node.String(), allowedCacheKeyTemplateVarsList())
+}
+
+// allowedCacheKeyTemplateVarsList renders the allowed variables for error
+// messages, e.g. `{{.Image}}, {{.Registry}}, ...`.
+func allowedCacheKeyTemplateVarsList() string {
+	vars := make([]string, 0, len(allowedCacheKeyTemplateVars))
+	for _, name := range allowedCacheKeyTemplateVars {
+		vars = append(vars, "{{."+name+"}}")
+	}
+	return strings.Join(vars, ", ")
+}
+
+// referencesPullIdentity reports whether the template references the image or
+// registry of the pull, without which a single cached credential would be
+// served for every image the provider matches.
+func (t *cacheKeyTemplate) referencesPullIdentity() bool {
+	for _, segment := range t.segments {
+		if segment.variable == "Image" || segment.variable == "Registry" {
+			return true
+		}
+	}
+	return false
+}
+
+// render builds the cache key for the given pull. Every segment is length
+// prefixed rather than concatenated, so a variable value containing the
+// template's literal separator text cannot produce the same key as different
+// values: {{.Image}}/{{.Namespace}} with image "a" and namespace "b" never
+// collides with image "a/b" and an empty namespace.
+func (t *cacheKeyTemplate) render(vars cacheKeyTemplateVars) (string, error) {
+	b := cryptobyte.NewBuilder(nil)
+	for _, segment := range t.segments {
+		value := segment.literal
+		switch segment.variable {
+		case "Image":
+			value = vars.Image
+		case "Registry":
+			value = vars.Registry
+		case "Namespace":
+			value = vars.Namespace
+		case "ServiceAccountName":
+			value = vars.ServiceAccountName
+		}
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes([]byte(value))
+		})
+	}
+
+	keyBytes, err := b.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return string(keyBytes), nil
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_parseCacheKeyTemplate(t *testing.T) {
+	testcases := []struct {
+		name      string
+		template  string
+		expectErr string
+	}{
+		{
+			name:     "literal text and allowed variables",
+			template: "{{.Registry}}/{{.Namespace}}",
+		},
+		{
+			name:     "all allowed variables",
+			template: "{{.Image}}|{{.Registry}}|{{.Namespace}}|{{.ServiceAccountName}}",
+		},
+		{
+			name:     "literal only",
+			template: "static-key",
+		},
+		{
+			name:      "unknown variable",
+			template:  "{{.Registry}}/{{.PodName}}",
+			expectErr: `unknown variable "{{.PodName}}", allowed variables are {{.Image}}, {{.Registry}}, {{.Namespace}}, {{.ServiceAccountName}}`,
+		},
+		{
+			name:      "control structure",
+			template:  "{{if .Namespace}}{{.Namespace}}{{end}}",
+			expectErr: "control structures are not allowed",
+		},
+		{
+			name:      "range",
+			template:  "{{range .Namespace}}{{.}}{{end}}",
+			expectErr: "control structures are not allowed",
+		},
+		{
+			name:      "function call",
+			template:  "{{printf \"%s\" .Registry}}",
+			expectErr: "pipelines and function calls are not allowed",
+		},
+		{
+			name:      "pipeline",
+			template:  "{{.Registry | printf \"%s\"}}",
+			expectErr: "pipelines and function calls are not allowed",
+		},
+		{
+			name:      "variable declaration",
+			template:  "{{$r := .Registry}}",
+			expectErr: "pipelines and function calls are not allowed",
+		},
+		{
+			name:      "nested field",
+			template:  "{{.Registry.Host}}",
+			expectErr: "only simple variable references are allowed",
+		},
+		{
+			name:      "template definition",
+			template:  `{{define "x"}}y{{end}}{{.Registry}}`,
+			expectErr: "template definitions are not allowed",
+		},
+		{
+			name:      "unclosed action",
+			template:  "{{.Registry",
+			expectErr: "invalid template",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseCacheKeyTemplate(tc.template)
+			if len(tc.expectErr) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error parsing %q: %v", tc.template, err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error parsing %q, got none", tc.template)
+			}
+			if !strings.Contains(err.Error(), tc.expectErr) {
+				t.Errorf("expected error containing %q, got %q", tc.expectErr, err.Error())
+			}
+		})
+	}
+}
+
+func Test_cacheKeyTemplateRender(t *testing.T) {
+	// Pairs of pulls that must never produce the same key, covering the
+	// collision pitfalls of naive string substitution: variable values that
+	// contain the template's literal separator, and adjacent variables whose
+	// values shift characters between each other.
+	testcases := []struct {
+		name     string
+		template string
+		a, b     cacheKeyTemplateVars
+	}{
+		{
+			name:     "value containing the literal separator",
+			template: "{{.Image}}/{{.Namespace}}",
+			a:        cacheKeyTemplateVars{Image: "registry.io/a", Namespace: "b"},
+			b:        cacheKeyTemplateVars{Image: "registry.io/a/b", Namespace: ""},
+		},
+		{
+			name:     "adjacent variables shifting characters",
+			template: "{{.Namespace}}{{.ServiceAccountName}}",
+			a:        cacheKeyTemplateVars{Namespace: "a", ServiceAccountName: "bc"},
+			b:        cacheKeyTemplateVars{Namespace: "ab", ServiceAccountName: "c"},
+		},
+		{
+			name:     "empty value versus missing separator",
+			template: "{{.Registry}}-{{.Namespace}}",
+			a:        cacheKeyTemplateVars{Registry: "registry.io-", Namespace: ""},
+			b:        cacheKeyTemplateVars{Registry: "registry.io", Namespace: ""},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpl, err := parseCacheKeyTemplate(tc.template)
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %v", tc.template, err)
+			}
+
+			keyA, err := tmpl.render(tc.a)
+			if err != nil {
+				t.Fatalf("unexpected error rendering %+v: %v", tc.a, err)
+			}
+			keyB, err := tmpl.render(tc.b)
+			if err != nil {
+				t.Fatalf("unexpected error rendering %+v: %v", tc.b, err)
+			}
+			if keyA == keyB {
+				t.Errorf("expected distinct keys for %+v and %+v, both rendered to %q", tc.a, tc.b, keyA)
+			}
+
+			keyA2, err := tmpl.render(tc.a)
+			if err != nil {
+				t.Fatalf("unexpected error rendering %+v: %v", tc.a, err)
+			}
+			if keyA != keyA2 {
+				t.Errorf("expected rendering to be deterministic, got %q and %q", keyA, keyA2)
+			}
+		})
+	}
+}
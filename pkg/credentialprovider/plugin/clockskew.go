@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"encoding/base64"
+	encodingjson "encoding/json"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/credentialprovider"
+)
+
+// implausibleExpiryHorizon is how far in the future a token expiry embedded in
+// plugin-returned credentials may plausibly lie. Registry tokens are short
+// lived; an expiry beyond this horizon almost always means the node clock is
+// behind, not that the token is genuinely long lived.
+const implausibleExpiryHorizon = 48 * time.Hour
+
+// tokenExpirationTime returns the exp claim of a JWT. It reports false for
+// credential material that is not a well-formed JWT or carries no exp claim.
+// As with tokenAudiences, the signature is deliberately not validated: the
+// token is only decoded to cross-check its expiry against the node clock.
+func tokenExpirationTime(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Expiry int64 `json:"exp"`
+	}
+	if err := encodingjson.Unmarshal(payload, &claims); err != nil || claims.Expiry == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(claims.Expiry, 0), true
+}
+
+// checkCredentialClockSkew cross-checks the expiry of JWT-shaped passwords in
+// plugin-returned credentials against the node clock. An expiry in the past or
+// implausibly far in the future is almost always clock skew on the node, which
+// otherwise only surfaces as confusing 401s from the registry; both are logged
+// and counted so operators can tell the two apart. It returns the earliest
+// token expiry found, or the zero time when no credential carries one, so
+// callers can keep the credential cache from outliving the tokens in it.
+func (p *pluginProvider) checkCredentialClockSkew(config credentialprovider.DockerConfig) time.Time {
+	var earliest time.Time
+	now := p.clock.Now()
+
+	for matchImage, entry := range config {
+		expiry, ok := tokenExpirationTime(entry.Password)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case expiry.Before(now):
+			klog.Warningf("Credential provider plugin %s returned credentials for %s whose token expired at %s, before the current node time %s; the node clock is likely skewed or the plugin returned a stale token, and pulls with these credentials will fail with an authorization error", p.name, matchImage, expiry.Format(time.RFC3339), now.Format(time.RFC3339))
+			kubeletCredentialProviderSkewedExpiries.WithLabelValues(p.name, "expired").Inc()
+		case expiry.After(now.Add(implausibleExpiryHorizon)):
+			klog.Warningf("Credential provider plugin %s returned credentials for %s whose token expires at %s, more than %s after the current node time %s; the node clock is likely behind", p.name, matchImage, expiry.Format(time.RFC3339), implausibleExpiryHorizon, now.Format(time.RFC3339))
+			kubeletCredentialProviderSkewedExpiries.WithLabelValues(p.name, "far_future").Inc()
+		}
+
+		if earliest.IsZero() || expiry.Before(earliest) {
+			earliest = expiry
+		}
+	}
+
+	return earliest
+}
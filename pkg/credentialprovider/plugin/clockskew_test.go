@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/credentialprovider"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+// fakeJWTWithExp returns a syntactically valid JWT carrying the given exp
+// claim. The signature is garbage; expiry inspection deliberately does not
+// check it.
+func fakeJWTWithExp(t *testing.T, exp time.Time) string {
+	t.Helper()
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]any{"exp": exp.Unix(), "sub": "system:serviceaccount:ns:sa-name"})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	payload := base64.RawURLEncoding.EncodeToString(claims)
+	signature := base64.RawURLEncoding.EncodeToString([]byte("signature"))
+
+	return strings.Join([]string{header, payload, signature}, ".")
+}
+
+func Test_tokenExpirationTime(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	testcases := []struct {
+		name           string
+		token          string
+		expectedExpiry time.Time
+		expectedOK     bool
+	}{
+		{
+			name:  "opaque password is not a JWT",
+			token: "hunter2",
+		},
+		{
+			name:  "JWT without an exp claim",
+			token: fakeJWT(t, "audience"),
+		},
+		{
+			name:           "JWT with an exp claim",
+			token:          fakeJWTWithExp(t, exp),
+			expectedExpiry: exp,
+			expectedOK:     true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			expiry, ok := tokenExpirationTime(testcase.token)
+			if ok != testcase.expectedOK {
+				t.Fatalf("expected ok: %v, got: %v", testcase.expectedOK, ok)
+			}
+			if ok && !expiry.Equal(testcase.expectedExpiry) {
+				t.Errorf("expected expiry %s, got %s", testcase.expectedExpiry, expiry)
+			}
+		})
+	}
+}
+
+func Test_checkCredentialClockSkew(t *testing.T) {
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	p := &pluginProvider{
+		name:  "test-plugin",
+		clock: fakeClock,
+	}
+
+	earlier := fakeClock.Now().Add(30 * time.Minute).Truncate(time.Second)
+	later := fakeClock.Now().Add(time.Hour).Truncate(time.Second)
+
+	testcases := []struct {
+		name           string
+		config         credentialprovider.DockerConfig
+		expectedExpiry time.Time
+	}{
+		{
+			name: "no credential carries a token expiry",
+			config: credentialprovider.DockerConfig{
+				"test.registry.io": credentialprovider.DockerConfigEntry{Username: "user", Password: "password"},
+			},
+		},
+		{
+			name: "earliest expiry across entries is returned",
+			config: credentialprovider.DockerConfig{
+				"test.registry.io":  credentialprovider.DockerConfigEntry{Username: "user", Password: fakeJWTWithExp(t, later)},
+				"other.registry.io": credentialprovider.DockerConfigEntry{Username: "user", Password: fakeJWTWithExp(t, earlier)},
+			},
+			expectedExpiry: earlier,
+		},
+		{
+			name: "expired and far-future expiries are still returned",
+			config: credentialprovider.DockerConfig{
+				"test.registry.io":  credentialprovider.DockerConfigEntry{Username: "user", Password: fakeJWTWithExp(t, fakeClock.Now().Add(-time.Minute).Truncate(time.Second))},
+				"other.registry.io": credentialprovider.DockerConfigEntry{Username: "user", Password: fakeJWTWithExp(t, fakeClock.Now().Add(implausibleExpiryHorizon+time.Hour))},
+			},
+			expectedExpiry: fakeClock.Now().Add(-time.Minute).Truncate(time.Second),
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			expiry := p.checkCredentialClockSkew(testcase.config)
+			if !expiry.Equal(testcase.expectedExpiry) {
+				t.Errorf("expected expiry %s, got %s", testcase.expectedExpiry, expiry)
+			}
+		})
+	}
+}
@@ -17,88 +17,509 @@ limitations under the License.
 package plugin
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
 	credentialproviderv1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1"
 	"k8s.io/kubernetes/pkg/credentialprovider"
+	"k8s.io/kubernetes/pkg/features"
 	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+	"k8s.io/kubernetes/pkg/util/parsers"
 )
 
-// readCredentialProviderConfig receives a path to a config file or directory.
+// saTokenForCredentialProvidersEnabled reports whether the
+// KubeletServiceAccountTokenForCredentialProviders feature gate is enabled. It
+// is a variable so tests can inject a different gate state, and it is consulted
+// on every config load and validation rather than captured once at kubelet
+// startup, so a gate change takes effect the next time configuration is loaded.
+var saTokenForCredentialProvidersEnabled = func() bool {
+	return utilfeature.DefaultFeatureGate.Enabled(features.KubeletServiceAccountTokenForCredentialProviders)
+}
+
+// osFS exposes the host filesystem as an fs.FS so the kubelet's disk-based
+// config loading shares the fs.FS code path. Unlike os.DirFS, it passes paths
+// through unmodified, so absolute config paths keep working.
+type osFS struct{}
+
+func (osFS) Open(name string) (fs.File, error)          { return os.Open(name) }
+func (osFS) Stat(name string) (fs.FileInfo, error)      { return os.Stat(name) }
+func (osFS) ReadDir(name string) ([]fs.DirEntry, error) { return os.ReadDir(name) }
+func (osFS) ReadFile(name string) ([]byte, error)       { return os.ReadFile(name) }
+
+// readCredentialProviderConfig receives a path to a config file or directory
+// on the host filesystem and loads it with readCredentialProviderConfigFromFS.
+func readCredentialProviderConfig(configPath string, strict bool) (*kubeletconfig.CredentialProviderConfig, map[string]string, error) {
+	return readCredentialProviderConfigFromFS(osFS{}, configPath, strict)
+}
+
+// ReadCredentialProviderConfigFromFS reads credential provider configuration from
+// the given filesystem, which allows embedded distributions and tests to load
+// configuration from memory or embedded filesystems without touching disk. The
+// path may name a config file or a directory and is interpreted with the same
+// semantics the kubelet applies to --image-credential-provider-config. Invalid
+// configuration is always a fatal error.
+func ReadCredentialProviderConfigFromFS(fsys fs.FS, path string) (*kubeletconfig.CredentialProviderConfig, error) {
+	config, _, err := readCredentialProviderConfigFromFS(fsys, path, true)
+	return config, err
+}
+
+// readCredentialProviderConfigFromFS receives a path within fsys to a config file or directory.
 // If the path is a directory, it reads all "*.json", "*.yaml" and "*.yml" files in lexicographic order,
 // decodes them, and merges their entries into a single CredentialProviderConfig object.
-// If the path is a file, it decodes the file into a CredentialProviderConfig object directly
-func readCredentialProviderConfig(configPath string) (*kubeletconfig.CredentialProviderConfig, error) {
+// If the path is a file, it decodes the file into a CredentialProviderConfig object directly.
+// When strict is false and the path is a directory, invalid files are skipped with an error
+// metric and log message instead of failing the whole load, so one bad file does not take
+// down image pulls for every provider on the node.
+// The second return value maps each merged provider name to the config file it was loaded
+// from, so the resolved provider ordering can be reported with its provenance.
+func readCredentialProviderConfigFromFS(fsys fs.FS, configPath string, strict bool) (*kubeletconfig.CredentialProviderConfig, map[string]string, error) {
 	if configPath == "" {
-		return nil, fmt.Errorf("credential provider config path is empty")
+		return nil, nil, fmt.Errorf("credential provider config path is empty")
 	}
 
-	fileInfo, err := os.Stat(configPath)
+	fileInfo, err := fs.Stat(fsys, configPath)
 	if err != nil {
-		return nil, fmt.Errorf("unable to access path %q: %w", configPath, err)
+		return nil, nil, fmt.Errorf("unable to access path %q: %w", configPath, err)
 	}
 
 	var configs []*kubeletconfig.CredentialProviderConfig
+	var configSources []string
 	var configFiles []string
 
 	if fileInfo.IsDir() {
-		entries, err := os.ReadDir(configPath)
+		entries, err := fs.ReadDir(fsys, configPath)
 		if err != nil {
-			return nil, fmt.Errorf("unable to read directory %q: %w", configPath, err)
+			return nil, nil, fmt.Errorf("unable to read directory %q: %w", configPath, err)
 		}
 
 		// Filter and sort *.json/*.yaml/*.yml files in lexicographic order
 		for _, entry := range entries {
-			ext := filepath.Ext(entry.Name())
+			ext := path.Ext(entry.Name())
 			if !entry.IsDir() && (ext == ".json" || ext == ".yaml" || ext == ".yml") {
-				configFiles = append(configFiles, filepath.Join(configPath, entry.Name()))
+				configFiles = append(configFiles, path.Join(configPath, entry.Name()))
 			}
 		}
 		sort.Strings(configFiles)
 
 		if len(configFiles) == 0 {
-			return nil, fmt.Errorf("no configuration files found in directory %q", configPath)
+			return nil, nil, fmt.Errorf("no configuration files found in directory %q", configPath)
+		}
+
+		// An ambiguous load order makes provider precedence for every file in the
+		// directory unpredictable, so ordering errors are fatal even in non-strict mode.
+		configFiles, err = orderConfigFiles(fsys, configPath, configFiles)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to determine load order for directory %q: %w", configPath, err)
 		}
 	} else {
 		configFiles = append(configFiles, configPath)
 	}
 
+	// Skipping invalid files only applies in non-strict directory mode; a single
+	// invalid config file is always a fatal error.
+	tolerateInvalidFiles := fileInfo.IsDir() && !strict
+	saTokenForCredentialProviders := saTokenForCredentialProvidersEnabled()
+
+	// Files are read, decoded and validated concurrently; some node images ship
+	// dozens of auto-generated provider snippets and the per-file work is
+	// independent. Results are indexed by file so merge ordering, log output and
+	// error reporting stay deterministic regardless of which file finishes first.
+	type parsedConfigFile struct {
+		config        *kubeletconfig.CredentialProviderConfig
+		readErr       error
+		decodeErr     error
+		validationErr error
+	}
+	parsed := make([]parsedConfigFile, len(configFiles))
+	var wg sync.WaitGroup
+	for i := range configFiles {
+		wg.Add(1)
+		go func(i int, filePath string) {
+			defer wg.Done()
+
+			data, err := fs.ReadFile(fsys, filePath)
+			if err != nil {
+				parsed[i].readErr = err
+				return
+			}
+
+			config, err := decode(data)
+			if err != nil {
+				parsed[i].decodeErr = err
+				return
+			}
+			applyConfigDefaults(config)
+
+			// Per-file validation only matters when invalid files are skipped;
+			// otherwise the merged config is validated as a whole below.
+			if tolerateInvalidFiles {
+				if errs := validateCredentialProviderConfig(config, saTokenForCredentialProviders); len(errs) > 0 {
+					parsed[i].validationErr = errs.ToAggregate()
+					return
+				}
+			}
+			parsed[i].config = config
+		}(i, configFiles[i])
+	}
+	wg.Wait()
+
+	// Fatal per-file errors are collected across all files rather than failing
+	// on the first one, so operators see every broken file in one pass.
+	var fileErrors []error
+
+	for i, filePath := range configFiles {
+		switch result := parsed[i]; {
+		case result.readErr != nil:
+			if tolerateInvalidFiles {
+				kubeletCredentialProviderConfigFileErrors.Inc()
+				klog.ErrorS(result.readErr, "Skipping unreadable credential provider config file", "path", filePath)
+				continue
+			}
+			fileErrors = append(fileErrors, fmt.Errorf("unable to read file %q: %w", filePath, result.readErr))
+		case result.decodeErr != nil:
+			if tolerateInvalidFiles {
+				kubeletCredentialProviderConfigFileErrors.Inc()
+				klog.ErrorS(result.decodeErr, "Skipping undecodable credential provider config file", "path", filePath)
+				continue
+			}
+			fileErrors = append(fileErrors, fmt.Errorf("error decoding config %q: %w", filePath, result.decodeErr))
+		case result.validationErr != nil:
+			kubeletCredentialProviderConfigFileErrors.Inc()
+			klog.ErrorS(result.validationErr, "Skipping invalid credential provider config file", "path", filePath)
+		default:
+			configs = append(configs, result.config)
+			configSources = append(configSources, filePath)
+		}
+	}
+
+	if len(fileErrors) > 0 {
+		return nil, nil, utilerrors.NewAggregate(fileErrors)
+	}
+
+	if fileInfo.IsDir() && len(configs) == 0 {
+		return nil, nil, fmt.Errorf("no valid configuration files found in directory %q", configPath)
+	}
+
+	// Merge all configs into a single CredentialProviderConfig
+	mergedConfig := &kubeletconfig.CredentialProviderConfig{}
+	providerSources := make(map[string]string)
+	providerNames := sets.NewString()
+	pinnedImages := sets.NewString()
+	for i, config := range configs {
+		for _, provider := range config.Providers {
+			if provider.Override {
+				if !providerNames.Has(provider.Name) {
+					if tolerateInvalidFiles {
+						kubeletCredentialProviderConfigFileErrors.Inc()
+						klog.ErrorS(nil, "Skipping credential provider override without a target", "provider", provider.Name)
+						continue
+					}
+					return nil, nil, fmt.Errorf("provider %q is marked override: true but no earlier file defines a provider with that name", provider.Name)
+				}
+				// The earlier entry is replaced in place so the base file keeps
+				// deciding provider precedence.
+				for j := range mergedConfig.Providers {
+					if mergedConfig.Providers[j].Name == provider.Name {
+						mergedConfig.Providers[j] = provider
+						break
+					}
+				}
+				providerSources[provider.Name] = configSources[i]
+				continue
+			}
+			if providerNames.Has(provider.Name) {
+				if tolerateInvalidFiles {
+					kubeletCredentialProviderConfigFileErrors.Inc()
+					klog.ErrorS(nil, "Skipping credential provider with duplicate name", "provider", provider.Name)
+					continue
+				}
+				return nil, nil, fmt.Errorf("duplicate provider name %q found in configuration file(s)", provider.Name)
+			}
+			providerNames.Insert(provider.Name)
+			providerSources[provider.Name] = configSources[i]
+			mergedConfig.Providers = append(mergedConfig.Providers, provider)
+		}
+		for _, pin := range config.Pins {
+			if pinnedImages.Has(pin.Image) {
+				if tolerateInvalidFiles {
+					kubeletCredentialProviderConfigFileErrors.Inc()
+					klog.ErrorS(nil, "Skipping credential provider pin with duplicate image", "image", pin.Image)
+					continue
+				}
+				return nil, nil, fmt.Errorf("duplicate pinned image %q found in configuration file(s)", pin.Image)
+			}
+			pinnedImages.Insert(pin.Image)
+			mergedConfig.Pins = append(mergedConfig.Pins, pin)
+		}
+	}
+
+	return mergedConfig, providerSources, nil
+}
+
+// loadOrderFileName is the optional manifest file in a credential provider
+// config directory that pins the order in which config files are loaded.
+const loadOrderFileName = "loadOrder"
+
+// priorityFrontMatterPrefix marks the optional leading comment of a YAML config
+// file that declares its load priority, e.g. "# priority: 10".
+const priorityFrontMatterPrefix = "# priority:"
+
+// orderConfigFiles determines the order in which the config files of a directory
+// are loaded and merged. By default files load in lexicographic filename order.
+// A loadOrder manifest file in the directory, listing one config filename per
+// line (blank lines and "#" comments are ignored), loads the listed files first
+// in manifest order, with unlisted files following in lexicographic order.
+// Alternatively, YAML config files may declare a numeric priority in a leading
+// "# priority: <n>" comment; files with lower priorities load first and files
+// without a priority follow in lexicographic order. Combining both strategies,
+// declaring the same priority twice, or listing an unknown or duplicate file in
+// the manifest is an error. configFiles must already be in lexicographic order.
+func orderConfigFiles(fsys fs.FS, configPath string, configFiles []string) ([]string, error) {
+	manifest, err := readLoadOrderManifest(fsys, configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the manifest before reading any per-file front-matter so manifest
+	// errors are not masked by unreadable config files.
+	var manifestOrdered []string
+	if manifest != nil {
+		known := sets.NewString(configFiles...)
+		listed := sets.NewString()
+		for _, name := range manifest {
+			filePath := path.Join(configPath, name)
+			if !known.Has(filePath) {
+				return nil, fmt.Errorf("file %q listed in the %s manifest was not found", name, loadOrderFileName)
+			}
+			if listed.Has(filePath) {
+				return nil, fmt.Errorf("file %q is listed more than once in the %s manifest", name, loadOrderFileName)
+			}
+			listed.Insert(filePath)
+			manifestOrdered = append(manifestOrdered, filePath)
+		}
+		for _, filePath := range configFiles {
+			if !listed.Has(filePath) {
+				manifestOrdered = append(manifestOrdered, filePath)
+			}
+		}
+	}
+
+	priorities := make(map[string]int)
+	seenPriorities := make(map[int]string)
 	for _, filePath := range configFiles {
-		data, err := os.ReadFile(filePath)
+		if path.Ext(filePath) == ".json" {
+			// JSON has no comments, so JSON files cannot carry priority front-matter.
+			continue
+		}
+		priority, ok, err := readPriorityFrontMatter(fsys, filePath)
 		if err != nil {
-			return nil, fmt.Errorf("unable to read file %q: %w", filePath, err)
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if conflictingFile, conflict := seenPriorities[priority]; conflict {
+			return nil, fmt.Errorf("files %q and %q declare the same priority %d", conflictingFile, filePath, priority)
 		}
+		priorities[filePath] = priority
+		seenPriorities[priority] = filePath
+	}
 
-		config, err := decode(data)
-		if err != nil {
-			return nil, fmt.Errorf("error decoding config %q: %w", filePath, err)
+	if manifest != nil && len(priorities) > 0 {
+		return nil, fmt.Errorf("the %s manifest cannot be combined with per-file priorities", loadOrderFileName)
+	}
+
+	if manifest != nil {
+		return manifestOrdered, nil
+	}
+
+	if len(priorities) > 0 {
+		var prioritized, remaining []string
+		for _, filePath := range configFiles {
+			if _, ok := priorities[filePath]; ok {
+				prioritized = append(prioritized, filePath)
+			} else {
+				remaining = append(remaining, filePath)
+			}
 		}
-		configs = append(configs, config)
+		sort.SliceStable(prioritized, func(i, j int) bool {
+			return priorities[prioritized[i]] < priorities[prioritized[j]]
+		})
+		return append(prioritized, remaining...), nil
 	}
 
-	// Merge all configs into a single CredentialProviderConfig
+	return configFiles, nil
+}
+
+// readLoadOrderManifest reads the loadOrder manifest of a config directory. It
+// returns nil without error if the directory has no manifest.
+func readLoadOrderManifest(fsys fs.FS, configPath string) ([]string, error) {
+	manifestPath := path.Join(configPath, loadOrderFileName)
+	data, err := fs.ReadFile(fsys, manifestPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read %s manifest %q: %w", loadOrderFileName, manifestPath, err)
+	}
+
+	var names []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		names = append(names, line)
+	}
+	return names, nil
+}
+
+// readPriorityFrontMatter reads the optional priority front-matter of a YAML
+// config file. It returns ok=false if the file does not exist or does not start
+// with a priority comment.
+func readPriorityFrontMatter(fsys fs.FS, filePath string) (int, bool, error) {
+	data, err := fs.ReadFile(fsys, filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("unable to read file %q: %w", filePath, err)
+	}
+
+	firstLine, _, _ := strings.Cut(string(data), "\n")
+	firstLine = strings.TrimSpace(firstLine)
+	if !strings.HasPrefix(firstLine, priorityFrontMatterPrefix) {
+		return 0, false, nil
+	}
+
+	priority, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(firstLine, priorityFrontMatterPrefix)))
+	if err != nil {
+		return 0, false, fmt.Errorf("file %q declares an invalid priority: %w", filePath, err)
+	}
+	return priority, true, nil
+}
+
+// MergeCredentialProviderConfigs merges the given configs into a single
+// CredentialProviderConfig with the same duplicate and validation semantics the
+// kubelet applies when loading configuration files from a directory: provider
+// names must be unique across all configs, and the merged result must be a valid
+// credential provider configuration. Nil configs are skipped. This allows node
+// bootstrappers and controllers to compose provider configs programmatically
+// before writing them to disk.
+func MergeCredentialProviderConfigs(configs ...*kubeletconfig.CredentialProviderConfig) (*kubeletconfig.CredentialProviderConfig, error) {
 	mergedConfig := &kubeletconfig.CredentialProviderConfig{}
 	providerNames := sets.NewString()
+	pinnedImages := sets.NewString()
 	for _, config := range configs {
+		if config == nil {
+			continue
+		}
 		for _, provider := range config.Providers {
+			// Provider entries inherit the config-level defaults of the config they
+			// came from. The loop variable is a copy, so the caller's config is not
+			// modified.
+			applyProviderDefaults(&provider, config.Defaults)
+			if provider.Override {
+				if !providerNames.Has(provider.Name) {
+					return nil, fmt.Errorf("provider %q is marked override: true but no earlier config defines a provider with that name", provider.Name)
+				}
+				for i := range mergedConfig.Providers {
+					if mergedConfig.Providers[i].Name == provider.Name {
+						mergedConfig.Providers[i] = provider
+						break
+					}
+				}
+				continue
+			}
 			if providerNames.Has(provider.Name) {
 				return nil, fmt.Errorf("duplicate provider name %q found in configuration file(s)", provider.Name)
 			}
 			providerNames.Insert(provider.Name)
 			mergedConfig.Providers = append(mergedConfig.Providers, provider)
 		}
+		for _, pin := range config.Pins {
+			if pinnedImages.Has(pin.Image) {
+				return nil, fmt.Errorf("duplicate pinned image %q found in configuration file(s)", pin.Image)
+			}
+			pinnedImages.Insert(pin.Image)
+			mergedConfig.Pins = append(mergedConfig.Pins, pin)
+		}
+		// Any config opting out of the legacy keyring disables it for the node;
+		// a later config cannot re-enable what an earlier one disabled.
+		if config.DisableLegacyKeyring {
+			mergedConfig.DisableLegacyKeyring = true
+		}
+	}
+
+	saTokenForCredentialProviders := saTokenForCredentialProvidersEnabled()
+	if errs := validateCredentialProviderConfig(mergedConfig, saTokenForCredentialProviders); len(errs) > 0 {
+		return nil, fmt.Errorf("failed to validate credential provider config: %v", errs.ToAggregate())
 	}
 
 	return mergedConfig, nil
 }
 
+// applyConfigDefaults fills in provider fields that were omitted from provider
+// entries with the config-level defaults. It must run before validation so that
+// inherited values are validated like explicitly configured ones.
+func applyConfigDefaults(config *kubeletconfig.CredentialProviderConfig) {
+	if config.Defaults == nil {
+		return
+	}
+	for i := range config.Providers {
+		applyProviderDefaults(&config.Providers[i], config.Defaults)
+	}
+}
+
+// applyProviderDefaults applies the config-level defaults to a single provider
+// entry. Fields the provider omitted are filled in with the default value, env
+// variables are merged by name with the provider's own entries winning, and the
+// provider's defaultCacheDuration is clamped to the defaults' maxCacheDuration.
+func applyProviderDefaults(provider *kubeletconfig.CredentialProvider, defaults *kubeletconfig.CredentialProviderConfigDefaults) {
+	if defaults == nil {
+		return
+	}
+	if provider.APIVersion == "" {
+		provider.APIVersion = defaults.PluginAPIVersion
+	}
+	if provider.DefaultCacheDuration == nil && defaults.DefaultCacheDuration != nil {
+		provider.DefaultCacheDuration = &metav1.Duration{Duration: defaults.DefaultCacheDuration.Duration}
+	}
+	if defaults.MaxCacheDuration != nil && provider.DefaultCacheDuration != nil && provider.DefaultCacheDuration.Duration > defaults.MaxCacheDuration.Duration {
+		provider.DefaultCacheDuration = &metav1.Duration{Duration: defaults.MaxCacheDuration.Duration}
+	}
+	if len(defaults.Env) > 0 {
+		providerEnvNames := sets.NewString()
+		for _, env := range provider.Env {
+			providerEnvNames.Insert(env.Name)
+		}
+		merged := make([]kubeletconfig.ExecEnvVar, 0, len(defaults.Env)+len(provider.Env))
+		for _, env := range defaults.Env {
+			if !providerEnvNames.Has(env.Name) {
+				merged = append(merged, env)
+			}
+		}
+		provider.Env = append(merged, provider.Env...)
+	}
+}
+
 // decode decodes data into the internal CredentialProviderConfig type.
 func decode(data []byte) (*kubeletconfig.CredentialProviderConfig, error) {
 	obj, gvk, err := codecs.UniversalDecoder().Decode(data, nil, nil)
@@ -121,6 +542,45 @@ func decode(data []byte) (*kubeletconfig.CredentialProviderConfig, error) {
 	return nil, fmt.Errorf("unable to convert %T to *CredentialProviderConfig", obj)
 }
 
+// Default caps on the size of a credential provider configuration. Every
+// configured matchImages pattern of every provider is evaluated on each image
+// pull, so pathological configs with thousands of patterns silently degrade
+// per-pull matching latency; the defaults are far above any reasonable config
+// while still catching runaway generated ones.
+const (
+	defaultMaxProviders              = 64
+	defaultMaxMatchImagesPerProvider = 64
+)
+
+// maxMetricsLabelsPerProvider caps the operator-configured static labels per
+// provider, keeping the per-plugin metric series count bounded.
+const maxMetricsLabelsPerProvider = 8
+
+// metricsLabelNameRegexp matches valid Prometheus label names, which provider
+// metricsLabels keys must conform to since they are published as metric labels.
+var metricsLabelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// maxProviders and maxMatchImagesPerProvider are variables rather than
+// constants so embedders with unusually large but deliberate configurations
+// can raise them via SetConfigValidationLimits.
+var maxProviders = defaultMaxProviders
+var maxMatchImagesPerProvider = defaultMaxMatchImagesPerProvider
+
+// SetConfigValidationLimits overrides the caps on the number of providers in a
+// credential provider configuration and the number of matchImages patterns per
+// provider. Non-positive values restore the defaults. It must be called before
+// configuration is loaded.
+func SetConfigValidationLimits(providers, matchImagesPerProvider int) {
+	if providers <= 0 {
+		providers = defaultMaxProviders
+	}
+	if matchImagesPerProvider <= 0 {
+		matchImagesPerProvider = defaultMaxMatchImagesPerProvider
+	}
+	maxProviders = providers
+	maxMatchImagesPerProvider = matchImagesPerProvider
+}
+
 // validateCredentialProviderConfig validates CredentialProviderConfig.
 func validateCredentialProviderConfig(config *kubeletconfig.CredentialProviderConfig, saTokenForCredentialProviders bool) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -129,6 +589,43 @@ func validateCredentialProviderConfig(config *kubeletconfig.CredentialProviderCo
 		allErrs = append(allErrs, field.Required(field.NewPath("providers"), "at least 1 item in plugins is required"))
 	}
 
+	if len(config.Providers) > maxProviders {
+		allErrs = append(allErrs, field.TooMany(field.NewPath("providers"), len(config.Providers), maxProviders))
+	}
+
+	if config.Defaults != nil {
+		defaultsPath := field.NewPath("defaults")
+		if config.Defaults.PluginAPIVersion == "" && len(config.Defaults.Env) == 0 && config.Defaults.DefaultCacheDuration == nil && config.Defaults.MaxCacheDuration == nil {
+			allErrs = append(allErrs, field.Required(defaultsPath, "at least one default value is required"))
+		}
+
+		if config.Defaults.PluginAPIVersion != "" {
+			if _, ok := apiVersions[config.Defaults.PluginAPIVersion]; !ok {
+				validAPIVersions := sets.StringKeySet(apiVersions).List()
+				allErrs = append(allErrs, field.NotSupported(defaultsPath.Child("pluginAPIVersion"), config.Defaults.PluginAPIVersion, validAPIVersions))
+			}
+		}
+
+		seenDefaultEnvNames := sets.NewString()
+		for i, env := range config.Defaults.Env {
+			envPath := defaultsPath.Child("env").Index(i)
+			if env.Name == "" {
+				allErrs = append(allErrs, field.Required(envPath.Child("name"), ""))
+			} else if seenDefaultEnvNames.Has(env.Name) {
+				allErrs = append(allErrs, field.Duplicate(envPath.Child("name"), env.Name))
+			}
+			seenDefaultEnvNames.Insert(env.Name)
+		}
+
+		if config.Defaults.DefaultCacheDuration != nil && config.Defaults.DefaultCacheDuration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(defaultsPath.Child("defaultCacheDuration"), config.Defaults.DefaultCacheDuration, "must be greater than or equal to 0"))
+		}
+
+		if config.Defaults.MaxCacheDuration != nil && config.Defaults.MaxCacheDuration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(defaultsPath.Child("maxCacheDuration"), config.Defaults.MaxCacheDuration, "must be greater than 0"))
+		}
+	}
+
 	fieldPath := field.NewPath("providers")
 	seenProviderNames := sets.NewString()
 	for _, provider := range config.Providers {
@@ -164,8 +661,12 @@ func validateCredentialProviderConfig(config *kubeletconfig.CredentialProviderCo
 			allErrs = append(allErrs, field.Required(fieldPath.Child("matchImages"), "at least 1 item in matchImages is required"))
 		}
 
+		if len(provider.MatchImages) > maxMatchImagesPerProvider {
+			allErrs = append(allErrs, field.TooMany(fieldPath.Child("matchImages"), len(provider.MatchImages), maxMatchImagesPerProvider))
+		}
+
 		for _, matchImage := range provider.MatchImages {
-			if _, err := credentialprovider.ParseSchemelessURL(matchImage); err != nil {
+			if err := credentialprovider.ValidateMatchImagePattern(matchImage); err != nil {
 				allErrs = append(allErrs, field.Invalid(fieldPath.Child("matchImages"), matchImage, fmt.Sprintf("match image is invalid: %s", err.Error())))
 			}
 		}
@@ -178,14 +679,63 @@ func validateCredentialProviderConfig(config *kubeletconfig.CredentialProviderCo
 			allErrs = append(allErrs, field.Invalid(fieldPath.Child("defaultCacheDuration"), provider.DefaultCacheDuration, "must be greater than or equal to 0"))
 		}
 
+		if len(provider.Command) > 0 && !filepath.IsAbs(provider.Command[0]) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("command"), provider.Command[0], "interpreter path must be absolute"))
+		}
+
+		if len(provider.TmpDir) > 0 && !filepath.IsAbs(provider.TmpDir) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("tmpDir"), provider.TmpDir, "tmpDir must be an absolute path"))
+		}
+
+		if len(provider.BinaryByPlatform) > 0 {
+			fldPath := fieldPath.Child("binaryByPlatform")
+			if len(provider.Command) > 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "binaryByPlatform selects the provider binary and cannot be combined with command"))
+			}
+			for key, binary := range provider.BinaryByPlatform {
+				if !strings.Contains(key, "-") {
+					allErrs = append(allErrs, field.Invalid(fldPath.Key(key), key, "must be a platform key of the form <os>-<arch>, such as linux-amd64"))
+				}
+				if len(binary) == 0 {
+					allErrs = append(allErrs, field.Required(fldPath.Key(key), ""))
+				} else if filepath.IsAbs(binary) {
+					allErrs = append(allErrs, field.Invalid(fldPath.Key(key), binary, "must be a path relative to the plugin bin directory"))
+				}
+			}
+			// A map without an entry for this node's platform means the operator
+			// did not intend this provider to run here; fail the config load
+			// instead of silently falling back to a differently named binary.
+			if _, ok := provider.BinaryByPlatform[currentPlatformKey]; !ok {
+				allErrs = append(allErrs, field.Required(fldPath.Key(currentPlatformKey), fmt.Sprintf("an entry for the current platform %s is required", currentPlatformKey)))
+			}
+		}
+
 		if provider.TokenAttributes != nil {
 			fldPath := fieldPath.Child("tokenAttributes")
 			if !saTokenForCredentialProviders {
+				kubeletCredentialProviderTokenAttributesRejected.WithLabelValues(provider.Name).Inc()
 				allErrs = append(allErrs, field.Forbidden(fldPath, "tokenAttributes is not supported when KubeletServiceAccountTokenForCredentialProviders feature gate is disabled"))
 			}
-			if len(provider.TokenAttributes.ServiceAccountTokenAudience) == 0 {
+			if len(provider.TokenAttributes.ServiceAccountTokenAudience) == 0 && len(provider.TokenAttributes.ServiceAccountTokenAudiences) == 0 {
 				allErrs = append(allErrs, field.Required(fldPath.Child("serviceAccountTokenAudience"), ""))
 			}
+			seenAudiences := sets.New[string]()
+			for i, audience := range provider.TokenAttributes.ServiceAccountTokenAudiences {
+				idxPath := fldPath.Child("serviceAccountTokenAudiences").Index(i)
+				if len(audience) == 0 {
+					allErrs = append(allErrs, field.Required(idxPath, ""))
+					continue
+				}
+				if audience == provider.TokenAttributes.ServiceAccountTokenAudience {
+					allErrs = append(allErrs, field.Invalid(idxPath, audience, "must not repeat serviceAccountTokenAudience"))
+					continue
+				}
+				if seenAudiences.Has(audience) {
+					allErrs = append(allErrs, field.Duplicate(idxPath, audience))
+					continue
+				}
+				seenAudiences.Insert(audience)
+			}
 			if provider.TokenAttributes.RequireServiceAccount == nil {
 				allErrs = append(allErrs, field.Required(fldPath.Child("requireServiceAccount"), ""))
 			}
@@ -206,9 +756,222 @@ func validateCredentialProviderConfig(config *kubeletconfig.CredentialProviderCo
 			if duplicateAnnotationKeys.Len() > 0 {
 				allErrs = append(allErrs, field.Invalid(fldPath, sets.List(duplicateAnnotationKeys), "annotation keys cannot be both required and optional"))
 			}
+
+			allErrs = append(allErrs, validateTokenIdentityAllowlist(fldPath.Child("allowedNamespaces"), provider.TokenAttributes.AllowedNamespaces, validation.IsDNS1123Label)...)
+			allErrs = append(allErrs, validateTokenIdentityAllowlist(fldPath.Child("allowedServiceAccountNames"), provider.TokenAttributes.AllowedServiceAccountNames, validation.IsDNS1123Subdomain)...)
+
+			if provider.TokenAttributes.TokenExpirySlack != nil && provider.TokenAttributes.TokenExpirySlack.Duration < 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("tokenExpirySlack"), provider.TokenAttributes.TokenExpirySlack.Duration, "must not be negative"))
+			}
+		}
+
+		if provider.NodeAttributes != nil {
+			fldPath := fieldPath.Child("nodeAttributes")
+			if !provider.NodeAttributes.SendTopologyLabels && len(provider.NodeAttributes.AnnotationKeys) == 0 {
+				allErrs = append(allErrs, field.Required(fldPath, "at least one of sendTopologyLabels and annotationKeys must be set"))
+			}
+			// Node annotation keys follow the same key syntax and uniqueness rules
+			// as service account annotation keys.
+			allErrs = append(allErrs, validateServiceAccountAnnotationKeys(fldPath.Child("annotationKeys"), provider.NodeAttributes.AnnotationKeys)...)
+		}
+
+		if provider.Isolation != nil {
+			fldPath := fieldPath.Child("isolation")
+			switch provider.Isolation.NetworkNamespace {
+			case "", kubeletconfig.HostNetworkNamespace, kubeletconfig.NoneNetworkNamespace:
+			default:
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("networkNamespace"), provider.Isolation.NetworkNamespace, []string{string(kubeletconfig.HostNetworkNamespace), string(kubeletconfig.NoneNetworkNamespace)}))
+			}
+			if !provider.Isolation.MountNamespace && (provider.Isolation.NetworkNamespace == "" || provider.Isolation.NetworkNamespace == kubeletconfig.HostNetworkNamespace) {
+				allErrs = append(allErrs, field.Required(fldPath, "at least one of mountNamespace and networkNamespace: None must be set"))
+			}
+		}
+
+		if provider.SystemdScope != nil {
+			fldPath := fieldPath.Child("systemdScope")
+			if provider.SystemdScope.CPUQuotaPercent != nil && *provider.SystemdScope.CPUQuotaPercent < 1 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("cpuQuotaPercent"), *provider.SystemdScope.CPUQuotaPercent, "must be at least 1"))
+			}
+			if len(provider.SystemdScope.MemoryMax) > 0 {
+				if quantity, err := resource.ParseQuantity(provider.SystemdScope.MemoryMax); err != nil {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryMax"), provider.SystemdScope.MemoryMax, err.Error()))
+				} else if quantity.Sign() <= 0 {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryMax"), provider.SystemdScope.MemoryMax, "must be greater than zero"))
+				}
+			}
+			if provider.SystemdScope.TasksMax != nil && *provider.SystemdScope.TasksMax < 1 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("tasksMax"), *provider.SystemdScope.TasksMax, "must be at least 1"))
+			}
+		}
+
+		if provider.ProbeImage != "" {
+			if provider.TokenAttributes != nil {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("probeImage"), "probe invocations run without a pod identity and cannot be combined with tokenAttributes"))
+			}
+			matched := false
+			for _, matchImage := range provider.MatchImages {
+				if ok, _ := credentialprovider.URLsMatchStr(matchImage, provider.ProbeImage); ok {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("probeImage"), provider.ProbeImage, "must match one of the provider's matchImages patterns"))
+			}
+		}
+
+		if provider.RequiredForNodeReady && len(provider.ProbeImage) == 0 {
+			allErrs = append(allErrs, field.Required(fieldPath.Child("probeImage"), "probeImage must be set when requiredForNodeReady is true, as the probe is what determines the provider's readiness"))
+		}
+
+		switch provider.CredentialSharingPolicy {
+		case "", kubeletconfig.NodeCredentialSharingPolicy, kubeletconfig.SameNamespaceCredentialSharingPolicy, kubeletconfig.SameServiceAccountCredentialSharingPolicy:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fieldPath.Child("credentialSharingPolicy"), provider.CredentialSharingPolicy, []string{string(kubeletconfig.NodeCredentialSharingPolicy), string(kubeletconfig.SameNamespaceCredentialSharingPolicy), string(kubeletconfig.SameServiceAccountCredentialSharingPolicy)}))
+		}
+
+		if len(provider.CacheKeyTemplate) > 0 {
+			fldPath := fieldPath.Child("cacheKeyTemplate")
+			if tmpl, err := parseCacheKeyTemplate(provider.CacheKeyTemplate); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath, provider.CacheKeyTemplate, err.Error()))
+			} else if !tmpl.referencesPullIdentity() {
+				allErrs = append(allErrs, field.Invalid(fldPath, provider.CacheKeyTemplate, "must reference {{.Image}} or {{.Registry}} so credentials cached for one registry are not served for another"))
+			}
+		}
+
+		switch provider.Runtime {
+		case "", kubeletconfig.ExecCredentialProviderRuntime:
+		case kubeletconfig.WASMCredentialProviderRuntime:
+			if len(provider.Command) > 0 {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("command"), "wasm plugins run inside the kubelet and cannot be combined with an interpreter command"))
+			}
+			if provider.ReuseProcess {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("reuseProcess"), "wasm plugins are instantiated per invocation and cannot be combined with reuseProcess"))
+			}
+			if provider.Isolation != nil {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("isolation"), "wasm plugins are sandboxed by the runtime and cannot be combined with isolation"))
+			}
+			if provider.SystemdScope != nil {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("systemdScope"), "wasm plugins run inside the kubelet process and cannot be placed in a systemd scope"))
+			}
+			if len(provider.BinaryByPlatform) > 0 {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("binaryByPlatform"), "wasm modules are platform-independent and cannot be combined with binaryByPlatform"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(fieldPath.Child("runtime"), provider.Runtime, []string{string(kubeletconfig.ExecCredentialProviderRuntime), string(kubeletconfig.WASMCredentialProviderRuntime)}))
+		}
+
+		if provider.BatchRequests {
+			fldPath := fieldPath.Child("batchRequests")
+			if provider.APIVersion != credentialproviderv1.SchemeGroupVersion.String() {
+				allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("batchRequests is only supported for %s API version", credentialproviderv1.SchemeGroupVersion.String())))
+			}
+			if provider.Runtime == kubeletconfig.WASMCredentialProviderRuntime {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "wasm plugins are invoked per image and cannot be combined with batchRequests"))
+			}
+			if len(provider.CacheKeyTemplate) > 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "cacheKeyTemplate renders a cache key for a single pull and cannot be combined with batchRequests"))
+			}
+		}
+
+		for i, transform := range provider.ImageTransforms {
+			fldPath := fieldPath.Child("imageTransforms").Index(i)
+			switch transform.Type {
+			case "":
+				allErrs = append(allErrs, field.Required(fldPath.Child("type"), "type of the image transform is required"))
+			case kubeletconfig.StripDigestImageTransformType, kubeletconfig.StripTagImageTransformType:
+				if transform.MapHostname != nil {
+					allErrs = append(allErrs, field.Forbidden(fldPath.Child("mapHostname"), fmt.Sprintf("mapHostname is only valid for the %s transform type", kubeletconfig.MapHostnameImageTransformType)))
+				}
+			case kubeletconfig.MapHostnameImageTransformType:
+				if transform.MapHostname == nil {
+					allErrs = append(allErrs, field.Required(fldPath.Child("mapHostname"), fmt.Sprintf("mapHostname is required for the %s transform type", kubeletconfig.MapHostnameImageTransformType)))
+				} else {
+					if len(transform.MapHostname.From) == 0 {
+						allErrs = append(allErrs, field.Required(fldPath.Child("mapHostname", "from"), "from is required"))
+					}
+					if len(transform.MapHostname.To) == 0 {
+						allErrs = append(allErrs, field.Required(fldPath.Child("mapHostname", "to"), "to is required"))
+					}
+				}
+			default:
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), transform.Type, []string{string(kubeletconfig.StripDigestImageTransformType), string(kubeletconfig.StripTagImageTransformType), string(kubeletconfig.MapHostnameImageTransformType)}))
+			}
+		}
+
+		if provider.RefreshAheadPercent != nil && (*provider.RefreshAheadPercent < 1 || *provider.RefreshAheadPercent > 99) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("refreshAheadPercent"), *provider.RefreshAheadPercent, "must be between 1 and 99"))
+		}
+
+		if provider.CacheJitterPercent != nil && (*provider.CacheJitterPercent < 1 || *provider.CacheJitterPercent > 99) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("cacheJitterPercent"), *provider.CacheJitterPercent, "must be between 1 and 99"))
+		}
+
+		// Pod annotation keys follow the same key syntax and uniqueness rules as
+		// service account annotation keys.
+		allErrs = append(allErrs, validateServiceAccountAnnotationKeys(fieldPath.Child("requiredPodAnnotationKeys"), provider.RequiredPodAnnotationKeys)...)
+		allErrs = append(allErrs, validateServiceAccountAnnotationKeys(fieldPath.Child("optionalPodAnnotationKeys"), provider.OptionalPodAnnotationKeys)...)
+
+		requiredPodAnnotationKeys := sets.New[string](provider.RequiredPodAnnotationKeys...)
+		optionalPodAnnotationKeys := sets.New[string](provider.OptionalPodAnnotationKeys...)
+		duplicatePodAnnotationKeys := requiredPodAnnotationKeys.Intersection(optionalPodAnnotationKeys)
+		if duplicatePodAnnotationKeys.Len() > 0 {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("optionalPodAnnotationKeys"), sets.List(duplicatePodAnnotationKeys), "annotation keys cannot be both required and optional"))
+		}
+
+		if len(provider.MetricsLabels) > maxMetricsLabelsPerProvider {
+			allErrs = append(allErrs, field.TooMany(fieldPath.Child("metricsLabels"), len(provider.MetricsLabels), maxMetricsLabelsPerProvider))
+		}
+		for _, name := range sets.List(sets.KeySet(provider.MetricsLabels)) {
+			if !metricsLabelNameRegexp.MatchString(name) {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("metricsLabels"), name, "label name must match ^[a-zA-Z_][a-zA-Z0-9_]*$"))
+			}
 		}
 	}
 
+	pinsPath := field.NewPath("pins")
+	seenPinImages := sets.NewString()
+	for _, pin := range config.Pins {
+		if pin.Image == "" {
+			allErrs = append(allErrs, field.Required(pinsPath.Child("image"), ""))
+		} else if _, _, digest, err := parsers.ParseImageName(pin.Image); err != nil {
+			allErrs = append(allErrs, field.Invalid(pinsPath.Child("image"), pin.Image, fmt.Sprintf("image reference is invalid: %s", err.Error())))
+		} else if len(digest) == 0 {
+			allErrs = append(allErrs, field.Invalid(pinsPath.Child("image"), pin.Image, "pinned image must be referenced by digest"))
+		}
+
+		if seenPinImages.Has(pin.Image) {
+			allErrs = append(allErrs, field.Duplicate(pinsPath.Child("image"), pin.Image))
+		}
+		seenPinImages.Insert(pin.Image)
+
+		if pin.Provider == "" {
+			allErrs = append(allErrs, field.Required(pinsPath.Child("provider"), ""))
+		} else if !seenProviderNames.Has(pin.Provider) {
+			allErrs = append(allErrs, field.Invalid(pinsPath.Child("provider"), pin.Provider, "pinned provider is not a configured provider"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateTokenIdentityAllowlist validates the entries of a tokenAttributes
+// identity allowlist (allowedNamespaces or allowedServiceAccountNames).
+// validateEntry validates a single entry against the syntax of the identity it
+// names and returns a list of violations.
+func validateTokenIdentityAllowlist(fldPath *field.Path, entries []string, validateEntry func(string) []string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seenEntries := sets.New[string]()
+	for _, entry := range entries {
+		for _, msg := range validateEntry(entry) {
+			allErrs = append(allErrs, field.Invalid(fldPath, entry, msg))
+		}
+		if seenEntries.Has(entry) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, entry))
+		}
+		seenEntries.Insert(entry)
+	}
 	return allErrs
 }
 
@@ -18,10 +18,12 @@ package plugin
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
 	"reflect"
 	"strings"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -39,6 +41,7 @@ func Test_readCredentialProviderConfig(t *testing.T) {
 		configData []string // Array to support multiple files for directory tests
 		fileNames  []string // Optional file names for directory tests
 		isDir      bool     // Whether to create a directory with multiple files
+		nonStrict  bool     // Whether to tolerate invalid files in directory mode
 		config     *kubeletconfig.CredentialProviderConfig
 		expectErr  string
 	}{
@@ -256,6 +259,48 @@ providers:
 				},
 			},
 		},
+		{
+			name: "non-strict directory mode skips invalid file",
+			configData: []string{`---
+kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1alpha1
+providers:
+  - name: test1
+    matchImages:
+    - "registry.io/one"
+    defaultCacheDuration: 10m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1alpha1`,
+				`this is not a valid credential provider config`},
+			fileNames: []string{
+				"01-valid.yaml",
+				"02-invalid.yaml",
+			},
+			isDir:     true,
+			nonStrict: true,
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test1",
+						MatchImages:          []string{"registry.io/one"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 10 * time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+			},
+		},
+		{
+			name: "non-strict directory mode fails when no file is valid",
+			configData: []string{
+				`this is not a valid credential provider config`,
+			},
+			fileNames: []string{
+				"01-invalid.yaml",
+			},
+			isDir:     true,
+			nonStrict: true,
+			config:    nil,
+			expectErr: "no valid configuration files found in directory",
+		},
 		{
 			name: "v1beta1 config with multiple providers",
 			configData: []string{`---
@@ -348,6 +393,59 @@ providers:
 				},
 			},
 		},
+		{
+			name: "v1 config with defaults inherited by providers",
+			configData: []string{`---
+kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1
+defaults:
+  pluginAPIVersion: credentialprovider.kubelet.k8s.io/v1
+  defaultCacheDuration: 10m
+  env:
+  - name: NO_PROXY
+    value: registry.internal
+providers:
+  - name: test1
+    matchImages:
+    - "registry.io/one"
+  - name: test2
+    matchImages:
+    - "registry.io/two"
+    defaultCacheDuration: 5m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1alpha1
+    env:
+    - name: NO_PROXY
+      value: registry.two.internal`},
+
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test1",
+						MatchImages:          []string{"registry.io/one"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 10 * time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						Env: []kubeletconfig.ExecEnvVar{
+							{
+								Name:  "NO_PROXY",
+								Value: "registry.internal",
+							},
+						},
+					},
+					{
+						Name:                 "test2",
+						MatchImages:          []string{"registry.io/two"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 5 * time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+						Env: []kubeletconfig.ExecEnvVar{
+							{
+								Name:  "NO_PROXY",
+								Value: "registry.two.internal",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "config with wrong Kind",
 			configData: []string{`---
@@ -574,6 +672,71 @@ providers:
 			config:    nil,
 			expectErr: `duplicate provider name "duplicate" found in configuration file(s)`,
 		},
+		{
+			name: "later file overrides an earlier provider in place",
+			configData: []string{
+				`---
+kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1
+providers:
+  - name: base
+    matchImages:
+    - "registry.io/base"
+    defaultCacheDuration: 10m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1
+  - name: other
+    matchImages:
+    - "registry.io/other"
+    defaultCacheDuration: 10m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1`,
+				`---
+kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1
+providers:
+  - name: base
+    override: true
+    matchImages:
+    - "registry.io/site"
+    defaultCacheDuration: 5m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1`,
+			},
+			isDir: true,
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "base",
+						Override:             true,
+						MatchImages:          []string{"registry.io/site"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 5 * time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+					},
+					{
+						Name:                 "other",
+						MatchImages:          []string{"registry.io/other"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 10 * time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+					},
+				},
+			},
+		},
+		{
+			name: "override without a target, throw error",
+			configData: []string{
+				`---
+kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1
+providers:
+  - name: orphan
+    override: true
+    matchImages:
+    - "registry.io/orphan"
+    defaultCacheDuration: 10m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1`,
+			},
+			isDir:     true,
+			config:    nil,
+			expectErr: `provider "orphan" is marked override: true but no earlier file defines a provider with that name`,
+		},
 		{
 			name: "directory with mixed supported and unsupported file extensions",
 			configData: []string{
@@ -713,7 +876,7 @@ providers:
 				configPath = file.Name()
 			}
 
-			authConfig, err := readCredentialProviderConfig(configPath)
+			authConfig, _, err := readCredentialProviderConfig(configPath, !testcase.nonStrict)
 			if err != nil {
 				if len(testcase.expectErr) == 0 {
 					t.Fatal(err)
@@ -734,6 +897,304 @@ providers:
 	}
 }
 
+func Test_ReadCredentialProviderConfigFromFS(t *testing.T) {
+	validConfig := `---
+kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1
+providers:
+  - name: test
+    matchImages:
+    - "registry.io/foobar"
+    defaultCacheDuration: 10m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1
+`
+	expectedConfig := &kubeletconfig.CredentialProviderConfig{
+		Providers: []kubeletconfig.CredentialProvider{
+			{
+				Name:                 "test",
+				MatchImages:          []string{"registry.io/foobar"},
+				DefaultCacheDuration: &metav1.Duration{Duration: 10 * time.Minute},
+				APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			},
+		},
+	}
+
+	testcases := []struct {
+		name      string
+		fsys      fs.FS
+		path      string
+		config    *kubeletconfig.CredentialProviderConfig
+		expectErr string
+	}{
+		{
+			name: "single file",
+			fsys: fstest.MapFS{
+				"etc/config.yaml": &fstest.MapFile{Data: []byte(validConfig)},
+			},
+			path:   "etc/config.yaml",
+			config: expectedConfig,
+		},
+		{
+			name: "directory",
+			fsys: fstest.MapFS{
+				"etc/config.d/00-test.yaml": &fstest.MapFile{Data: []byte(validConfig)},
+				"etc/config.d/ignored.txt":  &fstest.MapFile{Data: []byte("not a config")},
+			},
+			path:   "etc/config.d",
+			config: expectedConfig,
+		},
+		{
+			name: "invalid file is always fatal",
+			fsys: fstest.MapFS{
+				"etc/config.d/00-test.yaml": &fstest.MapFile{Data: []byte(validConfig)},
+				"etc/config.d/01-bad.yaml":  &fstest.MapFile{Data: []byte("invalid yaml")},
+			},
+			path:      "etc/config.d",
+			expectErr: "error decoding config",
+		},
+		{
+			name:      "missing path",
+			fsys:      fstest.MapFS{},
+			path:      "etc/config.yaml",
+			expectErr: "unable to access path",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			config, err := ReadCredentialProviderConfigFromFS(testcase.fsys, testcase.path)
+			if testcase.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testcase.expectErr) {
+					t.Fatalf("expected error containing %q, got: %v", testcase.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(config, testcase.config) {
+				t.Logf("actual config: %v", config)
+				t.Logf("expected config: %v", testcase.config)
+				t.Error("credential provider config did not match")
+			}
+		})
+	}
+}
+
+// benchmarkConfigDirFS builds an in-memory config directory with numFiles
+// auto-generated provider snippets, mirroring node images that ship one file
+// per provider.
+func benchmarkConfigDirFS(numFiles int) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for i := 0; i < numFiles; i++ {
+		config := fmt.Sprintf(`---
+kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1
+providers:
+  - name: provider-%02d
+    matchImages:
+    - "registry-%02d.io/*"
+    defaultCacheDuration: 10m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1
+`, i, i)
+		fsys[fmt.Sprintf("etc/config.d/%02d-provider.yaml", i)] = &fstest.MapFile{Data: []byte(config)}
+	}
+	return fsys
+}
+
+func Test_readCredentialProviderConfigFromFS_deterministicOrder(t *testing.T) {
+	fsys := benchmarkConfigDirFS(32)
+
+	config, _, err := readCredentialProviderConfigFromFS(fsys, "etc/config.d", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Providers must merge in lexicographic file order no matter which file
+	// finished parsing first.
+	for i, provider := range config.Providers {
+		if expected := fmt.Sprintf("provider-%02d", i); provider.Name != expected {
+			t.Fatalf("expected provider %q at position %d, got %q", expected, i, provider.Name)
+		}
+	}
+}
+
+func Benchmark_readCredentialProviderConfigFromFS(b *testing.B) {
+	for _, numFiles := range []int{1, 8, 64} {
+		fsys := benchmarkConfigDirFS(numFiles)
+		b.Run(fmt.Sprintf("files=%d", numFiles), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, _, err := readCredentialProviderConfigFromFS(fsys, "etc/config.d", true); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func Test_readCredentialProviderConfigFromFS_aggregatesFileErrors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"etc/config.d/00-bad.yaml": &fstest.MapFile{Data: []byte("invalid yaml")},
+		"etc/config.d/01-bad.yaml": &fstest.MapFile{Data: []byte("also invalid")},
+	}
+
+	_, _, err := readCredentialProviderConfigFromFS(fsys, "etc/config.d", true)
+	if err == nil {
+		t.Fatal("expected error but got none")
+	}
+	// Every broken file should be reported in a single pass, not just the first.
+	for _, filePath := range []string{"etc/config.d/00-bad.yaml", "etc/config.d/01-bad.yaml"} {
+		if !strings.Contains(err.Error(), filePath) {
+			t.Errorf("expected error to mention %q, got: %v", filePath, err)
+		}
+	}
+}
+
+func Test_readCredentialProviderConfigFromFS_resolvedProviderOrder(t *testing.T) {
+	configFor := func(name, frontMatter string) *fstest.MapFile {
+		return &fstest.MapFile{Data: []byte(frontMatter + `kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1
+providers:
+  - name: ` + name + `
+    matchImages:
+    - "registry.io/` + name + `"
+    defaultCacheDuration: 10m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1
+`)}
+	}
+
+	// Priorities invert the lexicographic file order, and the file without a
+	// priority loads last, so the resolved provider order is not derivable from
+	// filenames alone.
+	fsys := fstest.MapFS{
+		"etc/config.d/00-zeta.yaml":  configFor("zeta", "# priority: 20\n"),
+		"etc/config.d/01-alpha.yaml": configFor("alpha", "# priority: 10\n"),
+		"etc/config.d/02-beta.yaml":  configFor("beta", ""),
+	}
+
+	expectedOrder := []string{"alpha", "zeta", "beta"}
+	expectedSources := map[string]string{
+		"alpha": "etc/config.d/01-alpha.yaml",
+		"zeta":  "etc/config.d/00-zeta.yaml",
+		"beta":  "etc/config.d/02-beta.yaml",
+	}
+
+	// The resolved ordering is the precedence contract surfaced to operators, so
+	// it must be stable across repeated loads of the same directory.
+	for i := 0; i < 5; i++ {
+		config, sources, err := readCredentialProviderConfigFromFS(fsys, "etc/config.d", true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		order := make([]string, 0, len(config.Providers))
+		for _, provider := range config.Providers {
+			order = append(order, provider.Name)
+		}
+		if !reflect.DeepEqual(order, expectedOrder) {
+			t.Fatalf("expected provider order %v on load %d, got %v", expectedOrder, i, order)
+		}
+		if !reflect.DeepEqual(sources, expectedSources) {
+			t.Fatalf("expected provider sources %v on load %d, got %v", expectedSources, i, sources)
+		}
+	}
+}
+
+func Test_orderConfigFiles(t *testing.T) {
+	testcases := []struct {
+		name        string
+		fsys        fs.FS
+		configFiles []string
+		expect      []string
+		expectErr   string
+	}{
+		{
+			name:        "lexicographic order by default",
+			fsys:        fstest.MapFS{},
+			configFiles: []string{"etc/config.d/00-a.yaml", "etc/config.d/01-b.yaml"},
+			expect:      []string{"etc/config.d/00-a.yaml", "etc/config.d/01-b.yaml"},
+		},
+		{
+			name: "loadOrder manifest pins listed files first",
+			fsys: fstest.MapFS{
+				"etc/config.d/loadOrder": &fstest.MapFile{Data: []byte("# comment\n01-b.yaml\n\n")},
+			},
+			configFiles: []string{"etc/config.d/00-a.yaml", "etc/config.d/01-b.yaml", "etc/config.d/02-c.yaml"},
+			expect:      []string{"etc/config.d/01-b.yaml", "etc/config.d/00-a.yaml", "etc/config.d/02-c.yaml"},
+		},
+		{
+			name: "loadOrder manifest referencing missing file",
+			fsys: fstest.MapFS{
+				"etc/config.d/loadOrder": &fstest.MapFile{Data: []byte("missing.yaml\n")},
+			},
+			configFiles: []string{"etc/config.d/00-a.yaml"},
+			expectErr:   `file "missing.yaml" listed in the loadOrder manifest was not found`,
+		},
+		{
+			name: "loadOrder manifest listing a file twice",
+			fsys: fstest.MapFS{
+				"etc/config.d/loadOrder": &fstest.MapFile{Data: []byte("00-a.yaml\n00-a.yaml\n")},
+			},
+			configFiles: []string{"etc/config.d/00-a.yaml"},
+			expectErr:   `file "00-a.yaml" is listed more than once in the loadOrder manifest`,
+		},
+		{
+			name: "priority front-matter orders files before unprioritized ones",
+			fsys: fstest.MapFS{
+				"etc/config.d/00-a.yaml": &fstest.MapFile{Data: []byte("kind: CredentialProviderConfig")},
+				"etc/config.d/01-b.yaml": &fstest.MapFile{Data: []byte("# priority: 20\nkind: CredentialProviderConfig")},
+				"etc/config.d/02-c.yaml": &fstest.MapFile{Data: []byte("# priority: 10\nkind: CredentialProviderConfig")},
+			},
+			configFiles: []string{"etc/config.d/00-a.yaml", "etc/config.d/01-b.yaml", "etc/config.d/02-c.yaml"},
+			expect:      []string{"etc/config.d/02-c.yaml", "etc/config.d/01-b.yaml", "etc/config.d/00-a.yaml"},
+		},
+		{
+			name: "conflicting priorities",
+			fsys: fstest.MapFS{
+				"etc/config.d/00-a.yaml": &fstest.MapFile{Data: []byte("# priority: 10\nkind: CredentialProviderConfig")},
+				"etc/config.d/01-b.yaml": &fstest.MapFile{Data: []byte("# priority: 10\nkind: CredentialProviderConfig")},
+			},
+			configFiles: []string{"etc/config.d/00-a.yaml", "etc/config.d/01-b.yaml"},
+			expectErr:   "declare the same priority 10",
+		},
+		{
+			name: "invalid priority",
+			fsys: fstest.MapFS{
+				"etc/config.d/00-a.yaml": &fstest.MapFile{Data: []byte("# priority: high\nkind: CredentialProviderConfig")},
+			},
+			configFiles: []string{"etc/config.d/00-a.yaml"},
+			expectErr:   "declares an invalid priority",
+		},
+		{
+			name: "manifest and priorities cannot be combined",
+			fsys: fstest.MapFS{
+				"etc/config.d/loadOrder": &fstest.MapFile{Data: []byte("00-a.yaml\n")},
+				"etc/config.d/00-a.yaml": &fstest.MapFile{Data: []byte("# priority: 10\nkind: CredentialProviderConfig")},
+			},
+			configFiles: []string{"etc/config.d/00-a.yaml"},
+			expectErr:   "cannot be combined with per-file priorities",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			ordered, err := orderConfigFiles(testcase.fsys, "etc/config.d", testcase.configFiles)
+			if testcase.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testcase.expectErr) {
+					t.Fatalf("expected error containing %q, got: %v", testcase.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(ordered, testcase.expect) {
+				t.Errorf("expected order %v, got %v", testcase.expect, ordered)
+			}
+		})
+	}
+}
+
 func Test_validateCredentialProviderConfig(t *testing.T) {
 	testcases := []struct {
 		name                          string
@@ -850,76 +1311,1272 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 			expectErr: `providers.name: Duplicate value: "foobar"`,
 		},
 		{
-			name: "no apiVersion",
+			name: "relative interpreter path in command",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
 						Name:                 "foobar",
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
-						APIVersion:           "",
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						Command:              []string{"python3", "provider.py"},
 					},
 				},
 			},
-			expectErr: "providers.apiVersion: Required value",
+			expectErr: `providers.command: Invalid value: "python3": interpreter path must be absolute`,
 		},
 		{
-			name: "invalid apiVersion",
+			name: "relative tmpDir",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
 						Name:                 "foobar",
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
-						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha0",
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TmpDir:               "plugin-tmp",
 					},
 				},
 			},
-			expectErr: `providers.apiVersion: Unsupported value: "credentialprovider.kubelet.k8s.io/v1alpha0": supported values: "credentialprovider.kubelet.k8s.io/v1", "credentialprovider.kubelet.k8s.io/v1alpha1", "credentialprovider.kubelet.k8s.io/v1beta1"`,
+			expectErr: `providers.tmpDir: Invalid value: "plugin-tmp": tmpDir must be an absolute path`,
 		},
 		{
-			name: "negative default cache duration",
+			name: "valid binaryByPlatform",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
 						Name:                 "foobar",
 						MatchImages:          []string{"foobar.registry.io"},
-						DefaultCacheDuration: &metav1.Duration{Duration: -1 * time.Minute},
-						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						BinaryByPlatform:     map[string]string{currentPlatformKey: "foobar-static"},
 					},
 				},
 			},
-			expectErr: "providers.defaultCacheDuration: Invalid value: \"-1m0s\": must be greater than or equal to 0",
 		},
 		{
-			name: "invalid match image",
+			name: "binaryByPlatform without an entry for the current platform",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
 						Name:                 "foobar",
-						MatchImages:          []string{"%invalid%"},
+						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
-						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						BinaryByPlatform:     map[string]string{"plan9-mips": "foobar-static"},
+					},
+				},
+			},
+			expectErr: "providers.binaryByPlatform[" + currentPlatformKey + "]: Required value: an entry for the current platform " + currentPlatformKey + " is required",
+		},
+		{
+			name: "binaryByPlatform combined with command",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						Command:              []string{"/usr/bin/python3", "provider.py"},
+						BinaryByPlatform:     map[string]string{currentPlatformKey: "foobar-static"},
+					},
+				},
+			},
+			expectErr: `providers.binaryByPlatform: Forbidden: binaryByPlatform selects the provider binary and cannot be combined with command`,
+		},
+		{
+			name: "binaryByPlatform with an absolute path",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						BinaryByPlatform:     map[string]string{currentPlatformKey: "/usr/local/bin/foobar"},
+					},
+				},
+			},
+			expectErr: `providers.binaryByPlatform[` + currentPlatformKey + `]: Invalid value: "/usr/local/bin/foobar": must be a path relative to the plugin bin directory`,
+		},
+		{
+			name: "binaryByPlatform with a malformed platform key",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						BinaryByPlatform: map[string]string{
+							currentPlatformKey: "foobar-static",
+							"amd64":            "foobar-static",
+						},
+					},
+				},
+			},
+			expectErr: `providers.binaryByPlatform[amd64]: Invalid value: "amd64": must be a platform key of the form <os>-<arch>, such as linux-amd64`,
+		},
+		{
+			name: "no apiVersion",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "",
+					},
+				},
+			},
+			expectErr: "providers.apiVersion: Required value",
+		},
+		{
+			name: "invalid apiVersion",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha0",
+					},
+				},
+			},
+			expectErr: `providers.apiVersion: Unsupported value: "credentialprovider.kubelet.k8s.io/v1alpha0": supported values: "credentialprovider.kubelet.k8s.io/v1", "credentialprovider.kubelet.k8s.io/v1alpha1", "credentialprovider.kubelet.k8s.io/v1beta1"`,
+		},
+		{
+			name: "negative default cache duration",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: -1 * time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+			},
+			expectErr: "providers.defaultCacheDuration: Invalid value: \"-1m0s\": must be greater than or equal to 0",
+		},
+		{
+			name: "invalid match image",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"%invalid%"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
 					},
 				},
 			},
 			expectErr: `providers.matchImages: Invalid value: "%invalid%": match image is invalid: parse "https://%invalid%": invalid URL escape "%in"`,
 		},
 		{
-			name: "valid config",
+			name: "match image with misplaced multi-segment wildcard",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"registry.io/**/foo"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+			},
+			expectErr: `providers.matchImages: Invalid value: "registry.io/**/foo": match image is invalid: "**" is only supported as the final path segment`,
+		},
+		{
+			name: "match image with partial multi-segment wildcard",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"registry.io/foo**"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+			},
+			expectErr: `providers.matchImages: Invalid value: "registry.io/foo**": match image is invalid: "**" must be a full path segment`,
+		},
+		{
+			name: "match images with IPv6 literal registries",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"[2001:db8::1]:5000/repo", "2001:db8::1/repo"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+					},
+				},
+			},
+		},
+		{
+			name: "match image with malformed IPv6 literal",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"[2001:db8::1/repo"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+					},
+				},
+			},
+			expectErr: `providers.matchImages: Invalid value: "[2001:db8::1/repo": match image is invalid: parse "https://[2001:db8::1/repo": missing ']' in host`,
+		},
+		{
+			name: "empty node attributes",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						NodeAttributes:       &kubeletconfig.NodeAttributes{},
+					},
+				},
+			},
+			expectErr: `providers.nodeAttributes: Required value: at least one of sendTopologyLabels and annotationKeys must be set`,
+		},
+		{
+			name: "node attributes with invalid annotation key",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						NodeAttributes: &kubeletconfig.NodeAttributes{
+							AnnotationKeys: []string{"invalid annotation key"},
+						},
+					},
+				},
+			},
+			expectErr: `providers.nodeAttributes.annotationKeys: Invalid value: "invalid annotation key": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+		},
+		{
+			name: "valid node attributes",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						NodeAttributes: &kubeletconfig.NodeAttributes{
+							SendTopologyLabels: true,
+							AnnotationKeys:     []string{"node.example.com/sts-endpoint"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pin without digest",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Pins: []kubeletconfig.CredentialProviderPin{
+					{
+						Image:    "foobar.registry.io/pause:3.10",
+						Provider: "foobar",
+					},
+				},
+			},
+			expectErr: `pins.image: Invalid value: "foobar.registry.io/pause:3.10": pinned image must be referenced by digest`,
+		},
+		{
+			name: "pin to unknown provider",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Pins: []kubeletconfig.CredentialProviderPin{
+					{
+						Image:    "foobar.registry.io/pause@sha256:7031c1b283388d2c2e09b57badb803c05ebed362dc88d84b480cc47f72a21097",
+						Provider: "unknown",
+					},
+				},
+			},
+			expectErr: `pins.provider: Invalid value: "unknown": pinned provider is not a configured provider`,
+		},
+		{
+			name: "duplicate pinned image",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Pins: []kubeletconfig.CredentialProviderPin{
+					{
+						Image:    "foobar.registry.io/pause@sha256:7031c1b283388d2c2e09b57badb803c05ebed362dc88d84b480cc47f72a21097",
+						Provider: "foobar",
+					},
+					{
+						Image:    "foobar.registry.io/pause@sha256:7031c1b283388d2c2e09b57badb803c05ebed362dc88d84b480cc47f72a21097",
+						Provider: "foobar",
+					},
+				},
+			},
+			expectErr: `pins.image: Duplicate value: "foobar.registry.io/pause@sha256:7031c1b283388d2c2e09b57badb803c05ebed362dc88d84b480cc47f72a21097"`,
+		},
+		{
+			name: "valid cache jitter percent",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						CacheJitterPercent:   ptr.To[int32](20),
+					},
+				},
+			},
+		},
+		{
+			name: "cache jitter percent out of range",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						CacheJitterPercent:   ptr.To[int32](0),
+					},
+				},
+			},
+			expectErr: `providers.cacheJitterPercent: Invalid value: 0: must be between 1 and 99`,
+		},
+		{
+			name: "valid systemd scope",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						SystemdScope: &kubeletconfig.CredentialProviderSystemdScope{
+							CPUQuotaPercent: ptr.To[int32](50),
+							MemoryMax:       "128Mi",
+							TasksMax:        ptr.To[int64](16),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "systemd scope cpu quota percent below one",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						SystemdScope: &kubeletconfig.CredentialProviderSystemdScope{
+							CPUQuotaPercent: ptr.To[int32](0),
+						},
+					},
+				},
+			},
+			expectErr: `providers.systemdScope.cpuQuotaPercent: Invalid value: 0: must be at least 1`,
+		},
+		{
+			name: "systemd scope memory max is not a quantity",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						SystemdScope: &kubeletconfig.CredentialProviderSystemdScope{
+							MemoryMax: "lots",
+						},
+					},
+				},
+			},
+			expectErr: `providers.systemdScope.memoryMax: Invalid value: "lots": quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'`,
+		},
+		{
+			name: "refresh ahead percent out of range",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+						RefreshAheadPercent:  ptr.To[int32](100),
+					},
+				},
+			},
+			expectErr: `providers.refreshAheadPercent: Invalid value: 100: must be between 1 and 99`,
+		},
+		{
+			name: "valid refresh ahead percent",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+						RefreshAheadPercent:  ptr.To[int32](50),
+					},
+				},
+			},
+		},
+		{
+			name: "invalid pod annotation key",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                      "foobar",
+						MatchImages:               []string{"foobar.registry.io"},
+						DefaultCacheDuration:      &metav1.Duration{Duration: time.Minute},
+						APIVersion:                "credentialprovider.kubelet.k8s.io/v1alpha1",
+						RequiredPodAnnotationKeys: []string{"cantendwithadash-"},
+					},
+				},
+			},
+			expectErr: `providers.requiredPodAnnotationKeys: Invalid value: "cantendwithadash-": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+		},
+		{
+			name: "pod annotation key both required and optional",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                      "foobar",
+						MatchImages:               []string{"foobar.registry.io"},
+						DefaultCacheDuration:      &metav1.Duration{Duration: time.Minute},
+						APIVersion:                "credentialprovider.kubelet.k8s.io/v1alpha1",
+						RequiredPodAnnotationKeys: []string{"example.com/tenant", "example.com/team"},
+						OptionalPodAnnotationKeys: []string{"example.com/tenant"},
+					},
+				},
+			},
+			expectErr: `providers.optionalPodAnnotationKeys: Invalid value: ["example.com/tenant"]: annotation keys cannot be both required and optional`,
+		},
+		{
+			name: "valid pod annotation keys",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                      "foobar",
+						MatchImages:               []string{"foobar.registry.io"},
+						DefaultCacheDuration:      &metav1.Duration{Duration: time.Minute},
+						APIVersion:                "credentialprovider.kubelet.k8s.io/v1alpha1",
+						RequiredPodAnnotationKeys: []string{"example.com/tenant"},
+						OptionalPodAnnotationKeys: []string{"example.com/team"},
+					},
+				},
+			},
+		},
+		{
+			name: "valid pins",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Pins: []kubeletconfig.CredentialProviderPin{
+					{
+						Image:    "foobar.registry.io/pause@sha256:7031c1b283388d2c2e09b57badb803c05ebed362dc88d84b480cc47f72a21097",
+						Provider: "foobar",
+					},
+				},
+			},
+		},
+		{
+			name: "metrics label name is not a valid label name",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						MetricsLabels:        map[string]string{"team-name": "storage"},
+					},
+				},
+			},
+			expectErr: `providers.metricsLabels: Invalid value: "team-name": label name must match ^[a-zA-Z_][a-zA-Z0-9_]*$`,
+		},
+		{
+			name: "valid metrics labels",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						MetricsLabels:        map[string]string{"team": "storage", "tier": "critical"},
+					},
+				},
+			},
+		},
+		{
+			name: "empty defaults",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Defaults: &kubeletconfig.CredentialProviderConfigDefaults{},
+			},
+			expectErr: `defaults: Required value: at least one default value is required`,
+		},
+		{
+			name: "defaults with unsupported pluginAPIVersion",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Defaults: &kubeletconfig.CredentialProviderConfigDefaults{
+					PluginAPIVersion: "credentialprovider.kubelet.k8s.io/v1alpha0",
+				},
+			},
+			expectErr: `defaults.pluginAPIVersion: Unsupported value: "credentialprovider.kubelet.k8s.io/v1alpha0": supported values: "credentialprovider.kubelet.k8s.io/v1", "credentialprovider.kubelet.k8s.io/v1alpha1", "credentialprovider.kubelet.k8s.io/v1beta1"`,
+		},
+		{
+			name: "defaults with negative defaultCacheDuration",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Defaults: &kubeletconfig.CredentialProviderConfigDefaults{
+					DefaultCacheDuration: &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			expectErr: `defaults.defaultCacheDuration: Invalid value: "-1m0s": must be greater than or equal to 0`,
+		},
+		{
+			name: "defaults with zero maxCacheDuration",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Defaults: &kubeletconfig.CredentialProviderConfigDefaults{
+					MaxCacheDuration: &metav1.Duration{Duration: 0},
+				},
+			},
+			expectErr: `defaults.maxCacheDuration: Invalid value: "0s": must be greater than 0`,
+		},
+		{
+			name: "defaults env without a name",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Defaults: &kubeletconfig.CredentialProviderConfigDefaults{
+					Env: []kubeletconfig.ExecEnvVar{{Value: "BAR"}},
+				},
+			},
+			expectErr: `defaults.env[0].name: Required value`,
+		},
+		{
+			name: "defaults env with duplicate names",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Defaults: &kubeletconfig.CredentialProviderConfigDefaults{
+					Env: []kubeletconfig.ExecEnvVar{
+						{Name: "FOO", Value: "BAR"},
+						{Name: "FOO", Value: "BAZ"},
+					},
+				},
+			},
+			expectErr: `defaults.env[1].name: Duplicate value: "FOO"`,
+		},
+		{
+			name: "valid defaults",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+				Defaults: &kubeletconfig.CredentialProviderConfigDefaults{
+					PluginAPIVersion:     "credentialprovider.kubelet.k8s.io/v1",
+					Env:                  []kubeletconfig.ExecEnvVar{{Name: "NO_PROXY", Value: "registry.internal"}},
+					DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+					MaxCacheDuration:     &metav1.Duration{Duration: time.Hour},
+				},
+			},
+		},
+		{
+			name: "valid config",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+					},
+				},
+			},
+		},
+		{
+			name: "token attributes set without KubeletServiceAccountTokenForCredentialProviders feature gate enabled",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience: "audience",
+							RequireServiceAccount:       ptr.To(true),
+						},
+					},
+				},
+			},
+			expectErr: `providers.tokenAttributes: Forbidden: tokenAttributes is not supported when KubeletServiceAccountTokenForCredentialProviders feature gate is disabled`,
+		},
+		{
+			name: "token attributes not nil but empty ServiceAccountTokenAudience",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							RequiredServiceAccountAnnotationKeys: []string{"prefix.io/annotation-1", "prefix.io/annotation-2"},
+							RequireServiceAccount:                ptr.To(true),
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.serviceAccountTokenAudience: Required value`,
+		},
+		{
+			name: "token attributes with an audience list and no singular audience",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudiences: []string{"audience", "federated"},
+							RequireServiceAccount:        ptr.To(true),
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+		},
+		{
+			name: "token attributes with duplicate audience list entries",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudiences: []string{"federated", "federated"},
+							RequireServiceAccount:        ptr.To(true),
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.serviceAccountTokenAudiences[1]: Duplicate value: "federated"`,
+		},
+		{
+			name: "token attributes with an audience list entry repeating the singular audience",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:  "audience",
+							ServiceAccountTokenAudiences: []string{"audience"},
+							RequireServiceAccount:        ptr.To(true),
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.serviceAccountTokenAudiences[0]: Invalid value: "audience": must not repeat serviceAccountTokenAudience`,
+		},
+		{
+			name: "token attributes not nil but empty ServiceAccountTokenRequired",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequiredServiceAccountAnnotationKeys: []string{"prefix.io/annotation-1", "prefix.io/annotation-2"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.requireServiceAccount: Required value`,
+		},
+		{
+			name: "required service account annotation keys not qualified name (same validation as metav1.ObjectMeta)",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequireServiceAccount:                ptr.To(true),
+							RequiredServiceAccountAnnotationKeys: []string{"cantendwithadash-", "now-with-dashes/simple"}, // first key is invalid
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.requiredServiceAccountAnnotationKeys: Invalid value: "cantendwithadash-": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+		},
+		{
+			name: "optional service account annotation keys not qualified name (same validation as metav1.ObjectMeta)",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequireServiceAccount:                ptr.To(true),
+							OptionalServiceAccountAnnotationKeys: []string{"cantendwithadash-", "now-with-dashes/simple"}, // first key is invalid
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.optionalServiceAccountAnnotationKeys: Invalid value: "cantendwithadash-": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+		},
+		{
+			name: "duplicate required service account annotation keys",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequireServiceAccount:                ptr.To(true),
+							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple", "now-with-dashes/simple"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.requiredServiceAccountAnnotationKeys: Duplicate value: "now-with-dashes/simple"`,
+		},
+		{
+			name: "duplicate optional service account annotation keys",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequireServiceAccount:                ptr.To(true),
+							OptionalServiceAccountAnnotationKeys: []string{"now-with-dashes/simple", "now-with-dashes/simple"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.optionalServiceAccountAnnotationKeys: Duplicate value: "now-with-dashes/simple"`,
+		},
+		{
+			name: "annotation key in required and optional keys",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequireServiceAccount:                ptr.To(true),
+							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-1", "now-with-dashes/simple-2"},
+							OptionalServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-2", "now-with-dashes/simple-3"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes: Invalid value: ["now-with-dashes/simple-2"]: annotation keys cannot be both required and optional`,
+		},
+		{
+			name: "required annotation keys set when requireServiceAccount is false",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequireServiceAccount:                ptr.To(false),
+							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-1", "now-with-dashes/simple-2"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.requiredServiceAccountAnnotationKeys: Forbidden: requireServiceAccount cannot be false when requiredServiceAccountAnnotationKeys is set`,
+		},
+		{
+			name: "valid config with KubeletServiceAccountTokenForCredentialProviders feature gate enabled",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequireServiceAccount:                ptr.To(true),
+							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-1", "now-with-dashes/simple-2"},
+							OptionalServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-3"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+		},
+		{
+			name: "valid config with identity allowlists",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience: "audience",
+							RequireServiceAccount:       ptr.To(true),
+							AllowedNamespaces:           []string{"prod", "staging"},
+							AllowedServiceAccountNames:  []string{"image-puller"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+		},
+		{
+			name: "invalid namespace in allowedNamespaces",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience: "audience",
+							RequireServiceAccount:       ptr.To(true),
+							AllowedNamespaces:           []string{"Not_A_Namespace"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.allowedNamespaces: Invalid value: "Not_A_Namespace": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`,
+		},
+		{
+			name: "duplicate entry in allowedServiceAccountNames",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience: "audience",
+							RequireServiceAccount:       ptr.To(true),
+							AllowedServiceAccountNames:  []string{"image-puller", "image-puller"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.allowedServiceAccountNames: Duplicate value: "image-puller"`,
+		},
+		{
+			name: "valid config with tokenExpirySlack",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience: "audience",
+							RequireServiceAccount:       ptr.To(true),
+							TokenExpirySlack:            &metav1.Duration{Duration: 30 * time.Second},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+		},
+		{
+			name: "negative tokenExpirySlack",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience: "audience",
+							RequireServiceAccount:       ptr.To(true),
+							TokenExpirySlack:            &metav1.Duration{Duration: -time.Second},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes.tokenExpirySlack: Invalid value: -1000000000: must not be negative`,
+		},
+		{
+			name: "tokenAttributes set with credentialprovider.kubelet.k8s.io/v1alpha1 APIVersion",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequireServiceAccount:                ptr.To(true),
+							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes: Forbidden: tokenAttributes is only supported for credentialprovider.kubelet.k8s.io/v1 API version`,
+		},
+		{
+			name: "tokenAttributes set with credentialprovider.kubelet.k8s.io/v1beta1 APIVersion",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1beta1",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience:          "audience",
+							RequireServiceAccount:                ptr.To(true),
+							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple"},
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.tokenAttributes: Forbidden: tokenAttributes is only supported for credentialprovider.kubelet.k8s.io/v1 API version`,
+		},
+		{
+			name: "valid isolation config",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						Isolation: &kubeletconfig.CredentialProviderIsolation{
+							MountNamespace:   true,
+							NetworkNamespace: kubeletconfig.NoneNetworkNamespace,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "isolation with invalid networkNamespace",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						Isolation: &kubeletconfig.CredentialProviderIsolation{
+							NetworkNamespace: "Bridge",
+						},
+					},
+				},
+			},
+			expectErr: `providers.isolation.networkNamespace: Unsupported value: "Bridge": supported values: "Host", "None"`,
+		},
+		{
+			name: "isolation without any namespace configured",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						Isolation:            &kubeletconfig.CredentialProviderIsolation{},
+					},
+				},
+			},
+			expectErr: `providers.isolation: Required value: at least one of mountNamespace and networkNamespace: None must be set`,
+		},
+		{
+			name: "valid credentialSharingPolicy",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                    "foobar",
+						MatchImages:             []string{"foobar.registry.io"},
+						DefaultCacheDuration:    &metav1.Duration{Duration: time.Minute},
+						APIVersion:              "credentialprovider.kubelet.k8s.io/v1",
+						CredentialSharingPolicy: kubeletconfig.SameServiceAccountCredentialSharingPolicy,
+					},
+				},
+			},
+		},
+		{
+			name: "invalid credentialSharingPolicy",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                    "foobar",
+						MatchImages:             []string{"foobar.registry.io"},
+						DefaultCacheDuration:    &metav1.Duration{Duration: time.Minute},
+						APIVersion:              "credentialprovider.kubelet.k8s.io/v1",
+						CredentialSharingPolicy: "SamePod",
+					},
+				},
+			},
+			expectErr: `providers.credentialSharingPolicy: Unsupported value: "SamePod": supported values: "Node", "SameNamespace", "SameServiceAccount"`,
+		},
+		{
+			name: "valid probeImage",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						ProbeImage:           "foobar.registry.io/pause",
+					},
+				},
+			},
+		},
+		{
+			name: "probeImage not matching any matchImages pattern",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						ProbeImage:           "other.registry.io/pause",
+					},
+				},
+			},
+			expectErr: `providers.probeImage: Invalid value: "other.registry.io/pause": must match one of the provider's matchImages patterns`,
+		},
+		{
+			name: "probeImage combined with tokenAttributes",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						ProbeImage:           "foobar.registry.io/pause",
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience: "audience",
+							RequireServiceAccount:       ptr.To(true),
+						},
+					},
+				},
+			},
+			saTokenForCredentialProviders: true,
+			expectErr:                     `providers.probeImage: Forbidden: probe invocations run without a pod identity and cannot be combined with tokenAttributes`,
+		},
+		{
+			name: "requiredForNodeReady with a probeImage",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						ProbeImage:           "foobar.registry.io/pause",
+						RequiredForNodeReady: true,
+					},
+				},
+			},
+		},
+		{
+			name: "requiredForNodeReady without a probeImage",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						RequiredForNodeReady: true,
+					},
+				},
+			},
+			expectErr: `providers.probeImage: Required value: probeImage must be set when requiredForNodeReady is true, as the probe is what determines the provider's readiness`,
+		},
+		{
+			name: "valid cacheKeyTemplate",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						CacheKeyTemplate:     "{{.Registry}}/{{.Namespace}}",
+					},
+				},
+			},
+		},
+		{
+			name: "cacheKeyTemplate referencing an unknown variable",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						CacheKeyTemplate:     "{{.Registry}}/{{.PodName}}",
+					},
+				},
+			},
+			expectErr: `providers.cacheKeyTemplate: Invalid value: "{{.Registry}}/{{.PodName}}": unknown variable "{{.PodName}}", allowed variables are {{.Image}}, {{.Registry}}, {{.Namespace}}, {{.ServiceAccountName}}`,
+		},
+		{
+			name: "cacheKeyTemplate without image or registry",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
 						Name:                 "foobar",
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
-						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						CacheKeyTemplate:     "{{.Namespace}}/{{.ServiceAccountName}}",
 					},
 				},
 			},
+			expectErr: `providers.cacheKeyTemplate: Invalid value: "{{.Namespace}}/{{.ServiceAccountName}}": must reference {{.Image}} or {{.Registry}} so credentials cached for one registry are not served for another`,
 		},
 		{
-			name: "token attributes set without KubeletServiceAccountTokenForCredentialProviders feature gate enabled",
+			name: "valid wasm runtime",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -927,17 +2584,13 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience: "audience",
-							RequireServiceAccount:       ptr.To(true),
-						},
+						Runtime:              kubeletconfig.WASMCredentialProviderRuntime,
 					},
 				},
 			},
-			expectErr: `providers.tokenAttributes: Forbidden: tokenAttributes is not supported when KubeletServiceAccountTokenForCredentialProviders feature gate is disabled`,
 		},
 		{
-			name: "token attributes not nil but empty ServiceAccountTokenAudience",
+			name: "unknown runtime",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -945,18 +2598,14 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							RequiredServiceAccountAnnotationKeys: []string{"prefix.io/annotation-1", "prefix.io/annotation-2"},
-							RequireServiceAccount:                ptr.To(true),
-						},
+						Runtime:              "jvm",
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes.serviceAccountTokenAudience: Required value`,
+			expectErr: `providers.runtime: Unsupported value: "jvm": supported values: "exec", "wasm"`,
 		},
 		{
-			name: "token attributes not nil but empty ServiceAccountTokenRequired",
+			name: "wasm runtime combined with reuseProcess",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -964,18 +2613,15 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequiredServiceAccountAnnotationKeys: []string{"prefix.io/annotation-1", "prefix.io/annotation-2"},
-						},
+						Runtime:              kubeletconfig.WASMCredentialProviderRuntime,
+						ReuseProcess:         true,
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes.requireServiceAccount: Required value`,
+			expectErr: `providers.reuseProcess: Forbidden: wasm plugins are instantiated per invocation and cannot be combined with reuseProcess`,
 		},
 		{
-			name: "required service account annotation keys not qualified name (same validation as metav1.ObjectMeta)",
+			name: "wasm runtime combined with systemdScope",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -983,19 +2629,15 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequireServiceAccount:                ptr.To(true),
-							RequiredServiceAccountAnnotationKeys: []string{"cantendwithadash-", "now-with-dashes/simple"}, // first key is invalid
-						},
+						Runtime:              kubeletconfig.WASMCredentialProviderRuntime,
+						SystemdScope:         &kubeletconfig.CredentialProviderSystemdScope{},
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes.requiredServiceAccountAnnotationKeys: Invalid value: "cantendwithadash-": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+			expectErr: `providers.systemdScope: Forbidden: wasm plugins run inside the kubelet process and cannot be placed in a systemd scope`,
 		},
 		{
-			name: "optional service account annotation keys not qualified name (same validation as metav1.ObjectMeta)",
+			name: "wasm runtime combined with command",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -1003,19 +2645,15 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequireServiceAccount:                ptr.To(true),
-							OptionalServiceAccountAnnotationKeys: []string{"cantendwithadash-", "now-with-dashes/simple"}, // first key is invalid
-						},
+						Runtime:              kubeletconfig.WASMCredentialProviderRuntime,
+						Command:              []string{"/usr/bin/python3"},
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes.optionalServiceAccountAnnotationKeys: Invalid value: "cantendwithadash-": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+			expectErr: `providers.command: Forbidden: wasm plugins run inside the kubelet and cannot be combined with an interpreter command`,
 		},
 		{
-			name: "duplicate required service account annotation keys",
+			name: "valid batchRequests",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -1023,19 +2661,28 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequireServiceAccount:                ptr.To(true),
-							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple", "now-with-dashes/simple"},
-						},
+						BatchRequests:        true,
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes.requiredServiceAccountAnnotationKeys: Duplicate value: "now-with-dashes/simple"`,
 		},
 		{
-			name: "duplicate optional service account annotation keys",
+			name: "batchRequests with an API version without the images field",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1beta1",
+						BatchRequests:        true,
+					},
+				},
+			},
+			expectErr: `providers.batchRequests: Forbidden: batchRequests is only supported for credentialprovider.kubelet.k8s.io/v1 API version`,
+		},
+		{
+			name: "batchRequests combined with wasm runtime",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -1043,19 +2690,15 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequireServiceAccount:                ptr.To(true),
-							OptionalServiceAccountAnnotationKeys: []string{"now-with-dashes/simple", "now-with-dashes/simple"},
-						},
+						Runtime:              kubeletconfig.WASMCredentialProviderRuntime,
+						BatchRequests:        true,
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes.optionalServiceAccountAnnotationKeys: Duplicate value: "now-with-dashes/simple"`,
+			expectErr: `providers.batchRequests: Forbidden: wasm plugins are invoked per image and cannot be combined with batchRequests`,
 		},
 		{
-			name: "annotation key in required and optional keys",
+			name: "batchRequests combined with cacheKeyTemplate",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -1063,20 +2706,15 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequireServiceAccount:                ptr.To(true),
-							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-1", "now-with-dashes/simple-2"},
-							OptionalServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-2", "now-with-dashes/simple-3"},
-						},
+						CacheKeyTemplate:     "{{.Registry}}/{{.Namespace}}",
+						BatchRequests:        true,
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes: Invalid value: ["now-with-dashes/simple-2"]: annotation keys cannot be both required and optional`,
+			expectErr: `providers.batchRequests: Forbidden: cacheKeyTemplate renders a cache key for a single pull and cannot be combined with batchRequests`,
 		},
 		{
-			name: "required annotation keys set when requireServiceAccount is false",
+			name: "valid imageTransforms",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -1084,19 +2722,17 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequireServiceAccount:                ptr.To(false),
-							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-1", "now-with-dashes/simple-2"},
+						ImageTransforms: []kubeletconfig.ImageTransform{
+							{Type: kubeletconfig.StripDigestImageTransformType},
+							{Type: kubeletconfig.StripTagImageTransformType},
+							{Type: kubeletconfig.MapHostnameImageTransformType, MapHostname: &kubeletconfig.HostnameMapping{From: "legacy.registry.io", To: "foobar.registry.io"}},
 						},
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes.requiredServiceAccountAnnotationKeys: Forbidden: requireServiceAccount cannot be false when requiredServiceAccountAnnotationKeys is set`,
 		},
 		{
-			name: "valid config with KubeletServiceAccountTokenForCredentialProviders feature gate enabled",
+			name: "image transform without a type",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
@@ -1104,56 +2740,71 @@ func Test_validateCredentialProviderConfig(t *testing.T) {
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
 						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequireServiceAccount:                ptr.To(true),
-							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-1", "now-with-dashes/simple-2"},
-							OptionalServiceAccountAnnotationKeys: []string{"now-with-dashes/simple-3"},
-						},
+						ImageTransforms:      []kubeletconfig.ImageTransform{{}},
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
+			expectErr: `providers.imageTransforms[0].type: Required value: type of the image transform is required`,
 		},
 		{
-			name: "tokenAttributes set with credentialprovider.kubelet.k8s.io/v1alpha1 APIVersion",
+			name: "image transform with an unknown type",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
 						Name:                 "foobar",
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
-						APIVersion:           "credentialprovider.kubelet.k8s.io/v1alpha1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequireServiceAccount:                ptr.To(true),
-							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple"},
-						},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						ImageTransforms:      []kubeletconfig.ImageTransform{{Type: "LowerCase"}},
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes: Forbidden: tokenAttributes is only supported for credentialprovider.kubelet.k8s.io/v1 API version`,
+			expectErr: `providers.imageTransforms[0].type: Unsupported value: "LowerCase": supported values: "StripDigest", "StripTag", "MapHostname"`,
 		},
 		{
-			name: "tokenAttributes set with credentialprovider.kubelet.k8s.io/v1beta1 APIVersion",
+			name: "map hostname transform without a mapping",
 			config: &kubeletconfig.CredentialProviderConfig{
 				Providers: []kubeletconfig.CredentialProvider{
 					{
 						Name:                 "foobar",
 						MatchImages:          []string{"foobar.registry.io"},
 						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
-						APIVersion:           "credentialprovider.kubelet.k8s.io/v1beta1",
-						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
-							ServiceAccountTokenAudience:          "audience",
-							RequireServiceAccount:                ptr.To(true),
-							RequiredServiceAccountAnnotationKeys: []string{"now-with-dashes/simple"},
-						},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						ImageTransforms:      []kubeletconfig.ImageTransform{{Type: kubeletconfig.MapHostnameImageTransformType}},
 					},
 				},
 			},
-			saTokenForCredentialProviders: true,
-			expectErr:                     `providers.tokenAttributes: Forbidden: tokenAttributes is only supported for credentialprovider.kubelet.k8s.io/v1 API version`,
+			expectErr: `providers.imageTransforms[0].mapHostname: Required value: mapHostname is required for the MapHostname transform type`,
+		},
+		{
+			name: "map hostname transform with an empty mapping",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						ImageTransforms:      []kubeletconfig.ImageTransform{{Type: kubeletconfig.MapHostnameImageTransformType, MapHostname: &kubeletconfig.HostnameMapping{}}},
+					},
+				},
+			},
+			expectErr: `[providers.imageTransforms[0].mapHostname.from: Required value: from is required, providers.imageTransforms[0].mapHostname.to: Required value: to is required]`,
+		},
+		{
+			name: "strip transform with a hostname mapping",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "foobar",
+						MatchImages:          []string{"foobar.registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						ImageTransforms:      []kubeletconfig.ImageTransform{{Type: kubeletconfig.StripTagImageTransformType, MapHostname: &kubeletconfig.HostnameMapping{From: "legacy.registry.io", To: "foobar.registry.io"}}},
+					},
+				},
+			},
+			expectErr: `providers.imageTransforms[0].mapHostname: Forbidden: mapHostname is only valid for the MapHostname transform type`,
 		},
 	}
 
@@ -1173,3 +2824,282 @@ func errString(errs errors.Aggregate) string {
 	}
 	return ""
 }
+
+func Test_MergeCredentialProviderConfigs(t *testing.T) {
+	validProvider := func(name string) kubeletconfig.CredentialProvider {
+		return kubeletconfig.CredentialProvider{
+			Name:                 name,
+			MatchImages:          []string{"registry.io/" + name},
+			DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+			APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+		}
+	}
+
+	testcases := []struct {
+		name      string
+		configs   []*kubeletconfig.CredentialProviderConfig
+		expected  *kubeletconfig.CredentialProviderConfig
+		expectErr string
+	}{
+		{
+			name: "merges providers from multiple configs",
+			configs: []*kubeletconfig.CredentialProviderConfig{
+				{Providers: []kubeletconfig.CredentialProvider{validProvider("test1")}},
+				nil,
+				{Providers: []kubeletconfig.CredentialProvider{validProvider("test2")}},
+			},
+			expected: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{validProvider("test1"), validProvider("test2")},
+			},
+		},
+		{
+			name: "providers inherit config-level default apiVersion",
+			configs: []*kubeletconfig.CredentialProviderConfig{
+				{
+					Providers: []kubeletconfig.CredentialProvider{
+						{
+							Name:                 "test1",
+							MatchImages:          []string{"registry.io/test1"},
+							DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						},
+					},
+					Defaults: &kubeletconfig.CredentialProviderConfigDefaults{
+						PluginAPIVersion: "credentialprovider.kubelet.k8s.io/v1",
+					},
+				},
+			},
+			expected: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{validProvider("test1")},
+			},
+		},
+		{
+			name: "providers inherit env and cache duration defaults",
+			configs: []*kubeletconfig.CredentialProviderConfig{
+				{
+					Providers: []kubeletconfig.CredentialProvider{
+						{
+							Name:        "test1",
+							MatchImages: []string{"registry.io/test1"},
+							Env:         []kubeletconfig.ExecEnvVar{{Name: "HTTPS_PROXY", Value: "https://proxy.test1.internal"}},
+						},
+						{
+							Name:                 "test2",
+							MatchImages:          []string{"registry.io/test2"},
+							DefaultCacheDuration: &metav1.Duration{Duration: time.Hour},
+						},
+					},
+					Defaults: &kubeletconfig.CredentialProviderConfigDefaults{
+						PluginAPIVersion: "credentialprovider.kubelet.k8s.io/v1",
+						Env: []kubeletconfig.ExecEnvVar{
+							{Name: "HTTPS_PROXY", Value: "https://proxy.internal"},
+							{Name: "NO_PROXY", Value: "registry.internal"},
+						},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						MaxCacheDuration:     &metav1.Duration{Duration: 30 * time.Minute},
+					},
+				},
+			},
+			expected: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test1",
+						MatchImages:          []string{"registry.io/test1"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						Env: []kubeletconfig.ExecEnvVar{
+							{Name: "NO_PROXY", Value: "registry.internal"},
+							{Name: "HTTPS_PROXY", Value: "https://proxy.test1.internal"},
+						},
+					},
+					{
+						Name:                 "test2",
+						MatchImages:          []string{"registry.io/test2"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 30 * time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+						Env: []kubeletconfig.ExecEnvVar{
+							{Name: "HTTPS_PROXY", Value: "https://proxy.internal"},
+							{Name: "NO_PROXY", Value: "registry.internal"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate provider names across configs",
+			configs: []*kubeletconfig.CredentialProviderConfig{
+				{Providers: []kubeletconfig.CredentialProvider{validProvider("test1")}},
+				{Providers: []kubeletconfig.CredentialProvider{validProvider("test1")}},
+			},
+			expectErr: `duplicate provider name "test1" found in configuration file(s)`,
+		},
+		{
+			name: "override replaces an earlier provider in place",
+			configs: []*kubeletconfig.CredentialProviderConfig{
+				{Providers: []kubeletconfig.CredentialProvider{validProvider("test1"), validProvider("test2")}},
+				{Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test1",
+						Override:             true,
+						MatchImages:          []string{"registry.io/site"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 5 * time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+					},
+				}},
+			},
+			expected: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test1",
+						Override:             true,
+						MatchImages:          []string{"registry.io/site"},
+						DefaultCacheDuration: &metav1.Duration{Duration: 5 * time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+					},
+					validProvider("test2"),
+				},
+			},
+		},
+		{
+			name: "override without a target",
+			configs: []*kubeletconfig.CredentialProviderConfig{
+				{Providers: []kubeletconfig.CredentialProvider{validProvider("test1")}},
+				{Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "orphan",
+						Override:             true,
+						MatchImages:          []string{"registry.io/orphan"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+					},
+				}},
+			},
+			expectErr: `provider "orphan" is marked override: true but no earlier config defines a provider with that name`,
+		},
+		{
+			name: "disableLegacyKeyring set in any config disables it for the merged config",
+			configs: []*kubeletconfig.CredentialProviderConfig{
+				{Providers: []kubeletconfig.CredentialProvider{validProvider("test1")}, DisableLegacyKeyring: true},
+				{Providers: []kubeletconfig.CredentialProvider{validProvider("test2")}},
+			},
+			expected: &kubeletconfig.CredentialProviderConfig{
+				Providers:            []kubeletconfig.CredentialProvider{validProvider("test1"), validProvider("test2")},
+				DisableLegacyKeyring: true,
+			},
+		},
+		{
+			name:      "merged config with no providers is invalid",
+			configs:   []*kubeletconfig.CredentialProviderConfig{{}},
+			expectErr: "at least 1 item in plugins is required",
+		},
+		{
+			name: "invalid provider in merged config",
+			configs: []*kubeletconfig.CredentialProviderConfig{
+				{Providers: []kubeletconfig.CredentialProvider{{Name: "test1"}}},
+			},
+			expectErr: "failed to validate credential provider config",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			merged, err := MergeCredentialProviderConfigs(testcase.configs...)
+			if err != nil {
+				if len(testcase.expectErr) == 0 {
+					t.Fatal(err)
+				}
+				if !strings.Contains(err.Error(), testcase.expectErr) {
+					t.Fatalf("expected error %q but got %q", testcase.expectErr, err.Error())
+				}
+				return
+			}
+			if len(testcase.expectErr) > 0 {
+				t.Fatalf("expected error %q but got none", testcase.expectErr)
+			}
+
+			if !reflect.DeepEqual(merged, testcase.expected) {
+				t.Errorf("expected config %#v, got %#v", testcase.expected, merged)
+			}
+		})
+	}
+}
+
+func Test_saTokenForCredentialProvidersEnabledReevaluated(t *testing.T) {
+	config := &kubeletconfig.CredentialProviderConfig{
+		Providers: []kubeletconfig.CredentialProvider{
+			{
+				Name:                 "test",
+				MatchImages:          []string{"registry.io/foobar"},
+				DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+				APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+				TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+					ServiceAccountTokenAudience: "audience",
+					RequireServiceAccount:       ptr.To(true),
+				},
+			},
+		},
+	}
+
+	originalGate := saTokenForCredentialProvidersEnabled
+	t.Cleanup(func() { saTokenForCredentialProvidersEnabled = originalGate })
+
+	// Each load consults the gate afresh, so flipping it between loads changes
+	// whether tokenAttributes is accepted without restarting anything.
+	saTokenForCredentialProvidersEnabled = func() bool { return false }
+	if _, err := MergeCredentialProviderConfigs(config); err == nil || !strings.Contains(err.Error(), "tokenAttributes is not supported when KubeletServiceAccountTokenForCredentialProviders feature gate is disabled") {
+		t.Fatalf("expected feature gate validation error with gate disabled, got: %v", err)
+	}
+
+	saTokenForCredentialProvidersEnabled = func() bool { return true }
+	if _, err := MergeCredentialProviderConfigs(config); err != nil {
+		t.Fatalf("unexpected error with gate enabled: %v", err)
+	}
+}
+
+func Test_validateCredentialProviderConfig_limits(t *testing.T) {
+	t.Cleanup(func() { SetConfigValidationLimits(0, 0) })
+
+	makeProvider := func(name string, matchImages int) kubeletconfig.CredentialProvider {
+		provider := kubeletconfig.CredentialProvider{
+			Name:                 name,
+			DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+			APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+		}
+		for i := 0; i < matchImages; i++ {
+			provider.MatchImages = append(provider.MatchImages, fmt.Sprintf("%d.registry.io", i))
+		}
+		return provider
+	}
+
+	t.Run("too many providers", func(t *testing.T) {
+		config := &kubeletconfig.CredentialProviderConfig{}
+		for i := 0; i <= maxProviders; i++ {
+			config.Providers = append(config.Providers, makeProvider(fmt.Sprintf("provider-%d", i), 1))
+		}
+		errs := validateCredentialProviderConfig(config, false)
+		expectErr := fmt.Sprintf("providers: Too many: %d: must have at most %d items", maxProviders+1, maxProviders)
+		if errString(errs.ToAggregate()) != expectErr {
+			t.Errorf("expected error %q, got %q", expectErr, errString(errs.ToAggregate()))
+		}
+	})
+
+	t.Run("too many matchImages", func(t *testing.T) {
+		config := &kubeletconfig.CredentialProviderConfig{
+			Providers: []kubeletconfig.CredentialProvider{makeProvider("provider", maxMatchImagesPerProvider+1)},
+		}
+		errs := validateCredentialProviderConfig(config, false)
+		expectErr := fmt.Sprintf("providers.matchImages: Too many: %d: must have at most %d items", maxMatchImagesPerProvider+1, maxMatchImagesPerProvider)
+		if errString(errs.ToAggregate()) != expectErr {
+			t.Errorf("expected error %q, got %q", expectErr, errString(errs.ToAggregate()))
+		}
+	})
+
+	t.Run("raised limits permit larger configs", func(t *testing.T) {
+		SetConfigValidationLimits(0, 2*defaultMaxMatchImagesPerProvider)
+		config := &kubeletconfig.CredentialProviderConfig{
+			Providers: []kubeletconfig.CredentialProvider{makeProvider("provider", defaultMaxMatchImagesPerProvider+1)},
+		}
+		if errs := validateCredentialProviderConfig(config, false); len(errs) > 0 {
+			t.Errorf("unexpected errors: %v", errs.ToAggregate())
+		}
+	})
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/klog/v2"
+	credentialproviderapi "k8s.io/kubelet/pkg/apis/credentialprovider"
+)
+
+// maxDaemonFrameSize is the maximum size of a single length-prefixed frame
+// exchanged with a reused plugin process. Responses are small; this bound only
+// exists to protect the kubelet from a misbehaving plugin.
+const maxDaemonFrameSize = 16 << 20
+
+// daemonPlugin is an implementation of the Plugin interface that keeps the
+// credential provider process running between invocations. Requests and
+// responses are exchanged as length-prefixed frames (a 4-byte big-endian
+// length followed by the encoded CredentialProviderRequest or
+// CredentialProviderResponse) over the process's stdin and stdout. This
+// amortizes process startup cost for plugins with heavy runtime
+// initialization. If the process exits or violates the framing protocol, it
+// is killed and restarted on the next invocation.
+type daemonPlugin struct {
+	// base carries the provider configuration and the encode/decode helpers
+	// shared with the one-shot exec implementation.
+	base *execPlugin
+
+	// mu serializes request/response exchanges with the plugin process and
+	// guards the process state below.
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+}
+
+// ExecPlugin sends a CredentialProviderRequest to the long-running plugin
+// process, starting it first if necessary, and reads back a single
+// CredentialProviderResponse.
+func (d *daemonPlugin) ExecPlugin(ctx context.Context, image, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error) {
+	klog.V(5).Infof("Getting image %s credentials from reused exec plugin %s", image, d.base.name)
+
+	return d.execRequest(ctx, d.base.buildRequest(image, serviceAccountToken, serviceAccountAnnotations, podAnnotations))
+}
+
+// ExecPluginBatch is like ExecPlugin, but the single exchange carries the full
+// image list of the batch in the request's images field; image is the image
+// being pulled and must be among images.
+func (d *daemonPlugin) ExecPluginBatch(ctx context.Context, image string, images []string, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error) {
+	klog.V(5).Infof("Getting credentials for %d images from reused exec plugin %s in a single batched exchange", len(images), d.base.name)
+
+	authRequest := d.base.buildRequest(image, serviceAccountToken, serviceAccountAnnotations, podAnnotations)
+	authRequest.Images = images
+	return d.execRequest(ctx, authRequest)
+}
+
+// execRequest performs one request/response exchange with the long-running
+// plugin process, starting it first if necessary.
+func (d *daemonPlugin) execRequest(ctx context.Context, authRequest *credentialproviderapi.CredentialProviderRequest) (*credentialproviderapi.CredentialProviderResponse, error) {
+	if err := beginPluginInvocation(d.base.name); err != nil {
+		return nil, err
+	}
+	defer endPluginInvocation()
+
+	data, err := d.base.encodeRequest(authRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode auth request: %w", err)
+	}
+	// The encoded request carries the pod's service account token; zero it once
+	// the exchange is over so it is not retained in an intermediate buffer
+	// until garbage collection.
+	defer zeroBytes(data)
+
+	// Use the same catch-all timeout as the one-shot exec path.
+	ctx, cancel := context.WithTimeout(ctx, pluginTimeout)
+	defer cancel()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	startTime := time.Now()
+	defer func() {
+		// Observing through the context attaches a trace exemplar to the duration
+		// histogram when tracing is enabled and the invocation's span is sampled.
+		kubeletCredentialProviderPluginDuration.WithContext(ctx).WithLabelValues(d.base.name).Observe(time.Since(startTime).Seconds())
+	}()
+
+	response, err := d.exchange(ctx, data)
+	// The raw response frame carries credentials; zero it once decoded.
+	defer zeroBytes(response)
+	if err != nil {
+		// The process state is unknown after any error; kill it so the next
+		// invocation starts fresh.
+		d.stop()
+		kubeletCredentialProviderPluginErrors.WithLabelValues(d.base.name).Inc()
+		class := ErrPluginCrashed
+		if errors.Is(err, context.DeadlineExceeded) {
+			class = ErrPluginTimeout
+		}
+		err := fmt.Errorf("%w: error calling reused credential provider plugin %s for image %s: %v", class, d.base.name, authRequest.Image, err)
+		publishEvent(Event{Type: InvocationFailedEvent, Provider: d.base.name, Err: err})
+		return nil, err
+	}
+
+	// check that the response apiVersion matches what is expected
+	gvk, err := json.DefaultMetaFactory.Interpret(response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: error reading GVK from response: %v", ErrMalformedResponse, err)
+	}
+
+	if gvk.GroupVersion().String() != d.base.apiVersion {
+		return nil, fmt.Errorf("%w: apiVersion from credential plugin response did not match expected apiVersion:%s, actual apiVersion:%s", ErrMalformedResponse, d.base.apiVersion, gvk.GroupVersion().String())
+	}
+
+	decoded, err := d.base.decodeResponse(response)
+	if err != nil {
+		// err is explicitly not wrapped since it may contain credentials in the response.
+		return nil, fmt.Errorf("%w: error decoding credential provider plugin response from stdout", ErrMalformedResponse)
+	}
+
+	return decoded, nil
+}
+
+// exchange writes a single request frame and reads back a single response
+// frame, starting the plugin process if it is not already running. The caller
+// must hold d.mu.
+func (d *daemonPlugin) exchange(ctx context.Context, request []byte) ([]byte, error) {
+	if d.cmd == nil {
+		if err := d.start(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writeFrame(d.stdin, request); err != nil {
+		return nil, fmt.Errorf("error writing request frame: %w", err)
+	}
+
+	type frameResult struct {
+		data []byte
+		err  error
+	}
+	resultCh := make(chan frameResult, 1)
+	go func(stdout *bufio.Reader) {
+		data, err := readFrame(stdout)
+		resultCh <- frameResult{data: data, err: err}
+	}(d.stdout)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-resultCh:
+		if result.err != nil {
+			return nil, fmt.Errorf("error reading response frame: %w", result.err)
+		}
+		return result.data, nil
+	}
+}
+
+// start launches the plugin process and wires up its stdin/stdout pipes. The
+// caller must hold d.mu.
+func (d *daemonPlugin) start() error {
+	var cmd *exec.Cmd
+	if len(d.base.command) > 0 {
+		cmdArgs := append(d.base.command[1:len(d.base.command):len(d.base.command)], d.base.args...)
+		cmd = exec.Command(d.base.command[0], cmdArgs...)
+		// Relative script paths in command are resolved against the plugin bin directory.
+		cmd.Dir = d.base.pluginBinDir
+	} else {
+		pluginBin, err := resolvePluginBinary(d.base.pluginBinDir, d.base.name, d.base.binaryByPlatform)
+		if err != nil {
+			return err
+		}
+		cmd = exec.Command(pluginBin, d.base.args...)
+	}
+
+	cmd.Env = mergeEnvVars(d.base.environ(), d.base.configEnv())
+	// The plugin's stdout carries the framing protocol, so diagnostics go to
+	// the kubelet's stderr instead of being captured per-invocation.
+	cmd.Stderr = os.Stderr
+
+	if err := d.base.applyIsolation(cmd); err != nil {
+		return err
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("error creating stdin pipe: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("error creating stdout pipe: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("error starting credential provider plugin %s: %w", d.base.name, err)
+	}
+
+	if err := d.base.startSystemdScope(context.Background(), cmd); err != nil {
+		// The plugin must not keep running without the scope the operator
+		// configured; terminate it and fail the start.
+		_ = terminateProcess(cmd.Process)
+		_ = cmd.Wait()
+		return fmt.Errorf("error placing credential provider plugin %s in a systemd scope: %w", d.base.name, err)
+	}
+
+	d.cmd = cmd
+	d.stdin = stdin
+	d.stdout = bufio.NewReader(stdout)
+	return nil
+}
+
+// stop kills the plugin process, if running, and clears the process state so
+// the next invocation starts a new one. The caller must hold d.mu.
+func (d *daemonPlugin) stop() {
+	if d.cmd == nil {
+		return
+	}
+	d.stdin.Close()
+	if err := d.cmd.Process.Kill(); err != nil {
+		klog.V(5).Infof("Error killing credential provider plugin %s: %v", d.base.name, err)
+	}
+	// Reap the process; the exit status of a killed plugin is not interesting,
+	// but its resource usage still is.
+	_ = d.cmd.Wait()
+	if d.cmd.ProcessState != nil {
+		recordPluginResourceUsage(d.base.name, d.cmd.ProcessState)
+	}
+	d.cmd = nil
+	d.stdin = nil
+	d.stdout = nil
+}
+
+// shutdown stops the plugin process cooperatively during kubelet shutdown.
+// Closing stdin signals end-of-input under the framing protocol and SIGTERM
+// covers plugins that do not watch for it; a plugin still running after the
+// grace period is killed.
+func (d *daemonPlugin) shutdown() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.cmd == nil {
+		return
+	}
+	d.stdin.Close()
+	if err := terminateProcess(d.cmd.Process); err != nil {
+		klog.V(5).Infof("Error terminating credential provider plugin %s: %v", d.base.name, err)
+	}
+
+	exited := make(chan struct{})
+	go func() {
+		_ = d.cmd.Wait()
+		close(exited)
+	}()
+	select {
+	case <-exited:
+	case <-time.After(terminateGracePeriod):
+		klog.V(2).InfoS("Credential provider plugin did not exit after SIGTERM, killing it", "plugin", d.base.name)
+		_ = d.cmd.Process.Kill()
+		<-exited
+	}
+
+	if d.cmd.ProcessState != nil {
+		recordPluginResourceUsage(d.base.name, d.cmd.ProcessState)
+	}
+	d.cmd = nil
+	d.stdin = nil
+	d.stdout = nil
+}
+
+// writeFrame writes data prefixed with its length as a 4-byte big-endian integer.
+func writeFrame(w io.Writer, data []byte) error {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+	if _, err := w.Write(length[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// readFrame reads a single length-prefixed frame written by writeFrame.
+func readFrame(r io.Reader) ([]byte, error) {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size > maxDaemonFrameSize {
+		return nil, fmt.Errorf("frame of %d bytes exceeds maximum of %d bytes", size, maxDaemonFrameSize)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
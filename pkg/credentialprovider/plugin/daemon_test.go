@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func Test_frameRoundTrip(t *testing.T) {
+	testcases := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "empty frame",
+			data: []byte{},
+		},
+		{
+			name: "json payload",
+			data: []byte(`{"kind":"CredentialProviderRequest","apiVersion":"credentialprovider.kubelet.k8s.io/v1","image":"test.registry.io/foo/bar"}`),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := writeFrame(buf, tc.data); err != nil {
+				t.Fatalf("unexpected error writing frame: %v", err)
+			}
+
+			got, err := readFrame(buf)
+			if err != nil {
+				t.Fatalf("unexpected error reading frame: %v", err)
+			}
+			if !bytes.Equal(got, tc.data) {
+				t.Errorf("expected %q, got %q", tc.data, got)
+			}
+		})
+	}
+}
+
+func Test_readFrameTooLarge(t *testing.T) {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], maxDaemonFrameSize+1)
+
+	_, err := readFrame(bytes.NewReader(length[:]))
+	if err == nil {
+		t.Fatal("expected error for oversized frame, got none")
+	}
+	if !strings.Contains(err.Error(), "exceeds maximum") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func Test_readFrameTruncated(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := writeFrame(buf, []byte("full frame payload")); err != nil {
+		t.Fatalf("unexpected error writing frame: %v", err)
+	}
+	truncated := buf.Bytes()[:buf.Len()-4]
+
+	if _, err := readFrame(bytes.NewReader(truncated)); err == nil {
+		t.Fatal("expected error for truncated frame, got none")
+	}
+}
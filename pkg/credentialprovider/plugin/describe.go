@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// describeArg is the argument the kubelet appends to a plugin invocation to
+// request its self-description instead of credentials.
+const describeArg = "--describe"
+
+// describeTimeout bounds the self-description invocation. Describing is a
+// local, side-effect-free operation, so it gets a much shorter budget than a
+// credential request.
+const describeTimeout = 10 * time.Second
+
+// gzipRequestFeature is the feature name a plugin lists in its self-description
+// to receive gzip-compressed request bodies on stdin, keeping requests that
+// carry service account tokens with large claims within pipe buffer limits.
+const gzipRequestFeature = "gzip-request"
+
+// PluginDescription is the JSON document a credential provider plugin prints
+// to stdout when invoked with --describe. The contract is optional: plugins
+// that do not implement it are treated as not self-describing and remain fully
+// functional. The kubelet surfaces the reported values through DumpState and
+// the kubelet_credential_provider_plugin_info metric so operators can audit
+// which plugin build runs on each node.
+type PluginDescription struct {
+	// Version is the plugin's own version string, e.g. "v1.4.2". Required.
+	Version string `json:"version"`
+	// BuildInfo is free-form build metadata such as a git commit or build date.
+	BuildInfo string `json:"buildInfo,omitempty"`
+	// Features lists optional capabilities the plugin supports, e.g. "heartbeat".
+	Features []string `json:"features,omitempty"`
+}
+
+// describe invokes the plugin with --describe appended to its configured
+// arguments and decodes the self-description it prints to stdout. Plugins that
+// do not implement the contract typically exit non-zero or print something
+// other than a PluginDescription, both of which are reported as errors.
+func (e *execPlugin) describe(ctx context.Context) (*PluginDescription, error) {
+	ctx, cancel := context.WithTimeout(ctx, describeTimeout)
+	defer cancel()
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	var cmd *exec.Cmd
+	if len(e.command) > 0 {
+		cmdArgs := append(e.command[1:len(e.command):len(e.command)], e.args...)
+		cmdArgs = append(cmdArgs, describeArg)
+		cmd = exec.CommandContext(ctx, e.command[0], cmdArgs...)
+		// Relative script paths in command are resolved against the plugin bin directory.
+		cmd.Dir = e.pluginBinDir
+	} else {
+		cmd = exec.CommandContext(ctx, filepath.Join(e.pluginBinDir, e.name), append(e.args[:len(e.args):len(e.args)], describeArg)...)
+	}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	cmd.Env = mergeEnvVars(e.environ(), e.configEnv())
+
+	if err := e.applyIsolation(cmd); err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error execing credential provider plugin %s with %s: %v: %s", e.name, describeArg, err, stderr.String())
+	}
+
+	description := &PluginDescription{}
+	if err := json.Unmarshal(stdout.Bytes(), description); err != nil {
+		return nil, fmt.Errorf("error decoding %s response of credential provider plugin %s: %w", describeArg, e.name, err)
+	}
+	if len(description.Version) == 0 {
+		return nil, fmt.Errorf("%s response of credential provider plugin %s did not include a version", describeArg, e.name)
+	}
+
+	return description, nil
+}
+
+// recordPluginDescription gathers the provider's optional self-description and
+// publishes it via DumpState and the plugin info metric. Plugins are not
+// required to implement the contract, so failures are logged at low visibility
+// and otherwise ignored.
+func (p *pluginProvider) recordPluginDescription() {
+	if p.describePlugin == nil {
+		return
+	}
+
+	description, err := p.describePlugin(context.Background())
+	if err != nil {
+		klog.V(4).InfoS("Credential provider plugin did not return a self-description", "provider", p.name, "err", err)
+		return
+	}
+
+	kubeletCredentialProviderPluginInfo.WithLabelValues(p.name, description.Version, strings.Join(description.Features, ",")).Set(1)
+
+	for _, feature := range description.Features {
+		if feature == gzipRequestFeature && p.enableRequestCompression != nil {
+			klog.V(4).InfoS("Credential provider plugin advertised support for compressed requests", "provider", p.name)
+			p.enableRequestCompression()
+		}
+	}
+
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+	p.description = description
+}
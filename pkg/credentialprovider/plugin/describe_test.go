@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func Test_recordPluginDescription(t *testing.T) {
+	tclock := testingclock.NewFakeClock(time.Now())
+	newProvider := func(describe func(ctx context.Context) (*PluginDescription, error)) *pluginProvider {
+		return &pluginProvider{
+			name:                 "test",
+			apiVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			clock:                tclock,
+			matchImages:          []string{"test.registry.io"},
+			cache:                cache.NewExpirationStore(cacheKeyFunc, &cacheExpirationPolicy{clock: tclock}),
+			defaultCacheDuration: time.Minute,
+			lastCachePurge:       tclock.Now(),
+			plugin:               &fakeExecPlugin{},
+			describePlugin:       describe,
+		}
+	}
+
+	t.Run("description is published via dumpState", func(t *testing.T) {
+		want := &PluginDescription{
+			Version:   "v1.4.2",
+			BuildInfo: "commit deadbeef",
+			Features:  []string{"heartbeat"},
+		}
+		p := newProvider(func(ctx context.Context) (*PluginDescription, error) {
+			return want, nil
+		})
+		p.recordPluginDescription()
+
+		if got := p.dumpState().Description; !reflect.DeepEqual(got, want) {
+			t.Errorf("expected description %#v, got %#v", want, got)
+		}
+	})
+
+	t.Run("gzip-request feature enables request compression", func(t *testing.T) {
+		p := newProvider(func(ctx context.Context) (*PluginDescription, error) {
+			return &PluginDescription{Version: "v1.4.2", Features: []string{gzipRequestFeature}}, nil
+		})
+		enabled := false
+		p.enableRequestCompression = func() { enabled = true }
+		p.recordPluginDescription()
+
+		if !enabled {
+			t.Error("expected request compression to be enabled")
+		}
+	})
+
+	t.Run("describe failures are ignored", func(t *testing.T) {
+		p := newProvider(func(ctx context.Context) (*PluginDescription, error) {
+			return nil, errors.New("exec format error")
+		})
+		p.recordPluginDescription()
+
+		if got := p.dumpState().Description; got != nil {
+			t.Errorf("expected no description, got %#v", got)
+		}
+	})
+
+	t.Run("providers without a describe implementation are skipped", func(t *testing.T) {
+		p := newProvider(nil)
+		p.recordPluginDescription()
+
+		if got := p.dumpState().Description; got != nil {
+			t.Errorf("expected no description, got %#v", got)
+		}
+	})
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CredentialProviderState is a point-in-time snapshot of the credential provider
+// subsystem, suitable for inclusion in kubelet support bundles. It is redacted:
+// it contains configuration and health metadata only and never includes cached
+// credential values or service account tokens.
+type CredentialProviderState struct {
+	// ConfigHash is the hash of the loaded credential provider configuration,
+	// as recorded at plugin registration.
+	ConfigHash string `json:"configHash,omitempty"`
+	// ProviderOrder is the final resolved provider ordering of the loaded
+	// configuration. Providers are consulted in this order during image pulls
+	// and the first provider whose matchImages pattern matches an image wins.
+	ProviderOrder []ProviderOrderRecord `json:"providerOrder,omitempty"`
+	// Providers holds the per-provider state, in registration order.
+	Providers []ProviderState `json:"providers"`
+}
+
+// ProviderOrderRecord documents one provider's position in the resolved match
+// precedence and where it was loaded from.
+type ProviderOrderRecord struct {
+	// Name is the provider name from the credential provider config.
+	Name string `json:"name"`
+	// SourceFile is the config file the provider was loaded from, or empty for
+	// providers embedded in the kubelet configuration.
+	SourceFile string `json:"sourceFile,omitempty"`
+}
+
+// ProviderState is the snapshot of a single registered credential provider plugin.
+type ProviderState struct {
+	// Name is the provider name from the credential provider config.
+	Name string `json:"name"`
+	// APIVersion is the exec protocol version the provider is configured with.
+	APIVersion string `json:"apiVersion"`
+	// MatchImages is the list of image match patterns the provider operates against.
+	MatchImages []string `json:"matchImages"`
+	// MetricsLabels is the set of operator-configured static labels attached to
+	// the provider's metrics, or omitted if none are configured.
+	MetricsLabels map[string]string `json:"metricsLabels,omitempty"`
+	// DefaultCacheDuration is the configured fallback cache duration for credentials.
+	DefaultCacheDuration metav1.Duration `json:"defaultCacheDuration"`
+	// ServiceAccountTokenMode indicates whether the provider is configured with
+	// tokenAttributes and receives pod-bound service account tokens.
+	ServiceAccountTokenMode bool `json:"serviceAccountTokenMode"`
+	// CacheEntries is the number of credential entries currently cached for the
+	// provider, including entries that have expired but not yet been purged.
+	CacheEntries int `json:"cacheEntries"`
+	// ErrorCount is the total number of plugin invocation errors observed since
+	// the provider was registered.
+	ErrorCount int64 `json:"errorCount"`
+	// RecentErrors holds the most recent plugin invocation errors, oldest first.
+	RecentErrors []ProviderErrorRecord `json:"recentErrors,omitempty"`
+	// Degraded indicates the provider failed its most recent probe invocation.
+	// Only providers with a configured probeImage can be degraded.
+	Degraded bool `json:"degraded,omitempty"`
+	// LastAppliedJitter is the jitter subtracted from the most recently cached
+	// credential's lifetime, or omitted for providers without a configured
+	// cacheJitterPercent.
+	LastAppliedJitter *metav1.Duration `json:"lastAppliedJitter,omitempty"`
+	// Description is the plugin's self-description reported via the optional
+	// --describe contract, or omitted if the plugin does not implement it.
+	Description *PluginDescription `json:"description,omitempty"`
+}
+
+// ProviderErrorRecord is a single plugin invocation error retained for debugging.
+type ProviderErrorRecord struct {
+	// Time is when the error was observed.
+	Time metav1.Time `json:"time"`
+	// Error is the error message. Credential material returned by plugins is
+	// never part of these messages.
+	Error string `json:"error"`
+}
+
+// DumpState writes a redacted JSON snapshot of the credential provider subsystem
+// to w: the loaded configuration summary, per-provider health, cache statistics
+// and recent errors. It is intended for node debugging and support bundles.
+func DumpState(w io.Writer) error {
+	providersMutex.RLock()
+	state := CredentialProviderState{
+		ConfigHash:    loadedConfigHash,
+		ProviderOrder: append([]ProviderOrderRecord(nil), loadedProviderOrder...),
+		Providers:     make([]ProviderState, 0, len(providers)),
+	}
+	for _, p := range providers {
+		state.Providers = append(state.Providers, p.impl.dumpState())
+	}
+	providersMutex.RUnlock()
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling credential provider state: %w", err)
+	}
+
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("error writing credential provider state: %w", err)
+	}
+	return nil
+}
+
+// dumpState gathers the snapshot of a single provider.
+func (p *pluginProvider) dumpState() ProviderState {
+	state := ProviderState{
+		Name:                    p.name,
+		APIVersion:              p.apiVersion,
+		MatchImages:             p.matchImages,
+		MetricsLabels:           p.metricsLabels,
+		DefaultCacheDuration:    metav1.Duration{Duration: p.defaultCacheDuration},
+		ServiceAccountTokenMode: p.serviceAccountProvider != nil,
+		CacheEntries:            len(p.cache.List()),
+	}
+
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+	state.ErrorCount = p.errorCount
+	state.RecentErrors = append([]ProviderErrorRecord(nil), p.recentErrors...)
+	state.Degraded = p.degraded
+	state.Description = p.description
+	if p.cacheJitterPercent > 0 {
+		state.LastAppliedJitter = &metav1.Duration{Duration: p.lastAppliedJitter}
+	}
+
+	return state
+}
+
+// recordAppliedJitter retains the jitter applied to the most recent cache
+// insert for DumpState.
+func (p *pluginProvider) recordAppliedJitter(jitter time.Duration) {
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+	p.lastAppliedJitter = jitter
+}
+
+// maxRecentErrors bounds the number of plugin invocation errors retained per
+// provider for DumpState.
+const maxRecentErrors = 10
+
+// recordPluginError retains an invocation error for DumpState.
+func (p *pluginProvider) recordPluginError(err error) {
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+
+	p.errorCount++
+	p.recentErrors = append(p.recentErrors, ProviderErrorRecord{
+		Time:  metav1.Time{Time: p.clock.Now()},
+		Error: err.Error(),
+	})
+	if len(p.recentErrors) > maxRecentErrors {
+		p.recentErrors = p.recentErrors[1:]
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestDumpState(t *testing.T) {
+	tclock := testingclock.NewFakeClock(time.Now())
+	p := &pluginProvider{
+		name:                 "test",
+		apiVersion:           "credentialprovider.kubelet.k8s.io/v1",
+		clock:                tclock,
+		matchImages:          []string{"test.registry.io"},
+		cache:                cache.NewExpirationStore(cacheKeyFunc, &cacheExpirationPolicy{clock: tclock}),
+		defaultCacheDuration: time.Minute,
+		lastCachePurge:       tclock.Now(),
+		plugin:               &fakeExecPlugin{},
+	}
+	p.recordPluginError(errors.New("plugin exec failed"))
+
+	providersMutex.Lock()
+	savedProviders, savedHash, savedOrder := providers, loadedConfigHash, loadedProviderOrder
+	providers = []provider{{name: "test", impl: p}}
+	loadedConfigHash = "sha256:test"
+	loadedProviderOrder = []ProviderOrderRecord{{Name: "test", SourceFile: "etc/config.d/00-test.yaml"}}
+	providersMutex.Unlock()
+	defer func() {
+		providersMutex.Lock()
+		providers, loadedConfigHash, loadedProviderOrder = savedProviders, savedHash, savedOrder
+		providersMutex.Unlock()
+	}()
+
+	buf := &bytes.Buffer{}
+	if err := DumpState(buf); err != nil {
+		t.Fatalf("unexpected error dumping state: %v", err)
+	}
+
+	state := &CredentialProviderState{}
+	if err := json.Unmarshal(buf.Bytes(), state); err != nil {
+		t.Fatalf("unexpected error unmarshalling state: %v", err)
+	}
+
+	if state.ConfigHash != "sha256:test" {
+		t.Errorf("expected config hash %q, got %q", "sha256:test", state.ConfigHash)
+	}
+	if len(state.ProviderOrder) != 1 || state.ProviderOrder[0].Name != "test" || state.ProviderOrder[0].SourceFile != "etc/config.d/00-test.yaml" {
+		t.Errorf("unexpected provider order: %v", state.ProviderOrder)
+	}
+	if len(state.Providers) != 1 {
+		t.Fatalf("expected 1 provider, got %d", len(state.Providers))
+	}
+
+	providerState := state.Providers[0]
+	if providerState.Name != "test" {
+		t.Errorf("expected provider name %q, got %q", "test", providerState.Name)
+	}
+	if providerState.APIVersion != "credentialprovider.kubelet.k8s.io/v1" {
+		t.Errorf("unexpected apiVersion: %q", providerState.APIVersion)
+	}
+	if providerState.ErrorCount != 1 {
+		t.Errorf("expected error count 1, got %d", providerState.ErrorCount)
+	}
+	if len(providerState.RecentErrors) != 1 || providerState.RecentErrors[0].Error != "plugin exec failed" {
+		t.Errorf("unexpected recent errors: %v", providerState.RecentErrors)
+	}
+}
+
+func Test_recordPluginErrorBounded(t *testing.T) {
+	p := &pluginProvider{
+		name:  "test",
+		clock: testingclock.NewFakeClock(time.Now()),
+	}
+
+	for i := 0; i < maxRecentErrors+5; i++ {
+		p.recordPluginError(fmt.Errorf("error %d", i))
+	}
+
+	if p.errorCount != int64(maxRecentErrors+5) {
+		t.Errorf("expected error count %d, got %d", maxRecentErrors+5, p.errorCount)
+	}
+	if len(p.recentErrors) != maxRecentErrors {
+		t.Fatalf("expected %d retained errors, got %d", maxRecentErrors, len(p.recentErrors))
+	}
+	if p.recentErrors[0].Error != "error 5" {
+		t.Errorf("expected oldest retained error %q, got %q", "error 5", p.recentErrors[0].Error)
+	}
+}
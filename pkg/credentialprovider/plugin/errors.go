@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import "errors"
+
+// The errors below classify credential provider plugin failures so callers
+// such as the image manager can choose different retry and backoff behaviors
+// and produce precise pod events per failure class. Returned errors wrap one
+// of these sentinels together with call-site detail; match with errors.Is.
+var (
+	// ErrNoMatch indicates the requested image did not match any of the
+	// provider's matchImages patterns. Retrying cannot succeed until the
+	// credential provider configuration changes.
+	ErrNoMatch = errors.New("image does not match any credential provider pattern")
+
+	// ErrPluginTimeout indicates the plugin did not respond within the
+	// per-invocation timeout. The invocation may be retried with backoff.
+	ErrPluginTimeout = errors.New("credential provider plugin timed out")
+
+	// ErrPluginCrashed indicates the plugin process could not be started,
+	// exited with a non-zero status, or broke the exchange protocol.
+	ErrPluginCrashed = errors.New("credential provider plugin failed")
+
+	// ErrMalformedResponse indicates the plugin produced output that could not
+	// be decoded as a valid CredentialProviderResponse.
+	ErrMalformedResponse = errors.New("credential provider plugin returned a malformed response")
+
+	// ErrTokenMintFailed indicates a service account token required by a
+	// provider running in service account token mode could not be minted.
+	ErrTokenMintFailed = errors.New("error minting service account token")
+)
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"sync"
+)
+
+// EventType identifies the kind of credential provider lifecycle event.
+type EventType string
+
+const (
+	// ProviderLoadedEvent is published when a provider has been registered and
+	// is ready to serve credentials.
+	ProviderLoadedEvent EventType = "ProviderLoaded"
+	// ProviderUnloadedEvent is published when a provider is taken out of
+	// service, currently only during kubelet shutdown.
+	ProviderUnloadedEvent EventType = "ProviderUnloaded"
+	// InvocationFailedEvent is published when a plugin invocation fails,
+	// whether by crashing, timing out, or returning a malformed response.
+	InvocationFailedEvent EventType = "InvocationFailed"
+	// ProviderLookupFailedEvent is published when a provider fails to produce
+	// credentials while the keyring for an image pull is being composed. The
+	// pull proceeds with credentials from the remaining providers; the event
+	// records which provider was unable to contribute.
+	ProviderLookupFailedEvent EventType = "ProviderLookupFailed"
+	// ProviderDegradedEvent is published when a provider with a configured
+	// probeImage fails its probe invocation after loading. The provider stays
+	// in service; the event only signals that real pulls through it are likely
+	// to fail as well.
+	ProviderDegradedEvent EventType = "ProviderDegraded"
+	// CacheEvictedEvent is published when cached credentials are actively
+	// removed from a provider's cache before their natural expiry, for example
+	// after a secret rotation or a service account deletion. Entries aging out
+	// of the cache on their own do not produce events.
+	CacheEvictedEvent EventType = "CacheEvicted"
+)
+
+// Event describes a single credential provider lifecycle event.
+type Event struct {
+	// Type is the kind of event.
+	Type EventType
+	// Provider is the name of the provider the event concerns.
+	Provider string
+	// Err carries the failure for InvocationFailed and ProviderLookupFailed
+	// events and is nil otherwise.
+	Err error
+	// EntriesEvicted is the number of cache entries removed for CacheEvicted
+	// events and zero otherwise.
+	EntriesEvicted int
+}
+
+var (
+	eventSubscribersMutex sync.RWMutex
+	eventSubscribers      = make(map[int]func(Event))
+	nextEventSubscriberID int
+)
+
+// SubscribeToEvents registers a handler for credential provider lifecycle
+// events, letting other kubelet subsystems react to provider state changes
+// without polling metrics. Handlers are called synchronously from the
+// goroutine producing the event, which may be in the middle of serving
+// credentials for an image pull; they must return quickly and must not call
+// back into this package. The returned func removes the subscription.
+func SubscribeToEvents(handler func(Event)) func() {
+	eventSubscribersMutex.Lock()
+	defer eventSubscribersMutex.Unlock()
+
+	nextEventSubscriberID++
+	id := nextEventSubscriberID
+	eventSubscribers[id] = handler
+
+	return func() {
+		eventSubscribersMutex.Lock()
+		defer eventSubscribersMutex.Unlock()
+		delete(eventSubscribers, id)
+	}
+}
+
+// publishEvent delivers an event to every subscribed handler. Callers must
+// not hold providersMutex, so handlers remain free to read the exported
+// state of this package.
+func publishEvent(event Event) {
+	eventSubscribersMutex.RLock()
+	defer eventSubscribersMutex.RUnlock()
+
+	for _, handler := range eventSubscribers {
+		handler(event)
+	}
+}
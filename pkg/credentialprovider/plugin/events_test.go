@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_SubscribeToEvents(t *testing.T) {
+	var first, second []Event
+	unsubscribeFirst := SubscribeToEvents(func(event Event) {
+		first = append(first, event)
+	})
+	t.Cleanup(unsubscribeFirst)
+	unsubscribeSecond := SubscribeToEvents(func(event Event) {
+		second = append(second, event)
+	})
+	t.Cleanup(unsubscribeSecond)
+
+	loaded := Event{Type: ProviderLoadedEvent, Provider: "test-provider"}
+	publishEvent(loaded)
+
+	if !reflect.DeepEqual(first, []Event{loaded}) {
+		t.Errorf("first subscriber got events %v, expected %v", first, []Event{loaded})
+	}
+	if !reflect.DeepEqual(second, []Event{loaded}) {
+		t.Errorf("second subscriber got events %v, expected %v", second, []Event{loaded})
+	}
+
+	unsubscribeFirst()
+
+	evicted := Event{Type: CacheEvictedEvent, Provider: "test-provider", EntriesEvicted: 3}
+	publishEvent(evicted)
+
+	if !reflect.DeepEqual(first, []Event{loaded}) {
+		t.Errorf("unsubscribed subscriber got events %v, expected %v", first, []Event{loaded})
+	}
+	if !reflect.DeepEqual(second, []Event{loaded, evicted}) {
+		t.Errorf("second subscriber got events %v, expected %v", second, []Event{loaded, evicted})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides a scriptable in-memory credential provider for kubelet
+// unit tests, so tests covering credential failure paths do not need to spawn
+// real exec plugins.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/kubernetes/pkg/credentialprovider"
+	"k8s.io/kubernetes/pkg/credentialprovider/plugin"
+)
+
+// Provider is a scriptable credentialprovider.DockerConfigProvider. It mirrors
+// the method set of the per-pod plugin provider, returning scripted credentials
+// or errors per image and recording every image it was asked about. Images
+// without a scripted response behave like images that match no provider
+// pattern. The zero value is an enabled provider with no scripted responses.
+type Provider struct {
+	// Disabled makes Enabled return false, removing the provider from keyrings
+	// built against it.
+	Disabled bool
+
+	mu        sync.Mutex
+	responses map[string]response
+	calls     []string
+}
+
+type response struct {
+	config credentialprovider.DockerConfig
+	err    error
+}
+
+// compile-time checks that Provider keeps satisfying the interfaces kubelet
+// consumes plugin providers through.
+var _ credentialprovider.DockerConfigProvider = &Provider{}
+
+// New returns an enabled Provider with no scripted responses.
+func New() *Provider {
+	return &Provider{}
+}
+
+// Script sets the credentials and error returned for image. Scripting an image
+// again replaces the previous response. Errors should wrap one of the sentinel
+// errors of the plugin package when tests exercise failure classification.
+func (p *Provider) Script(image string, config credentialprovider.DockerConfig, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.responses == nil {
+		p.responses = make(map[string]response)
+	}
+	p.responses[image] = response{config: config, err: err}
+}
+
+// Calls returns the images the provider was asked to provide credentials for,
+// in call order.
+func (p *Provider) Calls() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	calls := make([]string, len(p.calls))
+	copy(calls, p.calls)
+	return calls
+}
+
+// Enabled implements credentialprovider.DockerConfigProvider.
+func (p *Provider) Enabled() bool {
+	return !p.Disabled
+}
+
+// Provide implements credentialprovider.DockerConfigProvider. Like the real
+// plugin provider, errors result in empty credentials.
+func (p *Provider) Provide(image string) credentialprovider.DockerConfig {
+	config, err := p.ProvideWithError(image)
+	if err != nil {
+		return credentialprovider.DockerConfig{}
+	}
+	return config
+}
+
+// ProvideWithContext mirrors the context-aware surface of the real plugin
+// provider. The fake performs no blocking work, so the context is ignored.
+func (p *Provider) ProvideWithContext(_ context.Context, image string) credentialprovider.DockerConfig {
+	return p.Provide(image)
+}
+
+// ProvideWithError returns the scripted credentials and error for image. Images
+// without a scripted response return an error wrapping plugin.ErrNoMatch, the
+// same failure class the real provider reports for images outside its
+// matchImages patterns.
+func (p *Provider) ProvideWithError(image string) (credentialprovider.DockerConfig, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls = append(p.calls, image)
+
+	scripted, ok := p.responses[image]
+	if !ok {
+		return credentialprovider.DockerConfig{}, fmt.Errorf("%w: image %s not scripted", plugin.ErrNoMatch, image)
+	}
+	return scripted.config, scripted.err
+}
+
+// ProvideForImages resolves credentials for the given images and returns the
+// resulting docker configs keyed by image.
+func (p *Provider) ProvideForImages(images []string) map[string]credentialprovider.DockerConfig {
+	results := make(map[string]credentialprovider.DockerConfig, len(images))
+	for _, image := range images {
+		results[image] = p.Provide(image)
+	}
+	return results
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	credentialproviderapi "k8s.io/kubelet/pkg/apis/credentialprovider"
+)
+
+// FaultInjectionEnvVar enables synthetic per-provider latency and failures so
+// e2e suites can validate cluster behavior under credential provider
+// degradation without shipping a deliberately broken plugin binary. The value
+// is a semicolon-separated list of provider specs, each of the form
+// <provider>:<param>=<value>[,<param>=<value>], where param is "latency" (a
+// Go duration added before every invocation) or "errorRate" (a probability
+// between 0 and 1 of failing the invocation outright). For example:
+//
+//	acr-provider:latency=2s,errorRate=0.5;gcp-provider:latency=100ms
+//
+// This hook is for testing only and must never be set on production nodes.
+const FaultInjectionEnvVar = "KUBELET_CREDENTIAL_PROVIDER_FAULT_INJECTION"
+
+// faultSpec is the parsed fault injection configuration of one provider.
+type faultSpec struct {
+	latency   time.Duration
+	errorRate float64
+}
+
+var faultSpecsOnce sync.Once
+var faultSpecs map[string]faultSpec
+
+// loadFaultSpecs parses the fault injection environment variable once. An
+// invalid spec is logged and ignored entirely rather than partially applied,
+// so a typo cannot silently degrade a subset of providers.
+func loadFaultSpecs() map[string]faultSpec {
+	faultSpecsOnce.Do(func() {
+		value := os.Getenv(FaultInjectionEnvVar)
+		if len(value) == 0 {
+			return
+		}
+		specs, err := parseFaultInjectionSpecs(value)
+		if err != nil {
+			klog.ErrorS(err, "Ignoring invalid credential provider fault injection spec", "envVar", FaultInjectionEnvVar)
+			return
+		}
+		faultSpecs = specs
+	})
+	return faultSpecs
+}
+
+// parseFaultInjectionSpecs parses the value of the fault injection environment
+// variable into per-provider specs.
+func parseFaultInjectionSpecs(value string) (map[string]faultSpec, error) {
+	specs := make(map[string]faultSpec)
+	for _, entry := range strings.Split(value, ";") {
+		name, params, ok := strings.Cut(entry, ":")
+		if !ok || len(name) == 0 {
+			return nil, fmt.Errorf("entry %q is not of the form <provider>:<param>=<value>[,<param>=<value>]", entry)
+		}
+		if _, ok := specs[name]; ok {
+			return nil, fmt.Errorf("duplicate fault injection entry for provider %q", name)
+		}
+
+		var spec faultSpec
+		for _, param := range strings.Split(params, ",") {
+			key, val, ok := strings.Cut(param, "=")
+			if !ok {
+				return nil, fmt.Errorf("parameter %q for provider %q is not of the form <param>=<value>", param, name)
+			}
+			switch key {
+			case "latency":
+				latency, err := time.ParseDuration(val)
+				if err != nil || latency < 0 {
+					return nil, fmt.Errorf("latency %q for provider %q must be a non-negative duration", val, name)
+				}
+				spec.latency = latency
+			case "errorRate":
+				rate, err := strconv.ParseFloat(val, 64)
+				if err != nil || rate < 0 || rate > 1 {
+					return nil, fmt.Errorf("errorRate %q for provider %q must be a number between 0 and 1", val, name)
+				}
+				spec.errorRate = rate
+			default:
+				return nil, fmt.Errorf("unknown fault injection parameter %q for provider %q", key, name)
+			}
+		}
+		specs[name] = spec
+	}
+	return specs, nil
+}
+
+// wrapWithFaultInjection decorates the plugin invoker with the fault spec
+// configured for the provider, or returns it unchanged when fault injection
+// is not configured for it.
+func wrapWithFaultInjection(name string, base Plugin) Plugin {
+	spec, ok := loadFaultSpecs()[name]
+	if !ok {
+		return base
+	}
+	klog.InfoS("Credential provider fault injection is active; this is a testing hook and must never be enabled on production nodes",
+		"provider", name, "latency", spec.latency, "errorRate", spec.errorRate)
+	return &faultInjectingPlugin{base: base, name: name, spec: spec, rand: rand.Float64}
+}
+
+// faultInjectingPlugin is a Plugin decorator that delays and probabilistically
+// fails invocations of the wrapped invoker. Injected failures surface through
+// the same error classification, metrics and backoff paths as real plugin
+// failures.
+type faultInjectingPlugin struct {
+	base Plugin
+	name string
+	spec faultSpec
+	// rand returns a uniform number in [0, 1); it is a field so tests can make
+	// failure injection deterministic.
+	rand func() float64
+}
+
+func (f *faultInjectingPlugin) ExecPlugin(ctx context.Context, image, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error) {
+	if err := f.injectFault(ctx); err != nil {
+		return nil, err
+	}
+	return f.base.ExecPlugin(ctx, image, serviceAccountToken, serviceAccountAnnotations, podAnnotations)
+}
+
+// ExecPluginBatch forwards batched invocations to the wrapped invoker with the
+// same injected latency and failures so providers configured with
+// batchRequests keep their batching behavior under fault injection.
+func (f *faultInjectingPlugin) ExecPluginBatch(ctx context.Context, image string, images []string, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error) {
+	batch, ok := f.base.(BatchPlugin)
+	if !ok {
+		return nil, fmt.Errorf("credential provider %q does not support batched invocations", f.name)
+	}
+	if err := f.injectFault(ctx); err != nil {
+		return nil, err
+	}
+	return batch.ExecPluginBatch(ctx, image, images, serviceAccountToken, serviceAccountAnnotations, podAnnotations)
+}
+
+// injectFault applies the configured latency and probabilistic failure before
+// an invocation is forwarded to the wrapped invoker.
+func (f *faultInjectingPlugin) injectFault(ctx context.Context) error {
+	if f.spec.latency > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(f.spec.latency):
+		}
+	}
+	if f.spec.errorRate > 0 && f.rand() < f.spec.errorRate {
+		return fmt.Errorf("synthetic failure injected for credential provider %q via %s", f.name, FaultInjectionEnvVar)
+	}
+	return nil
+}
+
+// shutdown forwards to the wrapped invoker so reused plugin processes are
+// still stopped on kubelet shutdown and after binary swaps.
+func (f *faultInjectingPlugin) shutdown() {
+	if dp, ok := f.base.(interface{ shutdown() }); ok {
+		dp.shutdown()
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	credentialproviderapi "k8s.io/kubelet/pkg/apis/credentialprovider"
+)
+
+func Test_parseFaultInjectionSpecs(t *testing.T) {
+	testcases := []struct {
+		name      string
+		value     string
+		expected  map[string]faultSpec
+		expectErr string
+	}{
+		{
+			name:  "single provider with latency and error rate",
+			value: "acr-provider:latency=2s,errorRate=0.5",
+			expected: map[string]faultSpec{
+				"acr-provider": {latency: 2 * time.Second, errorRate: 0.5},
+			},
+		},
+		{
+			name:  "multiple providers",
+			value: "acr-provider:latency=2s;gcp-provider:errorRate=1",
+			expected: map[string]faultSpec{
+				"acr-provider": {latency: 2 * time.Second},
+				"gcp-provider": {errorRate: 1},
+			},
+		},
+		{
+			name:      "missing provider name",
+			value:     "latency=2s",
+			expectErr: "is not of the form",
+		},
+		{
+			name:      "duplicate provider",
+			value:     "test:latency=1s;test:latency=2s",
+			expectErr: "duplicate fault injection entry",
+		},
+		{
+			name:      "negative latency",
+			value:     "test:latency=-1s",
+			expectErr: "must be a non-negative duration",
+		},
+		{
+			name:      "error rate above 1",
+			value:     "test:errorRate=1.5",
+			expectErr: "must be a number between 0 and 1",
+		},
+		{
+			name:      "unknown parameter",
+			value:     "test:jitter=1s",
+			expectErr: "unknown fault injection parameter",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			specs, err := parseFaultInjectionSpecs(testcase.value)
+			if len(testcase.expectErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), testcase.expectErr) {
+					t.Fatalf("expected error containing %q, got: %v", testcase.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(specs, testcase.expected) {
+				t.Errorf("expected specs %#v, got %#v", testcase.expected, specs)
+			}
+		})
+	}
+}
+
+func Test_faultInjectingPlugin(t *testing.T) {
+	base := &fakeExecPlugin{cacheKeyType: credentialproviderapi.RegistryPluginCacheKeyType}
+
+	t.Run("error rate fails invocations probabilistically", func(t *testing.T) {
+		plugin := &faultInjectingPlugin{
+			base: base,
+			name: "test",
+			spec: faultSpec{errorRate: 0.5},
+			rand: func() float64 { return 0.4 },
+		}
+
+		if _, err := plugin.ExecPlugin(context.Background(), "test.registry.io/foo", "", nil, nil); err == nil {
+			t.Fatal("expected synthetic failure when the random draw is below the error rate")
+		}
+
+		plugin.rand = func() float64 { return 0.6 }
+		if _, err := plugin.ExecPlugin(context.Background(), "test.registry.io/foo", "", nil, nil); err != nil {
+			t.Fatalf("expected invocation to pass through when the random draw is above the error rate, got: %v", err)
+		}
+	})
+
+	t.Run("latency respects context cancellation", func(t *testing.T) {
+		plugin := &faultInjectingPlugin{
+			base: base,
+			name: "test",
+			spec: faultSpec{latency: time.Minute},
+			rand: func() float64 { return 1 },
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := plugin.ExecPlugin(ctx, "test.registry.io/foo", "", nil, nil); err != context.Canceled {
+			t.Fatalf("expected context.Canceled, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"testing"
+)
+
+// FuzzDecodeResponse exercises the decoding of CredentialProviderResponse payloads
+// received from exec plugins over stdout. The kubelet must never panic on malformed
+// plugin output since a misbehaving plugin would otherwise be able to crash the
+// image pull path.
+func FuzzDecodeResponse(f *testing.F) {
+	f.Add([]byte(`{}`))
+	f.Add([]byte(`{"kind":"CredentialProviderResponse","apiVersion":"credentialprovider.kubelet.k8s.io/v1","cacheKeyType":"Registry"}`))
+	f.Add([]byte(`{"kind":"CredentialProviderResponse","apiVersion":"credentialprovider.kubelet.k8s.io/v1beta1","cacheKeyType":"Image","cacheDuration":"6m","auth":{"*.registry.io":{"username":"user","password":"password"}}}`))
+	f.Add([]byte(`{"kind":"CredentialProviderResponse","apiVersion":"credentialprovider.kubelet.k8s.io/v1alpha1","cacheKeyType":"Global"}`))
+	f.Add([]byte(`kind: CredentialProviderResponse
+apiVersion: credentialprovider.kubelet.k8s.io/v1
+cacheKeyType: Registry`))
+
+	e := &execPlugin{}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// The only requirement is that decoding does not panic or hang.
+		// Decode errors are expected for malformed input.
+		_, _ = e.decodeResponse(data)
+	})
+}
+
+// FuzzDecodeConfig exercises the decoding of CredentialProviderConfig files read
+// from disk. Malformed configuration must surface as a decode error rather than
+// a panic during kubelet startup.
+func FuzzDecodeConfig(f *testing.F) {
+	f.Add([]byte(`{}`))
+	f.Add([]byte(`{"kind":"CredentialProviderConfig","apiVersion":"kubelet.config.k8s.io/v1","providers":[{"name":"test","matchImages":["registry.io"],"defaultCacheDuration":"1m","apiVersion":"credentialprovider.kubelet.k8s.io/v1"}]}`))
+	f.Add([]byte(`kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1
+providers:
+  - name: test
+    matchImages:
+      - "registry.io"
+    defaultCacheDuration: 1m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// The only requirement is that decoding does not panic or hang.
+		// Decode errors are expected for malformed input.
+		_, _ = decode(data)
+	})
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bufio"
+	"io"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// watchHeartbeats reads newline-terminated heartbeats written by the plugin to
+// the heartbeat pipe and extends idleTimer on each one, so a plugin doing
+// legitimately slow work is not killed mid-flight. Heartbeats received after
+// hardDeadline are ignored, bounding the total runtime of a plugin that keeps
+// heartbeating. The function returns when the pipe is closed.
+func (e *execPlugin) watchHeartbeats(r io.Reader, idleTimer *time.Timer, idleTimeout time.Duration, hardDeadline time.Time) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		extension := heartbeatExtension(time.Now(), idleTimeout, hardDeadline)
+		if extension <= 0 {
+			klog.V(2).Infof("Ignoring heartbeat from credential provider plugin %s: hard timeout cap reached", e.name)
+			return
+		}
+
+		klog.V(5).Infof("Received heartbeat from credential provider plugin %s, extending timeout by %v", e.name, extension)
+		idleTimer.Reset(extension)
+	}
+}
+
+// heartbeatExtension returns how far a heartbeat received at now may push out
+// the plugin timeout: the regular idle timeout, clamped so the plugin is never
+// granted time past hardDeadline. A non-positive return value means the hard
+// cap has been reached and the heartbeat must be ignored.
+func heartbeatExtension(now time.Time, idleTimeout time.Duration, hardDeadline time.Time) time.Duration {
+	extension := hardDeadline.Sub(now)
+	if extension > idleTimeout {
+		extension = idleTimeout
+	}
+	return extension
+}
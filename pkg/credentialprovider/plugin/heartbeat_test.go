@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func Test_heartbeatExtension(t *testing.T) {
+	now := time.Now()
+
+	testcases := []struct {
+		name         string
+		idleTimeout  time.Duration
+		hardDeadline time.Time
+		want         time.Duration
+	}{
+		{
+			name:         "hard deadline far away grants the full idle timeout",
+			idleTimeout:  time.Minute,
+			hardDeadline: now.Add(time.Hour),
+			want:         time.Minute,
+		},
+		{
+			name:         "extension is clamped to the remaining time before the hard deadline",
+			idleTimeout:  time.Minute,
+			hardDeadline: now.Add(30 * time.Second),
+			want:         30 * time.Second,
+		},
+		{
+			name:         "hard deadline in the past grants no extension",
+			idleTimeout:  time.Minute,
+			hardDeadline: now.Add(-time.Second),
+			want:         -time.Second,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			if got := heartbeatExtension(now, testcase.idleTimeout, testcase.hardDeadline); got != testcase.want {
+				t.Errorf("expected extension %v, got %v", testcase.want, got)
+			}
+		})
+	}
+}
+
+func Test_watchHeartbeats(t *testing.T) {
+	e := &execPlugin{name: "heartbeat-plugin"}
+
+	fired := make(chan struct{})
+	idleTimer := time.AfterFunc(300*time.Millisecond, func() { close(fired) })
+	defer idleTimer.Stop()
+
+	read, write := io.Pipe()
+	defer read.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		e.watchHeartbeats(read, idleTimer, 300*time.Millisecond, time.Now().Add(time.Minute))
+	}()
+
+	// Heartbeats arriving well within the idle timeout keep pushing it out.
+	for i := 0; i < 20; i++ {
+		if _, err := write.Write([]byte("ping\n")); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		select {
+		case <-fired:
+			t.Fatal("expected heartbeats to keep extending the timeout")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	// Once the plugin stops heartbeating, the idle timeout fires.
+	write.Close()
+	select {
+	case <-done:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("expected watchHeartbeats to return once the pipe is closed")
+	}
+	select {
+	case <-fired:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("expected the idle timeout to fire after heartbeats stop")
+	}
+}
+
+func Test_watchHeartbeats_hardCap(t *testing.T) {
+	e := &execPlugin{name: "heartbeat-plugin"}
+
+	idleTimer := time.NewTimer(time.Hour)
+	defer idleTimer.Stop()
+
+	read, write := io.Pipe()
+	defer read.Close()
+	defer write.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		e.watchHeartbeats(read, idleTimer, time.Hour, time.Now().Add(-time.Second))
+	}()
+
+	// A heartbeat arriving after the hard cap is ignored and watching stops,
+	// even though the plugin keeps the pipe open.
+	if _, err := write.Write([]byte("ping\n")); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatal("expected watchHeartbeats to stop once the hard cap is reached")
+	}
+}
@@ -0,0 +1,43 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// setupHeartbeatPipe hands cmd the write end of a pipe as an inherited file
+// descriptor and advertises the descriptor number to the plugin via
+// KUBELET_CREDENTIAL_PROVIDER_HEARTBEAT_FD. It returns the read and write ends
+// of the pipe; the caller must close both once the plugin has exited.
+func setupHeartbeatPipe(cmd *exec.Cmd) (*os.File, *os.File, error) {
+	read, write, err := os.Pipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Entry i of ExtraFiles becomes file descriptor 3+i in the child.
+	cmd.ExtraFiles = append(cmd.ExtraFiles, write)
+	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", heartbeatFDEnvVar, 3+len(cmd.ExtraFiles)-1))
+
+	return read, write, nil
+}
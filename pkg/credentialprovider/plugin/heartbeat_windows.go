@@ -0,0 +1,32 @@
+//go:build windows
+// +build windows
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"os"
+	"os/exec"
+)
+
+// setupHeartbeatPipe is a no-op on Windows, where inheriting additional file
+// descriptors via ExtraFiles is not supported. Plugins remain bounded by the
+// fixed catch-all timeout.
+func setupHeartbeatPipe(cmd *exec.Cmd) (*os.File, *os.File, error) {
+	return nil, nil, nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"strings"
+
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+// applyImageTransforms applies the provider's configured transforms to the
+// image reference, in order, and returns the reference to match against the
+// provider's matchImages patterns. Transforms only affect matching: the plugin
+// is still invoked with, and credentials are still cached and looked up under,
+// the original reference. Transform types are validated at config load time,
+// so an unknown type here is skipped rather than treated as an error.
+func applyImageTransforms(image string, transforms []kubeletconfig.ImageTransform) string {
+	for _, transform := range transforms {
+		switch transform.Type {
+		case kubeletconfig.StripDigestImageTransformType:
+			image = stripDigest(image)
+		case kubeletconfig.StripTagImageTransformType:
+			image = stripTag(image)
+		case kubeletconfig.MapHostnameImageTransformType:
+			if transform.MapHostname != nil && parseRegistry(image) == transform.MapHostname.From {
+				image = transform.MapHostname.To + strings.TrimPrefix(image, transform.MapHostname.From)
+			}
+		}
+	}
+	return image
+}
+
+// stripDigest removes a trailing @digest from the image reference.
+func stripDigest(image string) string {
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		return image[:i]
+	}
+	return image
+}
+
+// stripTag removes a trailing :tag from the image reference, keeping any
+// digest suffix and any registry port intact.
+func stripTag(image string) string {
+	base, digest := image, ""
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		base, digest = image[:i], image[i:]
+	}
+	// A colon only introduces a tag when it appears after the last path
+	// separator; otherwise it separates the registry hostname from its port.
+	if i := strings.LastIndex(base, ":"); i > strings.LastIndex(base, "/") {
+		base = base[:i]
+	}
+	return base + digest
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	credentialproviderapi "k8s.io/kubelet/pkg/apis/credentialprovider"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func Test_applyImageTransforms(t *testing.T) {
+	stripDigest := kubeletconfig.ImageTransform{Type: kubeletconfig.StripDigestImageTransformType}
+	stripTag := kubeletconfig.ImageTransform{Type: kubeletconfig.StripTagImageTransformType}
+	mapHostname := kubeletconfig.ImageTransform{
+		Type:        kubeletconfig.MapHostnameImageTransformType,
+		MapHostname: &kubeletconfig.HostnameMapping{From: "legacy.registry.io", To: "registry.io"},
+	}
+
+	testcases := []struct {
+		name          string
+		image         string
+		transforms    []kubeletconfig.ImageTransform
+		expectedImage string
+	}{
+		{
+			name:          "no transforms leave the image untouched",
+			image:         "registry.io/app:v1",
+			expectedImage: "registry.io/app:v1",
+		},
+		{
+			name:          "strip digest removes a digest suffix",
+			image:         "registry.io/app@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			transforms:    []kubeletconfig.ImageTransform{stripDigest},
+			expectedImage: "registry.io/app",
+		},
+		{
+			name:          "strip digest is a no-op without a digest",
+			image:         "registry.io/app:v1",
+			transforms:    []kubeletconfig.ImageTransform{stripDigest},
+			expectedImage: "registry.io/app:v1",
+		},
+		{
+			name:          "strip tag removes a tag",
+			image:         "registry.io/app:v1",
+			transforms:    []kubeletconfig.ImageTransform{stripTag},
+			expectedImage: "registry.io/app",
+		},
+		{
+			name:          "strip tag keeps a registry port",
+			image:         "registry.io:8080/app:v1",
+			transforms:    []kubeletconfig.ImageTransform{stripTag},
+			expectedImage: "registry.io:8080/app",
+		},
+		{
+			name:          "strip tag keeps a digest suffix",
+			image:         "registry.io/app:v1@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			transforms:    []kubeletconfig.ImageTransform{stripTag},
+			expectedImage: "registry.io/app@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:          "map hostname rewrites a matching registry",
+			image:         "legacy.registry.io/app:v1",
+			transforms:    []kubeletconfig.ImageTransform{mapHostname},
+			expectedImage: "registry.io/app:v1",
+		},
+		{
+			name:          "map hostname is a no-op for other registries",
+			image:         "other.registry.io/app:v1",
+			transforms:    []kubeletconfig.ImageTransform{mapHostname},
+			expectedImage: "other.registry.io/app:v1",
+		},
+		{
+			name:          "transforms apply in order",
+			image:         "legacy.registry.io/app:v1@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+			transforms:    []kubeletconfig.ImageTransform{stripDigest, stripTag, mapHostname},
+			expectedImage: "registry.io/app",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			if image := applyImageTransforms(testcase.image, testcase.transforms); image != testcase.expectedImage {
+				t.Errorf("expected transformed image %q, got %q", testcase.expectedImage, image)
+			}
+		})
+	}
+}
+
+func Test_provideWithImageTransforms(t *testing.T) {
+	tclock := testingclock.NewFakeClock(time.Now())
+	p := &pluginProvider{
+		name:        "transforming-provider",
+		clock:       tclock,
+		matchImages: []string{"registry.io"},
+		imageTransforms: []kubeletconfig.ImageTransform{
+			{
+				Type:        kubeletconfig.MapHostnameImageTransformType,
+				MapHostname: &kubeletconfig.HostnameMapping{From: "legacy.registry.io", To: "registry.io"},
+			},
+		},
+		cache:          cache.NewExpirationStore(cacheKeyFunc, &cacheExpirationPolicy{clock: tclock}),
+		lastCachePurge: tclock.Now(),
+		plugin: &fakeExecPlugin{
+			cacheKeyType:  credentialproviderapi.RegistryPluginCacheKeyType,
+			cacheDuration: time.Hour,
+			auth: map[string]credentialproviderapi.AuthConfig{
+				"legacy.registry.io": {Username: "user", Password: "password"},
+			},
+		},
+	}
+
+	// The legacy hostname does not match the provider's matchImages pattern on
+	// its own; the configured mapping folds it into the canonical hostname for
+	// matching while the plugin still sees, and the cache is still keyed by,
+	// the original reference.
+	config, err := p.provideWithError(context.Background(), "legacy.registry.io/app", nil, "namespace", "pod-name", types.UID("pod-uid"), "service-account-name", nil, true, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config["legacy.registry.io"].Username != "user" {
+		t.Fatalf("unexpected docker config: %v", config)
+	}
+	entries := p.cache.List()
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 cache entry, got %d", len(entries))
+	}
+	if baseKey := entries[0].(*cacheEntry).baseKey; baseKey != "legacy.registry.io" {
+		t.Fatalf("expected the entry to be cached under the original registry, got base key %q", baseKey)
+	}
+
+	// An image the transforms do not fold into a matching reference is still
+	// rejected.
+	if _, err := p.provideWithError(context.Background(), "other.registry.io/app", nil, "namespace", "pod-name", types.UID("pod-uid"), "service-account-name", nil, true, false); !errors.Is(err, ErrNoMatch) {
+		t.Fatalf("expected a no match error for a non-matching image, got: %v", err)
+	}
+}
@@ -0,0 +1,42 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// validatePluginInterpreter verifies that the interpreter configured via the provider's
+// command exists and is owned by root, so that an unprivileged user cannot swap out the
+// interpreter the kubelet invokes.
+func validatePluginInterpreter(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("error inspecting interpreter %s: %w", path, err)
+	}
+
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok && stat.Uid != 0 {
+		return fmt.Errorf("interpreter %s must be owned by root, found uid %d", path, stat.Uid)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+//go:build windows
+// +build windows
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+)
+
+// validatePluginInterpreter verifies that the interpreter configured via the provider's
+// command exists. Ownership verification is not supported on Windows.
+func validatePluginInterpreter(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("error inspecting interpreter %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+//go:build !linux
+// +build !linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// applyIsolation fails invocations of providers configured with isolation,
+// which requires Linux namespaces. Failing loudly is preferred over silently
+// running the plugin with more access than the operator configured.
+func (e *execPlugin) applyIsolation(cmd *exec.Cmd) error {
+	if e.isolation == nil {
+		return nil
+	}
+	return fmt.Errorf("credential provider plugin %s is configured with isolation, which is only supported on Linux", e.name)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+// LintFinding is a single advisory finding about a credential provider config.
+// Findings flag configurations that are valid but commonly indicate operator
+// mistakes; they never prevent a config from loading.
+type LintFinding struct {
+	// Provider is the name of the provider the finding applies to, or empty
+	// when the finding concerns the configuration as a whole.
+	Provider string
+	// Field is the provider config field the finding concerns.
+	Field string
+	// Message describes the anti-pattern and how to address it.
+	Message string
+}
+
+// String renders the finding as a single human-readable line.
+func (f LintFinding) String() string {
+	if f.Provider == "" {
+		return fmt.Sprintf("%s: %s", f.Field, f.Message)
+	}
+	return fmt.Sprintf("provider %s: %s: %s", f.Provider, f.Field, f.Message)
+}
+
+// secretEnvVarMarkers are substrings of environment variable names that
+// suggest credential material is embedded directly in the kubelet config.
+var secretEnvVarMarkers = []string{"TOKEN", "SECRET", "PASSWORD", "PASSWD"}
+
+// LintCredentialProviderConfig inspects a credential provider config for
+// legal-but-suspect patterns. Unlike validation, findings do not fail config
+// loading: the kubelet logs them as warnings at startup, and external tooling
+// can run the same checks against a config before rolling it out.
+func LintCredentialProviderConfig(config *kubeletconfig.CredentialProviderConfig) []LintFinding {
+	var findings []LintFinding
+	// firstProviderForPattern records which provider first used each match
+	// pattern, so exact duplicates across providers can be flagged.
+	firstProviderForPattern := map[string]string{}
+
+	for _, provider := range config.Providers {
+		for _, pattern := range provider.MatchImages {
+			switch pattern {
+			case "*", "*.*":
+				findings = append(findings, LintFinding{
+					Provider: provider.Name,
+					Field:    "matchImages",
+					Message:  fmt.Sprintf("pattern %q matches every registry, including docker.io for bare image names; prefer listing the registries the plugin actually serves", pattern),
+				})
+			}
+
+			if first, seen := firstProviderForPattern[pattern]; seen && first != provider.Name {
+				findings = append(findings, LintFinding{
+					Provider: provider.Name,
+					Field:    "matchImages",
+					Message:  fmt.Sprintf("pattern %q is also matched by earlier provider %q, which takes precedence for images matching it", pattern, first),
+				})
+			} else if !seen {
+				firstProviderForPattern[pattern] = provider.Name
+			}
+		}
+
+		if provider.DefaultCacheDuration != nil && provider.DefaultCacheDuration.Duration == 0 {
+			findings = append(findings, LintFinding{
+				Provider: provider.Name,
+				Field:    "defaultCacheDuration",
+				Message:  "a zero cache duration disables credential caching, so the plugin is executed for every image pull unless its responses set an explicit cacheDuration",
+			})
+		}
+
+		if provider.TokenAttributes != nil {
+			if provider.TokenAttributes.ServiceAccountTokenAudience == "*" {
+				findings = append(findings, LintFinding{
+					Provider: provider.Name,
+					Field:    "tokenAttributes.serviceAccountTokenAudience",
+					Message:  "audience \"*\" does not restrict which services may accept the minted tokens; use an audience specific to the registry the plugin serves",
+				})
+			}
+			for _, audience := range provider.TokenAttributes.ServiceAccountTokenAudiences {
+				if audience == "*" {
+					findings = append(findings, LintFinding{
+						Provider: provider.Name,
+						Field:    "tokenAttributes.serviceAccountTokenAudiences",
+						Message:  "audience \"*\" does not restrict which services may accept the minted tokens; use an audience specific to the registry the plugin serves",
+					})
+				}
+			}
+			if provider.TokenAttributes.RequireServiceAccount != nil && !*provider.TokenAttributes.RequireServiceAccount {
+				findings = append(findings, LintFinding{
+					Provider: provider.Name,
+					Field:    "tokenAttributes.requireServiceAccount",
+					Message:  "requireServiceAccount is false, so the plugin is also invoked without a service account token and the resulting credentials are shared by all pods running without a service account",
+				})
+			}
+		}
+
+		for _, envVar := range provider.Env {
+			name := strings.ToUpper(envVar.Name)
+			for _, marker := range secretEnvVarMarkers {
+				if strings.Contains(name, marker) && envVar.Value != "" {
+					findings = append(findings, LintFinding{
+						Provider: provider.Name,
+						Field:    "env",
+						Message:  fmt.Sprintf("environment variable %q appears to embed a static secret in the kubelet configuration; prefer having the plugin read credentials from a file on the node", envVar.Name),
+					})
+					break
+				}
+			}
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+	"k8s.io/utils/ptr"
+)
+
+func Test_LintCredentialProviderConfig(t *testing.T) {
+	testcases := []struct {
+		name            string
+		config          *kubeletconfig.CredentialProviderConfig
+		expectedFinding []LintFinding
+	}{
+		{
+			name: "clean config has no findings",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test",
+						MatchImages:          []string{"registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+					},
+				},
+			},
+			expectedFinding: nil,
+		},
+		{
+			name: "wildcard match pattern",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test",
+						MatchImages:          []string{"*"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			expectedFinding: []LintFinding{
+				{
+					Provider: "test",
+					Field:    "matchImages",
+					Message:  `pattern "*" matches every registry, including docker.io for bare image names; prefer listing the registries the plugin actually serves`,
+				},
+			},
+		},
+		{
+			name: "duplicate match pattern across providers",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "first",
+						MatchImages:          []string{"registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+					},
+					{
+						Name:                 "second",
+						MatchImages:          []string{"registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			expectedFinding: []LintFinding{
+				{
+					Provider: "second",
+					Field:    "matchImages",
+					Message:  `pattern "registry.io" is also matched by earlier provider "first", which takes precedence for images matching it`,
+				},
+			},
+		},
+		{
+			name: "zero cache duration",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test",
+						MatchImages:          []string{"registry.io"},
+						DefaultCacheDuration: &metav1.Duration{},
+					},
+				},
+			},
+			expectedFinding: []LintFinding{
+				{
+					Provider: "test",
+					Field:    "defaultCacheDuration",
+					Message:  "a zero cache duration disables credential caching, so the plugin is executed for every image pull unless its responses set an explicit cacheDuration",
+				},
+			},
+		},
+		{
+			name: "unrestricted token audience and optional service account",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test",
+						MatchImages:          []string{"registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						TokenAttributes: &kubeletconfig.ServiceAccountTokenAttributes{
+							ServiceAccountTokenAudience: "*",
+							RequireServiceAccount:       ptr.To(false),
+						},
+					},
+				},
+			},
+			expectedFinding: []LintFinding{
+				{
+					Provider: "test",
+					Field:    "tokenAttributes.serviceAccountTokenAudience",
+					Message:  `audience "*" does not restrict which services may accept the minted tokens; use an audience specific to the registry the plugin serves`,
+				},
+				{
+					Provider: "test",
+					Field:    "tokenAttributes.requireServiceAccount",
+					Message:  "requireServiceAccount is false, so the plugin is also invoked without a service account token and the resulting credentials are shared by all pods running without a service account",
+				},
+			},
+		},
+		{
+			name: "static secret in env var",
+			config: &kubeletconfig.CredentialProviderConfig{
+				Providers: []kubeletconfig.CredentialProvider{
+					{
+						Name:                 "test",
+						MatchImages:          []string{"registry.io"},
+						DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+						Env: []kubeletconfig.ExecEnvVar{
+							{Name: "REGISTRY_ENDPOINT", Value: "registry.io"},
+							{Name: "REGISTRY_API_TOKEN", Value: "s3cr3t"},
+						},
+					},
+				},
+			},
+			expectedFinding: []LintFinding{
+				{
+					Provider: "test",
+					Field:    "env",
+					Message:  `environment variable "REGISTRY_API_TOKEN" appears to embed a static secret in the kubelet configuration; prefer having the plugin read credentials from a file on the node`,
+				},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			findings := LintCredentialProviderConfig(testcase.config)
+			if diff := cmp.Diff(testcase.expectedFinding, findings); diff != "" {
+				t.Errorf("unexpected findings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package plugin
 
 import (
+	"fmt"
 	"sync"
 
 	"k8s.io/component-base/metrics"
@@ -27,9 +28,34 @@ const (
 	KubeletSubsystem = "kubelet"
 )
 
+// MetricsCardinality controls which pod identity dimensions are attached to the
+// per-invocation credential provider plugin metrics.
+type MetricsCardinality string
+
+const (
+	// MetricsCardinalityProvider aggregates plugin invocation metrics per provider only.
+	// This is the default as it bounds the number of metric series by the number of
+	// configured providers.
+	MetricsCardinalityProvider MetricsCardinality = "Provider"
+	// MetricsCardinalityProviderNamespace aggregates plugin invocation metrics per
+	// provider and pod namespace. The number of metric series is bounded by the number
+	// of configured providers multiplied by the number of namespaces with pods on the node.
+	MetricsCardinalityProviderNamespace MetricsCardinality = "ProviderNamespace"
+	// MetricsCardinalityProviderServiceAccount aggregates plugin invocation metrics per
+	// provider, pod namespace and service account. On nodes running pods from many
+	// service accounts this can produce a large number of metric series and should only
+	// be enabled when the per-service-account breakdown is needed.
+	MetricsCardinalityProviderServiceAccount MetricsCardinality = "ProviderServiceAccount"
+)
+
 var (
 	registerOnce sync.Once
 
+	// metricsCardinality determines how the namespace and service_account labels of
+	// kubeletCredentialProviderPluginInvocations are populated. It defaults to
+	// per-provider aggregation and may only be changed before metrics registration.
+	metricsCardinality = MetricsCardinalityProvider
+
 	kubeletCredentialProviderPluginErrors = metrics.NewCounterVec(
 		&metrics.CounterOpts{
 			Subsystem:      KubeletSubsystem,
@@ -50,12 +76,285 @@ var (
 		},
 		[]string{"plugin_name"},
 	)
+
+	// kubeletCredentialProviderRequestSize tracks the size of encoded request
+	// bodies written to plugin stdin, before optional compression. Service
+	// account tokens with many claims are the usual reason request bodies grow
+	// into the hundreds of kilobytes.
+	kubeletCredentialProviderRequestSize = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_plugin_request_size_bytes",
+			Help:           "Size in bytes of encoded credential provider plugin request bodies, before optional compression",
+			Buckets:        metrics.ExponentialBuckets(1024, 4, 8),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	// kubeletCredentialProviderConfigFileErrors counts invalid credential provider
+	// config files that were skipped because the kubelet was started with
+	// --image-credential-provider-config-strict=false.
+	kubeletCredentialProviderConfigFileErrors = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_config_file_errors",
+			Help:           "Number of invalid credential provider config files skipped in non-strict mode",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	// kubeletCredentialProviderPluginCPUUsage accumulates the CPU time consumed by
+	// plugin processes as reported by the kernel when they are reaped. Together
+	// with the wall time covered by kubeletCredentialProviderPluginDuration, it
+	// lets operators detect plugins becoming resource hogs on busy nodes.
+	kubeletCredentialProviderPluginCPUUsage = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_plugin_cpu_usage_seconds_total",
+			Help:           "Cumulative CPU time consumed by credential provider plugin processes in seconds",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	// kubeletCredentialProviderPluginMaxRSS reports the peak resident set size of
+	// the most recently reaped plugin process.
+	kubeletCredentialProviderPluginMaxRSS = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_plugin_max_rss_bytes",
+			Help:           "Peak resident set size in bytes of the most recently reaped credential provider plugin process",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	// kubeletCredentialProviderTokenAttributesRejected counts provider
+	// configurations whose tokenAttributes were rejected because the
+	// KubeletServiceAccountTokenForCredentialProviders feature gate is disabled.
+	// A non-zero value means image pulls that depend on service account tokens
+	// fail validation instead of silently falling back to node-level credentials.
+	kubeletCredentialProviderTokenAttributesRejected = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_token_attributes_rejected",
+			Help:           "Number of credential provider configurations with tokenAttributes rejected because the KubeletServiceAccountTokenForCredentialProviders feature gate is disabled",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	// kubeletCredentialProviderTokenAudienceMismatches counts kubelet-minted
+	// service account tokens that were refused because their audiences did not
+	// match the provider's configured serviceAccountTokenAudience. A non-zero
+	// value points at a misconfigured or misbehaving token issuer.
+	kubeletCredentialProviderTokenAudienceMismatches = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_token_audience_mismatches",
+			Help:           "Number of service account tokens refused because their audiences did not match the credential provider's configured audience",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	// kubeletCredentialProviderPluginDeferrals counts non-urgent plugin
+	// invocations, such as cache prewarming, that were deferred because the node
+	// was under CPU pressure at the time.
+	kubeletCredentialProviderPluginDeferrals = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_plugin_deferrals",
+			Help:           "Number of non-urgent credential provider plugin invocations deferred due to node CPU pressure",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	// kubeletCredentialProviderPluginAPIVersion reports the credentialprovider
+	// API version each registered provider is configured with. The value is
+	// always 1; the api_version label is what enables fleet-wide dashboards
+	// tracking migration off the deprecated v1alpha1 and v1beta1 versions.
+	kubeletCredentialProviderPluginAPIVersion = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_plugin_api_version",
+			Help:           "Credentialprovider API version each registered credential provider plugin is configured with",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name", "api_version"},
+	)
+
+	// kubeletCredentialProviderPluginInfo publishes the version and supported
+	// features each plugin reported via the optional --describe contract. The
+	// value is always 1; the labels are what make it possible to audit which
+	// plugin build runs on each node across a fleet. Plugins that do not
+	// implement the contract have no series here.
+	kubeletCredentialProviderPluginInfo = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_plugin_info",
+			Help:           "Version and supported features reported by each credential provider plugin implementing the --describe contract",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name", "version", "features"},
+	)
+
+	// kubeletCredentialProviderPluginLabels publishes the operator-configured
+	// static labels of each provider, such as team or tier, as
+	// label_name/label_value pairs joined on plugin_name. The value is always
+	// 1; the series exist so alerts on this provider's other metrics can be
+	// routed to the owning team.
+	kubeletCredentialProviderPluginLabels = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_plugin_labels",
+			Help:           "Operator-configured static labels attached to each credential provider plugin via metricsLabels",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name", "label_name", "label_value"},
+	)
+
+	// kubeletCredentialProviderSkewedExpiries counts plugin-returned credentials
+	// whose embedded token expiry was in the past or implausibly far in the
+	// future relative to the node clock. A non-zero value almost always means
+	// the node clock is skewed, which otherwise only surfaces as authorization
+	// errors from the registry.
+	kubeletCredentialProviderSkewedExpiries = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_skewed_credential_expiries",
+			Help:           "Number of credentials returned by credential provider plugins with a token expiry in the past or implausibly far in the future relative to the node clock, by reason",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name", "reason"},
+	)
+
+	// kubeletCredentialProviderBinarySwaps counts staged plugin binaries the
+	// kubelet swapped in after a successful health probe, or rejected after a
+	// failed one. A growing rejected count means a DaemonSet keeps pushing a
+	// broken plugin build to this node.
+	kubeletCredentialProviderBinarySwaps = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_binary_swaps",
+			Help:           "Number of staged credential provider plugin binaries swapped in or rejected, by outcome",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name", "outcome"},
+	)
+
+	// kubeletCredentialProviderPluginDegraded reports whether each provider with
+	// a configured probeImage last failed its probe invocation. The value is 1
+	// while the provider is degraded and 0 after a successful probe; providers
+	// without a probeImage have no series here.
+	kubeletCredentialProviderPluginDegraded = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_plugin_degraded",
+			Help:           "Whether each credential provider plugin with a configured probeImage last failed its probe invocation (1 degraded, 0 healthy)",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	// kubeletCredentialProviderKeyringLookupErrors counts provider failures
+	// absorbed while composing the keyring for an image pull. The pull proceeds
+	// with credentials from the remaining providers, so these failures do not
+	// surface as pull errors; a growing count is the signal that a provider is
+	// silently dropping out of keyring composition.
+	kubeletCredentialProviderKeyringLookupErrors = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_keyring_lookup_errors",
+			Help:           "Number of credential provider plugin failures absorbed while composing the keyring for an image pull, where the pull proceeded with the remaining providers",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	// kubeletCredentialProviderTokenRequestFailures counts failed TokenRequest
+	// calls made to mint service account tokens for credential provider
+	// plugins, including calls that later succeeded on retry. It lets operators
+	// distinguish token minting issues from plugin issues, which both surface
+	// as pull failures otherwise.
+	kubeletCredentialProviderTokenRequestFailures = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_token_request_failures_total",
+			Help:           "Number of failed TokenRequest calls made to mint service account tokens for credential provider plugins, including calls that succeeded on retry",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	// kubeletCredentialProviderPluginInvocations carries the namespace and
+	// service_account labels in addition to plugin_name. Whether those labels are
+	// populated or left empty is controlled by metricsCardinality: per-provider
+	// aggregation keeps the series count bounded by the number of providers, while
+	// per-namespace and per-service-account aggregation multiply the series count
+	// by the number of distinct namespaces or service accounts pulling images on
+	// the node.
+	kubeletCredentialProviderPluginInvocations = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "credential_provider_plugin_invocations",
+			Help:           "Number of credential provider plugin invocations, optionally broken down by pod namespace and service account",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name", "namespace", "service_account"},
+	)
 )
 
+// SetMetricsCardinality configures the pod identity dimensions attached to credential
+// provider plugin invocation metrics. It must be called before the credential provider
+// plugins are registered; changing the cardinality after metrics registration is not
+// supported.
+func SetMetricsCardinality(cardinality MetricsCardinality) error {
+	switch cardinality {
+	case MetricsCardinalityProvider, MetricsCardinalityProviderNamespace, MetricsCardinalityProviderServiceAccount:
+		metricsCardinality = cardinality
+		return nil
+	default:
+		return fmt.Errorf("invalid metrics cardinality: %q", cardinality)
+	}
+}
+
+// invocationMetricLabels returns the label values for the plugin invocation metric,
+// suppressing the namespace and service account dimensions that the configured
+// metrics cardinality aggregates away.
+func invocationMetricLabels(pluginName, namespace, serviceAccount string) []string {
+	switch metricsCardinality {
+	case MetricsCardinalityProviderNamespace:
+		return []string{pluginName, namespace, ""}
+	case MetricsCardinalityProviderServiceAccount:
+		return []string{pluginName, namespace, serviceAccount}
+	default:
+		return []string{pluginName, "", ""}
+	}
+}
+
 // registerMetrics registers credential provider metrics.
 func registerMetrics() {
 	registerOnce.Do(func() {
 		legacyregistry.MustRegister(kubeletCredentialProviderPluginErrors)
 		legacyregistry.MustRegister(kubeletCredentialProviderPluginDuration)
+		legacyregistry.MustRegister(kubeletCredentialProviderRequestSize)
+		legacyregistry.MustRegister(kubeletCredentialProviderPluginInvocations)
+		legacyregistry.MustRegister(kubeletCredentialProviderConfigFileErrors)
+		legacyregistry.MustRegister(kubeletCredentialProviderPluginCPUUsage)
+		legacyregistry.MustRegister(kubeletCredentialProviderPluginMaxRSS)
+		legacyregistry.MustRegister(kubeletCredentialProviderTokenAttributesRejected)
+		legacyregistry.MustRegister(kubeletCredentialProviderPluginAPIVersion)
+		legacyregistry.MustRegister(kubeletCredentialProviderPluginInfo)
+		legacyregistry.MustRegister(kubeletCredentialProviderPluginLabels)
+		legacyregistry.MustRegister(kubeletCredentialProviderPluginDeferrals)
+		legacyregistry.MustRegister(kubeletCredentialProviderTokenAudienceMismatches)
+		legacyregistry.MustRegister(kubeletCredentialProviderBinarySwaps)
+		legacyregistry.MustRegister(kubeletCredentialProviderSkewedExpiries)
+		legacyregistry.MustRegister(kubeletCredentialProviderPluginDegraded)
+		legacyregistry.MustRegister(kubeletCredentialProviderKeyringLookupErrors)
+		legacyregistry.MustRegister(kubeletCredentialProviderTokenRequestFailures)
 	})
 }
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetMetricsCardinality(t *testing.T) {
+	defer func() { metricsCardinality = MetricsCardinalityProvider }()
+
+	testcases := []struct {
+		name        string
+		cardinality MetricsCardinality
+		expectErr   bool
+	}{
+		{
+			name:        "provider cardinality is valid",
+			cardinality: MetricsCardinalityProvider,
+		},
+		{
+			name:        "provider namespace cardinality is valid",
+			cardinality: MetricsCardinalityProviderNamespace,
+		},
+		{
+			name:        "provider service account cardinality is valid",
+			cardinality: MetricsCardinalityProviderServiceAccount,
+		},
+		{
+			name:        "unknown cardinality is rejected",
+			cardinality: MetricsCardinality("PerPod"),
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := SetMetricsCardinality(tc.cardinality)
+			if tc.expectErr != (err != nil) {
+				t.Errorf("expected error: %v, got: %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestInvocationMetricLabels(t *testing.T) {
+	defer func() { metricsCardinality = MetricsCardinalityProvider }()
+
+	testcases := []struct {
+		name        string
+		cardinality MetricsCardinality
+		expected    []string
+	}{
+		{
+			name:        "provider cardinality suppresses namespace and service account",
+			cardinality: MetricsCardinalityProvider,
+			expected:    []string{"provider", "", ""},
+		},
+		{
+			name:        "provider namespace cardinality suppresses service account",
+			cardinality: MetricsCardinalityProviderNamespace,
+			expected:    []string{"provider", "ns", ""},
+		},
+		{
+			name:        "provider service account cardinality keeps all labels",
+			cardinality: MetricsCardinalityProviderServiceAccount,
+			expected:    []string{"provider", "ns", "sa"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			metricsCardinality = tc.cardinality
+			labels := invocationMetricLabels("provider", "ns", "sa")
+			if !reflect.DeepEqual(labels, tc.expected) {
+				t.Errorf("expected labels %v, got %v", tc.expected, labels)
+			}
+		})
+	}
+}
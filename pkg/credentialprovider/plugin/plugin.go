@@ -18,15 +18,21 @@ package plugin
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/sha256"
+	"encoding/base64"
+	encodingjson "encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
 	"os"
 	"os/exec"
-	"path/filepath"
+	"runtime/pprof"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/crypto/cryptobyte"
@@ -34,15 +40,18 @@ import (
 
 	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"k8s.io/apimachinery/pkg/types"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/util/sets"
-	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/klog/v2"
 	credentialproviderapi "k8s.io/kubelet/pkg/apis/credentialprovider"
 	"k8s.io/kubelet/pkg/apis/credentialprovider/install"
@@ -50,7 +59,6 @@ import (
 	credentialproviderv1alpha1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1alpha1"
 	credentialproviderv1beta1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1beta1"
 	"k8s.io/kubernetes/pkg/credentialprovider"
-	"k8s.io/kubernetes/pkg/features"
 	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
 	kubeletconfigv1 "k8s.io/kubernetes/pkg/kubelet/apis/config/v1"
 	kubeletconfigv1alpha1 "k8s.io/kubernetes/pkg/kubelet/apis/config/v1alpha1"
@@ -61,11 +69,47 @@ import (
 const (
 	globalCacheKey     = "global"
 	cachePurgeInterval = time.Minute * 15
+
+	// pluginTimeout is the catch-all timeout for a single plugin invocation. It
+	// should leave enough head room in case a plugin needs to retry a failed
+	// request while ensuring a plugin does not run forever.
+	pluginTimeout = 1 * time.Minute
+
+	// pluginHeartbeatHardCap bounds the total runtime of an exec plugin that
+	// keeps extending its timeout via heartbeats. A plugin that is still running
+	// when the cap is reached is killed even if it is still heartbeating.
+	pluginHeartbeatHardCap = 5 * time.Minute
+
+	// heartbeatFDEnvVar advertises to exec plugins the file descriptor they may
+	// write newline-terminated heartbeats to in order to extend the invocation
+	// timeout while doing legitimately slow work.
+	heartbeatFDEnvVar = "KUBELET_CREDENTIAL_PROVIDER_HEARTBEAT_FD"
+
+	// maxRequestBodySize bounds the encoded CredentialProviderRequest written to
+	// a plugin's stdin. Service account tokens carrying many claims can grow
+	// requests well past typical pipe buffer sizes; the cap keeps a pathological
+	// token from producing an arbitrarily large request body.
+	maxRequestBodySize = 4 << 20 // 4 MiB
+
+	// requestEncodingEnvVar advertises to exec plugins the encoding of the
+	// request body on stdin. It is only set when the body is gzip-compressed; an
+	// uncompressed JSON body is the default and is sent without the variable.
+	requestEncodingEnvVar = "KUBELET_CREDENTIAL_PROVIDER_REQUEST_ENCODING"
+
+	// attestationEnvVar carries the kubelet identity attestation to exec
+	// plugins, so plugin backends can verify a request genuinely originated
+	// from this node's kubelet. It is only set when an attestation source is
+	// installed via SetNodeAttestationFunc.
+	attestationEnvVar = "KUBELET_CREDENTIAL_PROVIDER_ATTESTATION"
 )
 
 var (
 	scheme = runtime.NewScheme()
 	codecs = serializer.NewCodecFactory(scheme, serializer.EnableStrict)
+	// lenientCodecs drops unknown fields instead of failing the decode. It is only
+	// used for plugin responses, and only when the provider has opted in to
+	// tolerating unknown response fields.
+	lenientCodecs = serializer.NewCodecFactory(scheme)
 
 	apiVersions = map[string]schema.GroupVersion{
 		credentialproviderv1alpha1.SchemeGroupVersion.String(): credentialproviderv1alpha1.SchemeGroupVersion,
@@ -74,6 +118,22 @@ var (
 	}
 )
 
+// maxCacheDuration is the kubelet-level hard cap on how long credentials
+// returned by plugins are cached, independent of per-provider cache durations.
+// Zero disables the cap.
+var maxCacheDuration time.Duration
+
+// SetMaxCacheDuration sets a hard upper bound on how long credentials returned
+// by credential provider plugins are cached, regardless of the cacheDuration in
+// the plugin response or the provider's defaultCacheDuration. A non-positive
+// value disables the cap. It must be called before plugins serve credentials.
+func SetMaxCacheDuration(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	maxCacheDuration = d
+}
+
 // GetServiceAccountFunc is a function type that returns a service account for the given namespace and name.
 type GetServiceAccountFunc func(namespace, name string) (*v1.ServiceAccount, error)
 
@@ -89,8 +149,11 @@ func init() {
 }
 
 // RegisterCredentialProviderPlugins is called from kubelet to register external credential provider
-// plugins according to the CredentialProviderConfig config file.
+// plugins according to the CredentialProviderConfig config file and any providers embedded in the
+// kubelet configuration. At least one of the two sources must be given.
 func RegisterCredentialProviderPlugins(pluginConfigPath, pluginBinDir string,
+	strictConfig bool,
+	embeddedProviders []kubeletconfig.CredentialProvider,
 	getServiceAccountToken getServiceAccountTokenFunc,
 	getServiceAccount GetServiceAccountFunc,
 ) error {
@@ -102,29 +165,91 @@ func RegisterCredentialProviderPlugins(pluginConfigPath, pluginBinDir string,
 		return fmt.Errorf("error inspecting binary directory %s: %w", pluginBinDir, err)
 	}
 
-	credentialProviderConfig, err := readCredentialProviderConfig(pluginConfigPath)
-	if err != nil {
-		return err
+	// Register metrics for credential providers. This happens before the config is
+	// read so that config file errors tolerated in non-strict mode are counted.
+	registerMetrics()
+
+	var credentialProviderConfig *kubeletconfig.CredentialProviderConfig
+	var providerSources map[string]string
+	if len(pluginConfigPath) > 0 {
+		var err error
+		if isRemoteConfigPath(pluginConfigPath) {
+			credentialProviderConfig, providerSources, err = loadRemoteConfig(pluginConfigPath, pluginBinDir)
+			if err != nil {
+				return err
+			}
+			// The pinned checksum means a refresh can never change the loaded
+			// configuration; it keeps the local cache copy fresh for the next
+			// kubelet restart.
+			go refreshRemoteConfig(pluginConfigPath, remoteConfigCachePath(pluginBinDir))
+		} else {
+			credentialProviderConfig, providerSources, err = readCredentialProviderConfig(pluginConfigPath, strictConfig)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(embeddedProviders) > 0 {
+		// Providers embedded in the kubelet configuration are merged with the ones
+		// loaded from the config path under the same duplicate-name rules that apply
+		// to configuration directories.
+		var err error
+		credentialProviderConfig, err = MergeCredentialProviderConfigs(credentialProviderConfig, &kubeletconfig.CredentialProviderConfig{Providers: embeddedProviders})
+		if err != nil {
+			return err
+		}
+	}
+
+	if credentialProviderConfig == nil {
+		return fmt.Errorf("no credential provider config path given and no credential providers embedded in the kubelet configuration")
 	}
 
-	saTokenForCredentialProvidersFeatureEnabled := utilfeature.DefaultFeatureGate.Enabled(features.KubeletServiceAccountTokenForCredentialProviders)
+	saTokenForCredentialProvidersFeatureEnabled := saTokenForCredentialProvidersEnabled()
 	if errs := validateCredentialProviderConfig(credentialProviderConfig, saTokenForCredentialProvidersFeatureEnabled); len(errs) > 0 {
 		return fmt.Errorf("failed to validate credential provider config: %v", errs.ToAggregate())
 	}
 
-	// Register metrics for credential providers
-	registerMetrics()
+	// Record a hash of the loaded configuration so it can be published as a node
+	// annotation when the kubelet is configured to do so.
+	recordConfigHash(credentialProviderConfig)
+
+	// Record the final resolved provider ordering along with the file each
+	// provider was loaded from, so operators can verify precedence via DumpState
+	// instead of re-deriving the file load order by hand.
+	recordProviderOrder(credentialProviderConfig, providerSources)
+
+	// Lint findings are advisory: they flag valid configurations that commonly
+	// indicate operator mistakes, and never fail registration.
+	for _, finding := range LintCredentialProviderConfig(credentialProviderConfig) {
+		klog.InfoS("Credential provider config lint warning", "warning", finding.String())
+	}
+
+	// Record the digest-pinned image references so the per-pull keyring can
+	// restrict pinned images to their pinned provider.
+	setProviderPins(credentialProviderConfig.Pins)
+
+	// Record whether the configuration opted out of the legacy node-wide docker
+	// credential search, so the kubelet can skip building the legacy keyring.
+	setLegacyKeyringDisabled(credentialProviderConfig.DisableLegacyKeyring)
+	if credentialProviderConfig.DisableLegacyKeyring {
+		klog.InfoS("Legacy node-wide docker credential search disabled by credential provider configuration")
+	}
 
 	for _, provider := range credentialProviderConfig.Providers {
-		// Considering Windows binary with suffix ".exe", LookPath() helps to find the correct path.
-		// LookPath() also calls os.Stat().
-		pluginBin, err := exec.LookPath(filepath.Join(pluginBinDir, provider.Name))
-		if err != nil {
-			if errors.Is(err, os.ErrNotExist) || errors.Is(err, exec.ErrNotFound) {
-				return fmt.Errorf("plugin binary executable %s did not exist", pluginBin)
+		if len(provider.Command) > 0 {
+			// Script-based providers are invoked via an explicit interpreter instead of a
+			// binary named after the provider in the bin directory.
+			if err := validatePluginInterpreter(provider.Command[0]); err != nil {
+				return fmt.Errorf("error validating interpreter for plugin provider %s: %w", provider.Name, err)
+			}
+		} else {
+			// The same resolution used at invocation time, so per-platform bin
+			// layouts and binaryByPlatform entries that resolve here cannot fail
+			// differently later, and vice versa.
+			if _, err := resolvePluginBinary(pluginBinDir, provider.Name, provider.BinaryByPlatform); err != nil {
+				return err
 			}
-
-			return fmt.Errorf("error inspecting binary executable %s: %w", pluginBin, err)
 		}
 
 		plugin, err := newPluginProvider(pluginBinDir, provider, getServiceAccountToken, getServiceAccount)
@@ -132,12 +257,45 @@ func RegisterCredentialProviderPlugins(pluginConfigPath, pluginBinDir string,
 			return fmt.Errorf("error initializing plugin provider %s: %w", provider.Name, err)
 		}
 
+		recordProviderAPIVersion(provider.Name, provider.APIVersion)
+		recordProviderMetricsLabels(provider.Name, provider.MetricsLabels)
 		registerCredentialProviderPlugin(provider.Name, plugin)
+
+		// The self-description contract is optional and purely diagnostic, so it
+		// is gathered in the background without delaying registration.
+		go plugin.recordPluginDescription()
+
+		// The optional probe smoke-tests the plugin in the background, so missing
+		// binaries or broken registry access surface at load time without
+		// delaying kubelet startup.
+		go plugin.probePlugin()
 	}
 
+	// Watch for plugin binary upgrades staged next to the active binaries, so
+	// they can be probed and swapped in without interrupting image pulls.
+	go watchStagedPluginBinaries()
+
 	return nil
 }
 
+// recordProviderAPIVersion publishes the credentialprovider API version a
+// provider is configured with and warns when an older version is still in use,
+// so migration progress to v1 can be tracked fleet-wide.
+func recordProviderAPIVersion(providerName, apiVersion string) {
+	kubeletCredentialProviderPluginAPIVersion.WithLabelValues(providerName, apiVersion).Set(1)
+	if apiVersion != credentialproviderv1.SchemeGroupVersion.String() {
+		klog.Warningf("Credential provider plugin %s uses deprecated API version %s, migrate the plugin to %s", providerName, apiVersion, credentialproviderv1.SchemeGroupVersion.String())
+	}
+}
+
+// recordProviderMetricsLabels publishes the operator-configured static labels
+// of a provider so alerts on its other metrics can be joined to an owner.
+func recordProviderMetricsLabels(providerName string, labels map[string]string) {
+	for name, value := range labels {
+		kubeletCredentialProviderPluginLabels.WithLabelValues(providerName, name, value).Set(1)
+	}
+}
+
 // newPluginProvider returns a new pluginProvider based on the credential provider config.
 func newPluginProvider(pluginBinDir string, provider kubeletconfig.CredentialProvider,
 	getServiceAccountToken getServiceAccountTokenFunc,
@@ -154,28 +312,89 @@ func newPluginProvider(pluginBinDir string, provider kubeletconfig.CredentialPro
 		return nil, fmt.Errorf("invalid apiVersion: %q", provider.APIVersion)
 	}
 
+	execPlugin := &execPlugin{
+		name:             provider.Name,
+		apiVersion:       provider.APIVersion,
+		encoder:          codecs.EncoderForVersion(info.Serializer, gv),
+		pluginBinDir:     pluginBinDir,
+		binaryByPlatform: provider.BinaryByPlatform,
+		command:          provider.Command,
+		args:             provider.Args,
+		envVars:          provider.Env,
+		tmpDir:           provider.TmpDir,
+		environ:          os.Environ,
+
+		tolerateUnknownResponseFields: provider.TolerateUnknownResponseFields,
+		nodeAttributes:                provider.NodeAttributes,
+		isolation:                     provider.Isolation,
+		systemdScope:                  provider.SystemdScope,
+	}
+	var pluginImpl Plugin = execPlugin
+	switch {
+	case provider.Runtime == kubeletconfig.WASMCredentialProviderRuntime:
+		wasmRuntime := registeredWASMRuntime()
+		if wasmRuntime == nil {
+			return nil, fmt.Errorf("provider %s is configured with the wasm runtime, but no WASM runtime is wired into this kubelet build", provider.Name)
+		}
+		pluginImpl = &wasmPlugin{base: execPlugin, runtime: wasmRuntime}
+	case provider.ReuseProcess:
+		pluginImpl = &daemonPlugin{base: execPlugin}
+	}
+	// Testing hook: inject synthetic latency or failures for this provider when
+	// requested via the fault injection environment variable. A no-op unless the
+	// environment variable names this provider.
+	pluginImpl = wrapWithFaultInjection(provider.Name, pluginImpl)
+
 	clock := clock.RealClock{}
-	return &pluginProvider{
-		clock:                clock,
-		matchImages:          provider.MatchImages,
-		cache:                cache.NewExpirationStore(cacheKeyFunc, &cacheExpirationPolicy{clock: clock}),
-		defaultCacheDuration: provider.DefaultCacheDuration.Duration,
-		lastCachePurge:       clock.Now(),
-		plugin: &execPlugin{
-			name:         provider.Name,
-			apiVersion:   provider.APIVersion,
-			encoder:      codecs.EncoderForVersion(info.Serializer, gv),
-			pluginBinDir: pluginBinDir,
-			args:         provider.Args,
-			envVars:      provider.Env,
-			environ:      os.Environ,
-		},
+	pluginProvider := &pluginProvider{
+		name:                   provider.Name,
+		apiVersion:             provider.APIVersion,
+		clock:                  clock,
+		matchImages:            provider.MatchImages,
+		imageTransforms:        provider.ImageTransforms,
+		metricsLabels:          provider.MetricsLabels,
+		cache:                  cache.NewExpirationStore(cacheKeyFunc, &cacheExpirationPolicy{clock: clock}),
+		defaultCacheDuration:   provider.DefaultCacheDuration.Duration,
+		lastCachePurge:         clock.Now(),
+		plugin:                 pluginImpl,
+		jitterRand:             rand.Float64,
 		serviceAccountProvider: newServiceAccountProvider(provider, getServiceAccount, getServiceAccountToken),
-	}, nil
+
+		credentialSharingPolicy: provider.CredentialSharingPolicy,
+		probeImage:              provider.ProbeImage,
+		requiredForNodeReady:    provider.RequiredForNodeReady,
+		batchRequests:           provider.BatchRequests,
+
+		requiredPodAnnotationKeys: provider.RequiredPodAnnotationKeys,
+		optionalPodAnnotationKeys: provider.OptionalPodAnnotationKeys,
+	}
+	if provider.Runtime != kubeletconfig.WASMCredentialProviderRuntime {
+		// Self-description and request compression are negotiated with a native
+		// binary; wasm modules are invoked only through the request protocol.
+		pluginProvider.describePlugin = execPlugin.describe
+		pluginProvider.enableRequestCompression = execPlugin.enableRequestCompression
+	}
+	if provider.RefreshAheadPercent != nil {
+		pluginProvider.refreshAheadPercent = *provider.RefreshAheadPercent
+	}
+	if provider.CacheJitterPercent != nil {
+		pluginProvider.cacheJitterPercent = *provider.CacheJitterPercent
+	}
+	if len(provider.CacheKeyTemplate) > 0 {
+		cacheKeyTemplate, err := parseCacheKeyTemplate(provider.CacheKeyTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cacheKeyTemplate for provider %s: %w", provider.Name, err)
+		}
+		pluginProvider.cacheKeyTemplate = cacheKeyTemplate
+	}
+	return pluginProvider, nil
 }
 
 // pluginProvider is the plugin-based implementation of the DockerConfigProvider interface.
 type pluginProvider struct {
+	// name is the name of the provider as specified in the credential provider config.
+	name string
+
 	clock clock.Clock
 
 	sync.Mutex
@@ -187,6 +406,15 @@ type pluginProvider struct {
 	// against this list of match URLs.
 	matchImages []string
 
+	// imageTransforms is the ordered list of transforms applied to the image
+	// reference before it is matched against matchImages. Transforms only
+	// affect matching; the plugin is still invoked with the original reference.
+	imageTransforms []kubeletconfig.ImageTransform
+
+	// metricsLabels holds the operator-configured static labels of the provider,
+	// retained for state dumps.
+	metricsLabels map[string]string
+
 	// cache stores DockerConfig entries with an expiration time based on the cache duration
 	// returned from the credential provider plugin.
 	cache cache.Store
@@ -200,17 +428,121 @@ type pluginProvider struct {
 	// lastCachePurge is the last time cache is cleaned for expired entries.
 	lastCachePurge time.Time
 
+	// lastCacheKeyStrategy is the cache key strategy declared by the most
+	// recent plugin response, or the zero value before the first response. It
+	// is kept so entries cached under a previous strategy can be invalidated
+	// when a plugin upgrade changes the strategy; see migrateCacheKeyStrategy.
+	// Guarded by the embedded mutex.
+	lastCacheKeyStrategy cacheKeyStrategy
+
+	// requiredPodAnnotationKeys and optionalPodAnnotationKeys are the allowlists
+	// of pod annotation keys passed to the plugin; a pod missing a required key
+	// does not have the plugin invoked for it at all.
+	requiredPodAnnotationKeys []string
+	optionalPodAnnotationKeys []string
+
+	// refreshAheadPercent is the percentage of a cached credential's lifetime
+	// after which a cache hit triggers a background refresh, or 0 when
+	// refresh-ahead is disabled for the provider.
+	refreshAheadPercent int32
+	// refreshing tracks the keys of background refreshes currently in flight so
+	// repeated cache hits on a stale credential start at most one refresh.
+	refreshing sync.Map
+
+	// cacheJitterPercent is the maximum percentage of a cache duration randomly
+	// subtracted from cached credential lifetimes on insert, spreading out the
+	// expiry of entries primed together, or 0 when jitter is disabled for the
+	// provider.
+	cacheJitterPercent int32
+	// jitterRand returns a uniform number in [0, 1); it is a field so tests can
+	// make jitter deterministic.
+	jitterRand func() float64
+
+	// probeImage is the image reference the provider is smoke-tested with once
+	// after loading, or empty when probing is not configured.
+	probeImage string
+
+	// requiredForNodeReady, when true, surfaces this provider's probe outcome
+	// as the CredentialProviderNotReady node condition: the node reports the
+	// condition as true until the provider has passed a probe invocation.
+	requiredForNodeReady bool
+
+	// batchRequests, when true, folds every image of the pod the provider
+	// matches into a single plugin invocation and caches the response for each
+	// of them.
+	batchRequests bool
+
+	// credentialSharingPolicy is the scope of pods credentials from this
+	// provider may be shared across. Policies narrower than Node fold the pod's
+	// namespace, and for SameServiceAccount its service account name, into the
+	// cache and singleflight keys so pods outside the scope never observe each
+	// other's credentials. Empty is equivalent to Node.
+	credentialSharingPolicy kubeletconfig.CredentialSharingPolicy
+
+	// cacheKeyTemplate, when non-nil, overrides the cache scope the plugin
+	// declares in its responses with the operator-configured template rendered
+	// against the pull being served. Identity scoping from service account
+	// tokens, pod annotations and the sharing policy still applies on top.
+	cacheKeyTemplate *cacheKeyTemplate
+
 	// serviceAccountProvider holds the logic for handling service account tokens when needed.
 	serviceAccountProvider *serviceAccountProvider
+
+	// apiVersion is the exec protocol version the provider is configured with,
+	// retained for state dumps.
+	apiVersion string
+
+	// describePlugin invokes the optional --describe contract of the plugin.
+	// Only set for exec-based plugins; a func field so tests can stub it.
+	describePlugin func(ctx context.Context) (*PluginDescription, error)
+
+	// enableRequestCompression switches the plugin over to gzip-compressed
+	// request bodies. Only set for exec-based plugins; it is invoked when the
+	// plugin advertises the gzip-request feature in its self-description.
+	enableRequestCompression func()
+
+	// statusMu guards the error bookkeeping below, which feeds DumpState.
+	statusMu     sync.Mutex
+	errorCount   int64
+	recentErrors []ProviderErrorRecord
+	// degraded is true while the provider's most recent probe invocation
+	// failed. Always false for providers without a probeImage.
+	degraded bool
+	// probed is true once at least one probe invocation has completed, so node
+	// readiness can distinguish a provider that has not been probed yet from
+	// one that passed its probe.
+	probed bool
+	// lastAppliedJitter is the jitter subtracted from the most recently cached
+	// credential's lifetime, retained for DumpState. Only meaningful for
+	// providers with a configured cacheJitterPercent.
+	lastAppliedJitter time.Duration
+	// description is the plugin's self-description reported via --describe, or
+	// nil if the plugin does not implement the contract.
+	description *PluginDescription
 }
 
 type serviceAccountProvider struct {
-	audience                             string
+	pluginName string
+	// audience is the default audience tokens are minted for: the singular
+	// serviceAccountTokenAudience, or the first entry of
+	// serviceAccountTokenAudiences.
+	audience string
+	// audiences is the full set of audiences the configuration allows,
+	// including the default; a plugin response may request any of them.
+	audiences                            sets.Set[string]
 	requireServiceAccount                bool
 	getServiceAccountFunc                GetServiceAccountFunc
 	getServiceAccountTokenFunc           getServiceAccountTokenFunc
 	requiredServiceAccountAnnotationKeys []string
 	optionalServiceAccountAnnotationKeys []string
+	// allowedNamespaces and allowedServiceAccountNames restrict the pod
+	// identities the provider may receive tokens for. An empty set means no
+	// restriction on that dimension.
+	allowedNamespaces          sets.Set[string]
+	allowedServiceAccountNames sets.Set[string]
+	// tokenExpirySlack shortens how long credentials minted with a service
+	// account token may be cached relative to the token's remaining validity.
+	tokenExpirySlack time.Duration
 }
 
 func newServiceAccountProvider(
@@ -218,21 +550,60 @@ func newServiceAccountProvider(
 	getServiceAccount GetServiceAccountFunc,
 	getServiceAccountToken getServiceAccountTokenFunc,
 ) *serviceAccountProvider {
-	featureGateEnabled := utilfeature.DefaultFeatureGate.Enabled(features.KubeletServiceAccountTokenForCredentialProviders)
-	serviceAccountTokenAudienceSet := provider.TokenAttributes != nil && len(provider.TokenAttributes.ServiceAccountTokenAudience) > 0
+	featureGateEnabled := saTokenForCredentialProvidersEnabled()
+	serviceAccountTokenAudienceSet := provider.TokenAttributes != nil &&
+		(len(provider.TokenAttributes.ServiceAccountTokenAudience) > 0 || len(provider.TokenAttributes.ServiceAccountTokenAudiences) > 0)
 
 	if !featureGateEnabled || !serviceAccountTokenAudienceSet {
 		return nil
 	}
 
+	// Tokens are minted for the default audience: the singular field, or the
+	// first entry of the audience list.
+	audience := provider.TokenAttributes.ServiceAccountTokenAudience
+	if len(audience) == 0 {
+		audience = provider.TokenAttributes.ServiceAccountTokenAudiences[0]
+	}
+
+	var tokenExpirySlack time.Duration
+	if provider.TokenAttributes.TokenExpirySlack != nil {
+		tokenExpirySlack = provider.TokenAttributes.TokenExpirySlack.Duration
+	}
+
 	return &serviceAccountProvider{
-		audience:                             provider.TokenAttributes.ServiceAccountTokenAudience,
+		pluginName:                           provider.Name,
+		audience:                             audience,
+		audiences:                            sets.New[string](provider.TokenAttributes.ServiceAccountTokenAudiences...).Insert(audience),
 		requireServiceAccount:                *provider.TokenAttributes.RequireServiceAccount,
 		getServiceAccountFunc:                getServiceAccount,
 		getServiceAccountTokenFunc:           getServiceAccountToken,
 		requiredServiceAccountAnnotationKeys: provider.TokenAttributes.RequiredServiceAccountAnnotationKeys,
 		optionalServiceAccountAnnotationKeys: provider.TokenAttributes.OptionalServiceAccountAnnotationKeys,
+		allowedNamespaces:                    sets.New[string](provider.TokenAttributes.AllowedNamespaces...),
+		allowedServiceAccountNames:           sets.New[string](provider.TokenAttributes.AllowedServiceAccountNames...),
+		tokenExpirySlack:                     tokenExpirySlack,
+	}
+}
+
+// isAudienceAllowed reports whether a plugin response may request a token for
+// the given audience, i.e. whether the audience is among the audiences the
+// provider's tokenAttributes configure.
+func (s *serviceAccountProvider) isAudienceAllowed(audience string) bool {
+	return s.audiences.Has(audience)
+}
+
+// isServiceAccountAllowed reports whether the provider may receive a token for
+// the given pod identity per the allowedNamespaces and allowedServiceAccountNames
+// allowlists in the provider's tokenAttributes. Both restrictions must be
+// satisfied; an empty allowlist allows any value on that dimension.
+func (s *serviceAccountProvider) isServiceAccountAllowed(namespace, name string) bool {
+	if s.allowedNamespaces.Len() > 0 && !s.allowedNamespaces.Has(namespace) {
+		return false
 	}
+	if s.allowedServiceAccountNames.Len() > 0 && !s.allowedServiceAccountNames.Has(name) {
+		return false
+	}
+	return true
 }
 
 type requiredAnnotationNotFoundError string
@@ -279,36 +650,322 @@ func (s *serviceAccountProvider) getServiceAccountData(namespace, name string) (
 	return sa.UID, saAnnotations, nil
 }
 
-// getServiceAccountToken returns a service account token for the service account.
+// tokenRequestBackoff bounds the in-provider retries of failed TokenRequest
+// calls. The steps and durations are sized to ride out a brief API server blip
+// while staying well within the plugin invocation timeout.
+var tokenRequestBackoff = wait.Backoff{
+	Steps:    4,
+	Duration: 250 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.5,
+}
+
+// isTransientTokenRequestError reports whether a failed TokenRequest call may
+// succeed on retry. Permanent failures, such as a forbidden request, are not
+// retried; retrying them would only delay the pull failure.
+func isTransientTokenRequestError(err error) bool {
+	return apierrors.IsInternalError(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsServerTimeout(err) ||
+		utilnet.IsConnectionRefused(err) ||
+		utilnet.IsConnectionReset(err)
+}
+
+// getServiceAccountToken returns a service account token minted for the
+// provider's default audience.
 func (s *serviceAccountProvider) getServiceAccountToken(podNamespace, podName, serviceAccountName string, serviceAccountUID, podUID types.UID) (string, error) {
-	tr, err := s.getServiceAccountTokenFunc(podNamespace, serviceAccountName, &authenticationv1.TokenRequest{
-		ObjectMeta: metav1.ObjectMeta{
-			UID: serviceAccountUID,
-		},
-		Spec: authenticationv1.TokenRequestSpec{
-			Audiences: []string{s.audience},
-			// expirationSeconds is not set explicitly here. It has the same default value of "ExpirationSeconds" in the TokenRequestSpec.
-			BoundObjectRef: &authenticationv1.BoundObjectReference{
-				APIVersion: "v1",
-				Kind:       "Pod",
-				Name:       podName,
-				UID:        podUID,
+	return s.getServiceAccountTokenForAudience(s.audience, podNamespace, podName, serviceAccountName, serviceAccountUID, podUID)
+}
+
+// getServiceAccountTokenForAudience returns a service account token minted for
+// the given audience. Transient TokenRequest failures are retried with
+// jittered backoff inside the provider before the pull is failed.
+func (s *serviceAccountProvider) getServiceAccountTokenForAudience(audience, podNamespace, podName, serviceAccountName string, serviceAccountUID, podUID types.UID) (string, error) {
+	var tr *authenticationv1.TokenRequest
+	var lastErr error
+	err := wait.ExponentialBackoff(tokenRequestBackoff, func() (bool, error) {
+		var err error
+		tr, err = s.getServiceAccountTokenFunc(podNamespace, serviceAccountName, &authenticationv1.TokenRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				UID: serviceAccountUID,
 			},
-		},
+			Spec: authenticationv1.TokenRequestSpec{
+				Audiences: []string{audience},
+				// expirationSeconds is not set explicitly here. It has the same default value of "ExpirationSeconds" in the TokenRequestSpec.
+				BoundObjectRef: &authenticationv1.BoundObjectReference{
+					APIVersion: "v1",
+					Kind:       "Pod",
+					Name:       podName,
+					UID:        podUID,
+				},
+			},
+		})
+		if err == nil {
+			return true, nil
+		}
+		kubeletCredentialProviderTokenRequestFailures.WithLabelValues(s.pluginName).Inc()
+		if !isTransientTokenRequestError(err) {
+			return false, err
+		}
+		klog.V(2).InfoS("Transient failure requesting service account token for credential provider plugin, will retry", "plugin", s.pluginName, "serviceAccount", klog.KRef(podNamespace, serviceAccountName), "err", err)
+		lastErr = err
+		return false, nil
 	})
 
 	if err != nil {
+		if wait.Interrupted(err) && lastErr != nil {
+			return "", fmt.Errorf("error getting service account token after %d attempts: %w", tokenRequestBackoff.Steps, lastErr)
+		}
 		return "", err
 	}
 
+	// The token was requested with exactly the given audience, but a
+	// misconfigured or misbehaving token issuer could mint one with broader
+	// audiences than requested. A token presentable to additional audiences
+	// grants the plugin more reach than the configuration declares, so refuse
+	// to hand it over.
+	if len(audience) > 0 {
+		audiences, err := tokenAudiences(tr.Status.Token)
+		if err != nil {
+			return "", fmt.Errorf("error verifying audiences of service account token: %w", err)
+		}
+		if len(audiences) != 1 || audiences[0] != audience {
+			kubeletCredentialProviderTokenAudienceMismatches.WithLabelValues(s.pluginName).Inc()
+			return "", fmt.Errorf("refusing to pass service account token to plugin: token audiences %v do not match the requested audience %q", audiences, audience)
+		}
+	}
+
 	return tr.Status.Token, nil
 }
 
+// audienceClaim is the aud claim of a JWT, which may be encoded as a single
+// string or an array of strings per RFC 7519.
+type audienceClaim []string
+
+func (a *audienceClaim) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := encodingjson.Unmarshal(data, &single); err == nil {
+		*a = []string{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := encodingjson.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// tokenAudiences returns the audiences carried in the aud claim of a JWT. The
+// signature is deliberately not validated: the token was just minted by the
+// kubelet's own token issuer and is only decoded to cross-check its audiences
+// against the provider configuration.
+func tokenAudiences(token string) ([]string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token is not a well-formed JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("error decoding token payload: %w", err)
+	}
+
+	var claims struct {
+		Audience audienceClaim `json:"aud"`
+	}
+	if err := encodingjson.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("error parsing token claims: %w", err)
+	}
+
+	return claims.Audience, nil
+}
+
+// tokenExpiry returns the exp claim of a JWT, or the zero time if the token
+// does not carry one. Like tokenAudiences, it does not validate the signature:
+// the token was just minted by the kubelet's own token issuer and is only
+// decoded to bound how long credentials minted with it may be cached.
+func tokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("token is not a well-formed JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error decoding token payload: %w", err)
+	}
+
+	var claims struct {
+		Expiry int64 `json:"exp"`
+	}
+	if err := encodingjson.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("error parsing token claims: %w", err)
+	}
+	if claims.Expiry == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.Unix(claims.Expiry, 0), nil
+}
+
+// cacheKeyStrategy is the combination of cache scopes a plugin response
+// declared: the image/registry/global scope of the cache key and the service
+// account or pod scope credentials obtained with a token are cached under. An
+// unset serviceAccountTokenCacheType in the response is recorded as the
+// ServiceAccount default it is equivalent to, so plugins that start declaring
+// the field explicitly are not treated as having changed strategy.
+type cacheKeyStrategy struct {
+	keyType        credentialproviderapi.PluginCacheKeyType
+	tokenCacheType credentialproviderapi.ServiceAccountTokenCacheType
+}
+
 // cacheEntry is the cache object that will be stored in cache.Store.
 type cacheEntry struct {
-	key         string
-	credentials credentialprovider.DockerConfig
+	key string
+	// baseKey is the image, registry or global portion of key, before it is
+	// combined with the service account cache key. It is kept so cached
+	// credentials can be invalidated per registry.
+	baseKey string
+	// strategy is the cache key strategy of the response the entry was cached
+	// from. It is kept so entries cached under a previous strategy can be
+	// invalidated when a plugin upgrade changes the strategy.
+	strategy cacheKeyStrategy
+	// saNamespace and saName identify the service account the credentials were
+	// obtained for when the plugin operates in service account token mode. They
+	// are kept so cached credentials can be invalidated when the identity they
+	// were issued under rotates or goes away. Both are empty for providers not
+	// using tokenAttributes.
+	saNamespace string
+	saName      string
+	// credentials holds the secret material in dedicated byte slices so it can
+	// be zeroed when the entry expires or is evicted, instead of lingering on
+	// the kubelet heap until garbage collection.
+	credentials []cachedCredential
 	expiresAt   time.Time
+	// refreshAt is the point in the entry's lifetime after which a cache hit
+	// triggers a background refresh of the credentials. It is the zero time when
+	// refresh-ahead is disabled for the provider.
+	refreshAt time.Time
+}
+
+// cachedCredential is the zeroable cached form of a single docker config entry.
+// Secret fields are byte slices rather than strings so they can be wiped in
+// place; strings materialized from them on cache hits only live for the
+// duration of the image pull they are handed to.
+type cachedCredential struct {
+	matchImage    string
+	username      []byte
+	password      []byte
+	headerNames   []string
+	headerValues  [][]byte
+	email         string
+	credentialUse []credentialprovider.AuthConfigCredentialUse
+	pullEndpoint  string
+	tls           *cachedTLSCredential
+	// signing parameters reference a key rather than containing secret
+	// material, so they are cached as plain fields like pullEndpoint.
+	signing *credentialprovider.AuthConfigSigning
+}
+
+// cachedTLSCredential is the zeroable cached form of client TLS material. Only
+// the private key is secret; the certificate chain and file paths are plain
+// strings.
+type cachedTLSCredential struct {
+	clientCertificate     string
+	clientKey             []byte
+	clientCertificateFile string
+	clientKeyFile         string
+}
+
+// newCachedCredentials copies the docker config into its zeroable cached form.
+func newCachedCredentials(config credentialprovider.DockerConfig) []cachedCredential {
+	credentials := make([]cachedCredential, 0, len(config))
+	for matchImage, entry := range config {
+		credential := cachedCredential{
+			matchImage:    matchImage,
+			username:      []byte(entry.Username),
+			password:      []byte(entry.Password),
+			email:         entry.Email,
+			credentialUse: entry.CredentialUse,
+			pullEndpoint:  entry.PullEndpoint,
+		}
+		if entry.Signing != nil {
+			signing := *entry.Signing
+			credential.signing = &signing
+		}
+		for _, header := range entry.Headers {
+			credential.headerNames = append(credential.headerNames, header.Name)
+			credential.headerValues = append(credential.headerValues, []byte(header.Value))
+		}
+		if entry.TLS != nil {
+			credential.tls = &cachedTLSCredential{
+				clientCertificate:     entry.TLS.ClientCertificate,
+				clientKey:             []byte(entry.TLS.ClientKey),
+				clientCertificateFile: entry.TLS.ClientCertificateFile,
+				clientKeyFile:         entry.TLS.ClientKeyFile,
+			}
+		}
+		credentials = append(credentials, credential)
+	}
+	return credentials
+}
+
+// dockerConfig materializes the cached credentials back into a docker config.
+func (c *cacheEntry) dockerConfig() credentialprovider.DockerConfig {
+	config := make(credentialprovider.DockerConfig, len(c.credentials))
+	for _, credential := range c.credentials {
+		entry := credentialprovider.DockerConfigEntry{
+			Username:      string(credential.username),
+			Password:      string(credential.password),
+			Email:         credential.email,
+			CredentialUse: credential.credentialUse,
+			PullEndpoint:  credential.pullEndpoint,
+		}
+		if credential.signing != nil {
+			signing := *credential.signing
+			entry.Signing = &signing
+		}
+		for i, name := range credential.headerNames {
+			entry.Headers = append(entry.Headers, credentialprovider.AuthConfigHeader{Name: name, Value: string(credential.headerValues[i])})
+		}
+		if credential.tls != nil {
+			entry.TLS = &credentialprovider.AuthConfigTLS{
+				ClientCertificate:     credential.tls.clientCertificate,
+				ClientKey:             string(credential.tls.clientKey),
+				ClientCertificateFile: credential.tls.clientCertificateFile,
+				ClientKeyFile:         credential.tls.clientKeyFile,
+			}
+		}
+		config[credential.matchImage] = entry
+	}
+	return config
+}
+
+// zero wipes the secret material of the entry in place. The entry must already
+// be unreachable through the cache.
+func (c *cacheEntry) zero() {
+	for i := range c.credentials {
+		zeroBytes(c.credentials[i].username)
+		zeroBytes(c.credentials[i].password)
+		for _, value := range c.credentials[i].headerValues {
+			zeroBytes(value)
+		}
+		if c.credentials[i].tls != nil {
+			zeroBytes(c.credentials[i].tls.clientKey)
+		}
+	}
+}
+
+// zeroBytes overwrites the slice with zeros so secret material is not retained
+// until garbage collection.
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
 }
 
 // cacheKeyFunc extracts AuthEntry.MatchKey as the cache key function for the plugin provider.
@@ -323,9 +980,16 @@ type cacheExpirationPolicy struct {
 }
 
 // IsExpired returns true if the current time is after cacheEntry.expiresAt, which is determined by the
-// cache duration returned from the credential provider plugin response.
+// cache duration returned from the credential provider plugin response. Expired
+// entries are wiped on the way out so their secret material does not linger on
+// the heap until garbage collection.
 func (c *cacheExpirationPolicy) IsExpired(entry *cache.TimestampedEntry) bool {
-	return c.clock.Now().After(entry.Obj.(*cacheEntry).expiresAt)
+	cachedEntry := entry.Obj.(*cacheEntry)
+	if c.clock.Now().After(cachedEntry.expiresAt) {
+		cachedEntry.zero()
+		return true
+	}
+	return false
 }
 
 // perPluginProvider holds the shared pluginProvider and the per-request information
@@ -343,6 +1007,16 @@ type perPodPluginProvider struct {
 	podUID       types.UID
 
 	serviceAccountName string
+
+	// podAnnotations is the full set of annotations on the pod the image is
+	// being pulled for; each provider filters it down to its configured
+	// allowlist before invoking the plugin.
+	podAnnotations map[string]string
+
+	// podImages is the full list of images in the spec of the pod the image is
+	// being pulled for. Providers configured with batchRequests fold the subset
+	// they match into a single plugin invocation.
+	podImages []string
 }
 
 // Enabled always returns true since registration of the plugin via kubelet implies it should be enabled.
@@ -351,26 +1025,277 @@ func (p *perPodPluginProvider) Enabled() bool {
 }
 
 func (p *perPodPluginProvider) Provide(image string) credentialprovider.DockerConfig {
-	return p.provider.provide(image, p.podNamespace, p.podName, p.podUID, p.serviceAccountName)
+	return p.ProvideWithContext(context.Background(), image)
+}
+
+// ProvideWithContext is like Provide, but bounds the plugin invocation by the
+// given context, so the deadline and cancellation of the image pull the
+// credentials are resolved for propagate into the plugin exec. Provide remains
+// as a shim for the context-free DockerConfigProvider interface.
+func (p *perPodPluginProvider) ProvideWithContext(ctx context.Context, image string) credentialprovider.DockerConfig {
+	return p.provider.provide(ctx, image, p.podImages, p.podNamespace, p.podName, p.podUID, p.serviceAccountName, p.podAnnotations)
+}
+
+// provideWithContext is the error-surfacing variant of ProvideWithContext used
+// during keyring composition, so a provider failure can be attributed and
+// recorded while the pull proceeds on credentials from the other providers.
+func (p *perPodPluginProvider) provideWithContext(ctx context.Context, image string) (credentialprovider.DockerConfig, error) {
+	return p.provider.provideWithError(ctx, image, p.podImages, p.podNamespace, p.podName, p.podUID, p.serviceAccountName, p.podAnnotations, true, false)
+}
+
+// credentialSource describes the identity the provider's credentials are
+// scoped to, or nil when the provider operates with node-wide credentials.
+// A non-nil source carries the provider's name so the pull manager can
+// record which plugin supplied the credentials, and keeps it from recording
+// image pulls with these credentials as accessible to every pod on the node.
+func (p *perPodPluginProvider) credentialSource() *credentialprovider.CredentialSource {
+	if p.provider.serviceAccountProvider != nil && p.serviceAccountName != "" {
+		coordinates := &credentialprovider.ServiceAccountCoordinates{
+			Namespace: p.podNamespace,
+			Name:      p.serviceAccountName,
+		}
+		if uid, _, err := p.provider.serviceAccountProvider.getServiceAccountData(p.podNamespace, p.serviceAccountName); err == nil {
+			coordinates.UID = string(uid)
+		}
+		return &credentialprovider.CredentialSource{Provider: p.name, ServiceAccount: coordinates}
+	}
+
+	// Without service account tokens in play, the sharing policy alone decides
+	// whether the credentials are node-wide. The coordinates carry only the
+	// components of the pod's identity the policy partitions by.
+	switch p.provider.credentialSharingPolicy {
+	case kubeletconfig.SameNamespaceCredentialSharingPolicy:
+		return &credentialprovider.CredentialSource{Provider: p.name, ServiceAccount: &credentialprovider.ServiceAccountCoordinates{
+			Namespace: p.podNamespace,
+		}}
+	case kubeletconfig.SameServiceAccountCredentialSharingPolicy:
+		return &credentialprovider.CredentialSource{Provider: p.name, ServiceAccount: &credentialprovider.ServiceAccountCoordinates{
+			Namespace: p.podNamespace,
+			Name:      p.serviceAccountName,
+		}}
+	}
+	return nil
+}
+
+// ProvideWithError is like Provide, but surfaces the classified error when no
+// credentials could be produced. Returned errors wrap one of the exported
+// sentinel errors (ErrNoMatch, ErrPluginTimeout, ErrPluginCrashed,
+// ErrMalformedResponse, ErrTokenMintFailed) so callers can choose retry and
+// backoff behavior per failure class.
+func (p *perPodPluginProvider) ProvideWithError(image string) (credentialprovider.DockerConfig, error) {
+	return p.provider.provideWithError(context.Background(), image, p.podImages, p.podNamespace, p.podName, p.podUID, p.serviceAccountName, p.podAnnotations, true, false)
+}
+
+// ProvideForImages resolves credentials for the given images concurrently and
+// returns the resulting docker configs keyed by image. Duplicate images are only
+// resolved once, and concurrent plugin invocations for the same image are
+// deduplicated by the provider's singleflight group. It is intended for batch
+// consumers such as image prepullers that warm credentials for many images at
+// once; as a non-urgent consumer it only serves cached credentials while the
+// node is under CPU pressure.
+func (p *perPodPluginProvider) ProvideForImages(images []string) map[string]credentialprovider.DockerConfig {
+	results := make(map[string]credentialprovider.DockerConfig, len(images))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, image := range sets.NewString(images...).List() {
+		wg.Add(1)
+		go func(image string) {
+			defer wg.Done()
+
+			config, err := p.provider.provideWithError(context.Background(), image, images, p.podNamespace, p.podName, p.podUID, p.serviceAccountName, p.podAnnotations, false, false)
+			if err != nil {
+				if !errors.Is(err, ErrNoMatch) {
+					klog.Errorf("Failed getting credential from external registry credential provider %s: %v", p.provider.name, err)
+				}
+				config = credentialprovider.DockerConfig{}
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			results[image] = config
+		}(image)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// nodeUnderCPUPressure reports whether the node is under enough CPU pressure
+// that non-urgent credential provider invocations should be deferred. The
+// kubelet wires this to its node pressure view via SetNodeCPUPressureFunc; the
+// default never defers.
+var nodeUnderCPUPressure = func() bool { return false }
+
+// SetNodeCPUPressureFunc installs the function consulted before non-urgent
+// credential provider plugin invocations, such as cache prewarming through
+// ProvideForImages, so they yield to workloads when the node is under CPU
+// pressure. On-demand invocations blocking an image pull are never deferred.
+// It is intended to be called once during kubelet setup.
+func SetNodeCPUPressureFunc(fn func() bool) {
+	if fn != nil {
+		nodeUnderCPUPressure = fn
+	}
+}
+
+// credentialFailureBackOff tracks credential resolution failures per provider
+// and registry, with the same bounds the kubelet applies to image pull backoff.
+// It lets the image puller attribute a registry authorization failure to a
+// failing provider instead of growing the image pull backoff unbounded while
+// the actual problem is credential resolution.
+var credentialFailureBackOff = flowcontrol.NewBackOff(10*time.Second, 300*time.Second)
+
+// credentialFailureBackOffKey scopes failure backoff per provider and registry,
+// so one provider failing for one registry does not mark unrelated pulls.
+func credentialFailureBackOffKey(providerName, image string) string {
+	return providerName + "/" + parseRegistry(image)
+}
+
+// getNodeFunc returns the node object the kubelet is running on, for providers
+// that opt in to node-derived request fields via nodeAttributes. It is nil
+// until the kubelet installs its node view via SetNodeGetter, in which case the
+// node-derived request fields are left empty.
+var getNodeFunc func() (*v1.Node, error)
+
+// SetNodeGetter installs the function the credential provider plugins use to
+// read the node object when a provider opts in to node-derived request fields
+// via nodeAttributes. It is intended to be called once during kubelet setup.
+func SetNodeGetter(fn func() (*v1.Node, error)) {
+	if fn != nil {
+		getNodeFunc = fn
+	}
+}
+
+// GetNodeAttestationFunc mints a short-lived attestation proving that a plugin
+// invocation originated from this node's kubelet, such as a kubelet-signed
+// statement or a node-bound token. Plugin backends verify it to reject requests
+// from processes that merely copied the plugin binary and configuration onto a
+// compromised host.
+type GetNodeAttestationFunc func(ctx context.Context) (string, error)
+
+// getNodeAttestation is nil until an attestation source is installed via
+// SetNodeAttestationFunc, in which case invocations carry no attestation.
+var getNodeAttestation GetNodeAttestationFunc
+
+// SetNodeAttestationFunc installs the kubelet identity attestation source. It
+// must be called before plugins are registered. Once set, every plugin
+// invocation carries a fresh attestation in the environment and fails if one
+// cannot be minted.
+func SetNodeAttestationFunc(fn GetNodeAttestationFunc) {
+	if fn != nil {
+		getNodeAttestation = fn
+	}
+}
+
+// attestationEnv returns the environment entry carrying the kubelet identity
+// attestation, or nil when no attestation source is configured.
+func attestationEnv(ctx context.Context) ([]string, error) {
+	if getNodeAttestation == nil {
+		return nil, nil
+	}
+
+	attestation, err := getNodeAttestation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mint kubelet identity attestation: %w", err)
+	}
+	return []string{attestationEnvVar + "=" + attestation}, nil
+}
+
+// nodeRequestAttributes resolves the node-derived CredentialProviderRequest
+// fields for a provider's nodeAttributes configuration. Topology labels or
+// annotations absent from the node are omitted; a failure to read the node is
+// logged and results in empty fields rather than failing the invocation, since
+// plugins must already tolerate absent keys.
+func nodeRequestAttributes(attributes *kubeletconfig.NodeAttributes) (topologyLabels, annotations map[string]string) {
+	if attributes == nil || getNodeFunc == nil {
+		return nil, nil
+	}
+
+	node, err := getNodeFunc()
+	if err != nil {
+		klog.ErrorS(err, "Failed to get node for credential provider request enrichment")
+		return nil, nil
+	}
+
+	if attributes.SendTopologyLabels {
+		for _, key := range []string{v1.LabelTopologyZone, v1.LabelTopologyRegion} {
+			if value, ok := node.Labels[key]; ok {
+				if topologyLabels == nil {
+					topologyLabels = make(map[string]string)
+				}
+				topologyLabels[key] = value
+			}
+		}
+	}
+
+	for _, key := range attributes.AnnotationKeys {
+		if value, ok := node.Annotations[key]; ok {
+			if annotations == nil {
+				annotations = make(map[string]string)
+			}
+			annotations[key] = value
+		}
+	}
+
+	return topologyLabels, annotations
 }
 
 // provide returns a credentialprovider.DockerConfig based on the credentials returned
-// from cache or the exec plugin.
-func (p *pluginProvider) provide(image, podNamespace, podName string, podUID types.UID, serviceAccountName string) credentialprovider.DockerConfig {
-	if !p.isImageAllowed(image) {
+// from cache or the exec plugin. Errors are logged and result in empty credentials so
+// other providers in the keyring still get a chance to authenticate the image pull.
+func (p *pluginProvider) provide(ctx context.Context, image string, podImages []string, podNamespace, podName string, podUID types.UID, serviceAccountName string, podAnnotations map[string]string) credentialprovider.DockerConfig {
+	dockerConfig, err := p.provideWithError(ctx, image, podImages, podNamespace, podName, podUID, serviceAccountName, podAnnotations, true, false)
+	if err != nil {
+		if !errors.Is(err, ErrNoMatch) {
+			klog.Errorf("Failed getting credential from external registry credential provider %s: %v", p.name, err)
+		}
 		return credentialprovider.DockerConfig{}
 	}
 
+	return dockerConfig
+}
+
+// provideWithError returns a credentialprovider.DockerConfig based on the credentials
+// returned from cache or the exec plugin, along with a classified error when no
+// credentials could be produced. podImages is the full list of images of the
+// pod the pull belongs to; it is only consulted by providers configured with
+// batchRequests. Non-urgent invocations serve cached credentials
+// only while the node is under CPU pressure. bypassCache skips the cache lookup
+// and always invokes the plugin; it is used by background refreshes of cached
+// credentials that would otherwise be served their own stale entry.
+func (p *pluginProvider) provideWithError(ctx context.Context, image string, podImages []string, podNamespace, podName string, podUID types.UID, serviceAccountName string, podAnnotations map[string]string, urgent, bypassCache bool) (credentialprovider.DockerConfig, error) {
+	if !p.isImageAllowed(image) {
+		return credentialprovider.DockerConfig{}, fmt.Errorf("%w: image %s did not match provider %s", ErrNoMatch, image, p.name)
+	}
+
+	// When the provider opts in to batch requests, a single invocation carries
+	// every image of the pod the provider matches and the response is cached for
+	// each of them. The batch is sorted so sibling pulls of the same pod build
+	// the same singleflight key and collapse into one invocation.
+	var batchImages []string
+	if p.batchRequests {
+		batchImages = p.batchImagesFor(image, podImages)
+	}
+
+	// A missing required pod annotation means the workload has not opted in to
+	// this plugin, so the plugin is not invoked at all. Mirrors the semantics of
+	// required service account annotations.
+	podAnnotationsForPlugin, ok := p.podAnnotationsForPlugin(podAnnotations)
+	if !ok {
+		klog.V(5).Infof("Pod %s/%s is missing an annotation required by credential provider plugin %s", podNamespace, podName, p.name)
+		return credentialprovider.DockerConfig{}, nil
+	}
+
 	var serviceAccountUID types.UID
 	var serviceAccountToken string
 	var saAnnotations map[string]string
 	var err error
 	var serviceAccountCacheKey string
+	var podScopedCacheKey string
 
 	if p.serviceAccountProvider != nil {
 		if len(serviceAccountName) == 0 && p.serviceAccountProvider.requireServiceAccount {
 			klog.V(5).Infof("Service account name is empty for pod %s/%s", podNamespace, podName)
-			return credentialprovider.DockerConfig{}
+			return credentialprovider.DockerConfig{}, nil
 		}
 
 		// If the service account name is empty and the plugin has indicated that invoking the plugin
@@ -378,41 +1303,124 @@ func (p *pluginProvider) provide(image, podNamespace, podName string, podUID typ
 		// This is useful for plugins that are running in service account token mode and are also used
 		// to pull images for pods without service accounts (e.g., static pods).
 		if len(serviceAccountName) > 0 {
+			// The identity allowlists are enforced before any token is minted so a
+			// compromised plugin can never be handed a token for a service account
+			// outside the approved set.
+			if !p.serviceAccountProvider.isServiceAccountAllowed(podNamespace, serviceAccountName) {
+				klog.V(5).Infof("Service account %s/%s is not in the allowed set of identities for provider %s", podNamespace, serviceAccountName, p.name)
+				return credentialprovider.DockerConfig{}, nil
+			}
+
 			if serviceAccountUID, saAnnotations, err = p.serviceAccountProvider.getServiceAccountData(podNamespace, serviceAccountName); err != nil {
 				if isRequiredAnnotationNotFoundError(err) {
 					// The required annotation could be a mechanism for individual workloads to opt in to using service account tokens
 					// for image pull. If any of the required annotation is missing, we will not invoke the plugin. We will log the error
 					// at higher verbosity level as it could be noisy.
 					klog.V(5).Infof("Failed to get service account data %s/%s: %v", podNamespace, serviceAccountName, err)
-					return credentialprovider.DockerConfig{}
+					return credentialprovider.DockerConfig{}, nil
 				}
 
-				klog.Errorf("Failed to get service account %s/%s: %v", podNamespace, serviceAccountName, err)
-				return credentialprovider.DockerConfig{}
+				return credentialprovider.DockerConfig{}, fmt.Errorf("failed to get service account %s/%s: %w", podNamespace, serviceAccountName, err)
 			}
 
 			if serviceAccountToken, err = p.serviceAccountProvider.getServiceAccountToken(podNamespace, podName, serviceAccountName, serviceAccountUID, podUID); err != nil {
-				klog.Errorf("Error getting service account token %s/%s: %v", podNamespace, serviceAccountName, err)
-				return credentialprovider.DockerConfig{}
+				return credentialprovider.DockerConfig{}, fmt.Errorf("%w for service account %s/%s: %v", ErrTokenMintFailed, podNamespace, serviceAccountName, err)
 			}
 
 			serviceAccountCacheKey, err = generateServiceAccountCacheKey(podNamespace, serviceAccountName, serviceAccountUID, saAnnotations)
 			if err != nil {
-				klog.Errorf("Error generating service account cache key: %v", err)
-				return credentialprovider.DockerConfig{}
+				return credentialprovider.DockerConfig{}, fmt.Errorf("error generating service account cache key: %w", err)
+			}
+
+			podScopedCacheKey, err = generatePodScopedCacheKey(serviceAccountCacheKey, podUID)
+			if err != nil {
+				return credentialprovider.DockerConfig{}, fmt.Errorf("error generating pod-scoped cache key: %w", err)
 			}
 		}
 	}
 
-	// Check if the credentials are cached and return them if found.
-	cachedConfig, found, errCache := p.getCachedCredentials(image, serviceAccountCacheKey)
-	if errCache != nil {
-		klog.Errorf("Failed to get cached docker config: %v", err)
-		return credentialprovider.DockerConfig{}
+	// Policies narrower than Node partition cached credentials by the pod's
+	// sharing scope, so a pod outside the scope a credential was resolved for
+	// invokes the plugin instead of being served the cached credential.
+	if scopeNamespace, scopeServiceAccount, scoped := p.sharingScope(podNamespace, serviceAccountName); scoped {
+		if serviceAccountCacheKey, err = generateSharingScopeCacheKey(serviceAccountCacheKey, scopeNamespace, scopeServiceAccount); err != nil {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("error generating sharing scope cache key: %w", err)
+		}
+		if len(podScopedCacheKey) > 0 {
+			if podScopedCacheKey, err = generateSharingScopeCacheKey(podScopedCacheKey, scopeNamespace, scopeServiceAccount); err != nil {
+				return credentialprovider.DockerConfig{}, fmt.Errorf("error generating sharing scope cache key: %w", err)
+			}
+		}
 	}
 
-	if found {
-		return cachedConfig
+	// Allowlisted pod annotations are part of the scope credentials are cached
+	// under, so credentials minted for one set of workload-declared hints are
+	// not served to pods declaring different ones.
+	if len(p.requiredPodAnnotationKeys) > 0 || len(p.optionalPodAnnotationKeys) > 0 {
+		if serviceAccountCacheKey, err = generatePodAnnotationsCacheKey(serviceAccountCacheKey, podAnnotationsForPlugin); err != nil {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("error generating pod annotations cache key: %w", err)
+		}
+		if len(podScopedCacheKey) > 0 {
+			if podScopedCacheKey, err = generatePodAnnotationsCacheKey(podScopedCacheKey, podAnnotationsForPlugin); err != nil {
+				return credentialprovider.DockerConfig{}, fmt.Errorf("error generating pod annotations cache key: %w", err)
+			}
+		}
+	}
+
+	// An operator-configured cacheKeyTemplate pins the cache scope at
+	// configuration time, replacing the image/registry/global scope the plugin
+	// declares per response. It is rendered up front so lookups and stores use
+	// the same key.
+	var templateCacheKey string
+	if p.cacheKeyTemplate != nil {
+		if templateCacheKey, err = p.cacheKeyTemplate.render(cacheKeyTemplateVars{
+			Image:              image,
+			Registry:           parseRegistry(image),
+			Namespace:          podNamespace,
+			ServiceAccountName: serviceAccountName,
+		}); err != nil {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("error rendering cache key template: %w", err)
+		}
+	}
+
+	if !bypassCache {
+		// Entries the plugin declared per-pod are cached under the pod-scoped key, so
+		// check that before the service account scoped key.
+		if len(podScopedCacheKey) > 0 {
+			cachedConfig, found, refreshDue, errCache := p.lookupCachedCredentials(image, templateCacheKey, podScopedCacheKey)
+			if errCache != nil {
+				return credentialprovider.DockerConfig{}, fmt.Errorf("failed to get cached docker config: %w", errCache)
+			}
+
+			if found {
+				if refreshDue {
+					p.refreshAhead(image, podNamespace, podName, podUID, serviceAccountName, podAnnotations)
+				}
+				return cachedConfig, nil
+			}
+		}
+
+		// Check if the credentials are cached and return them if found.
+		cachedConfig, found, refreshDue, errCache := p.lookupCachedCredentials(image, templateCacheKey, serviceAccountCacheKey)
+		if errCache != nil {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("failed to get cached docker config: %w", errCache)
+		}
+
+		if found {
+			if refreshDue {
+				p.refreshAhead(image, podNamespace, podName, podUID, serviceAccountName, podAnnotations)
+			}
+			return cachedConfig, nil
+		}
+	}
+
+	// Non-urgent invocations, such as cache prewarming, yield to workloads when
+	// the node is under CPU pressure. Cached credentials were already served
+	// above; only the plugin invocation itself is deferred.
+	if !urgent && nodeUnderCPUPressure() {
+		kubeletCredentialProviderPluginDeferrals.WithLabelValues(p.name).Inc()
+		klog.V(4).Infof("Deferring non-urgent invocation of credential provider plugin %s for image %s due to node CPU pressure", p.name, image)
+		return credentialprovider.DockerConfig{}, nil
 	}
 
 	// ExecPlugin is wrapped in single flight to exec plugin once for concurrent same image request.
@@ -424,6 +1432,12 @@ func (p *pluginProvider) provide(image, podNamespace, podName string, podUID typ
 	// When the plugin is operating in the service account token mode, the singleflight key is the image plus the serviceAccountCacheKey
 	// which is generated from the service account namespace, name, uid and the annotations passed to the plugin.
 	singleFlightKey := image
+	if len(batchImages) > 1 {
+		// A batched invocation serves every image in the batch, so keying the
+		// singleflight on the batch collapses concurrent pulls of sibling images
+		// of the same pod into one invocation.
+		singleFlightKey = strings.Join(batchImages, "\x00")
+	}
 	if p.serviceAccountProvider != nil && len(serviceAccountName) > 0 {
 		// When the plugin is operating in the service account token mode, the singleflight key is the
 		// image + sa annotations + sa token.
@@ -432,25 +1446,101 @@ func (p *pluginProvider) provide(image, podNamespace, podName string, podUID typ
 		// In the future, when we support caching of the service account token for pod-sa pairs, this will be singleflighted
 		// for different containers in the same pod using the same image.
 		if singleFlightKey, err = generateSingleFlightKey(image, getHashIfNotEmpty(serviceAccountToken), saAnnotations); err != nil {
-			klog.Errorf("Error generating singleflight key: %v", err)
-			return credentialprovider.DockerConfig{}
+			return credentialprovider.DockerConfig{}, fmt.Errorf("error generating singleflight key: %w", err)
+		}
+	}
+	if len(p.requiredPodAnnotationKeys) > 0 || len(p.optionalPodAnnotationKeys) > 0 {
+		// Fold the allowlisted pod annotations into the singleflight key so
+		// concurrent pulls with different workload-declared hints are not
+		// collapsed into a single plugin invocation.
+		if singleFlightKey, err = generatePodAnnotationsCacheKey(singleFlightKey, podAnnotationsForPlugin); err != nil {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("error generating singleflight key: %w", err)
+		}
+	}
+	if scopeNamespace, scopeServiceAccount, scoped := p.sharingScope(podNamespace, serviceAccountName); scoped {
+		// Fold the sharing scope into the singleflight key so concurrent pulls
+		// from different scopes are not collapsed into a single plugin invocation.
+		if singleFlightKey, err = generateSharingScopeCacheKey(singleFlightKey, scopeNamespace, scopeServiceAccount); err != nil {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("error generating singleflight key: %w", err)
 		}
 	}
-	res, err, _ := p.group.Do(singleFlightKey, func() (interface{}, error) {
-		return p.plugin.ExecPlugin(context.Background(), image, serviceAccountToken, saAnnotations)
+	invocationStart := time.Now()
+	res, err, _ := p.group.Do(singleFlightKey, func() (res interface{}, err error) {
+		kubeletCredentialProviderPluginInvocations.WithLabelValues(invocationMetricLabels(p.name, podNamespace, serviceAccountName)...).Inc()
+		// The invocation runs under the context of the caller that won the
+		// singleflight slot; waiters collapsed onto the same invocation may see
+		// its cancellation, which classifies as ErrPluginTimeout and is retried
+		// by the next pull attempt.
+		// The goroutine driving the invocation is labeled so CPU profiles and
+		// goroutine dumps attribute plugin handling to the provider and the
+		// registry being served.
+		pprof.Do(ctx, pprof.Labels("plugin_name", p.name, "registry", parseRegistry(image)), func(ctx context.Context) {
+			if batch, ok := p.plugin.(BatchPlugin); ok && len(batchImages) > 1 {
+				res, err = batch.ExecPluginBatch(ctx, image, batchImages, serviceAccountToken, saAnnotations, podAnnotationsForPlugin)
+			} else {
+				res, err = p.plugin.ExecPlugin(ctx, image, serviceAccountToken, saAnnotations, podAnnotationsForPlugin)
+			}
+		})
+		return res, err
 	})
+	// The observed wall time includes waiting on a collapsed singleflight
+	// invocation, so the samples reflect how long pulls actually waited on
+	// credential resolution for the registry.
+	recordRegistryLatency(parseRegistry(image), time.Since(invocationStart))
 
 	if err != nil {
-		klog.Errorf("Failed getting credential from external registry credential provider: %v", err)
-		return credentialprovider.DockerConfig{}
+		p.recordPluginError(err)
+		credentialFailureBackOff.Next(credentialFailureBackOffKey(p.name, image), credentialFailureBackOff.Clock.Now())
+		return credentialprovider.DockerConfig{}, err
 	}
 
 	response, ok := res.(*credentialproviderapi.CredentialProviderResponse)
 	if !ok {
-		klog.Errorf("Invalid response type returned by external credential provider")
-		return credentialprovider.DockerConfig{}
+		credentialFailureBackOff.Next(credentialFailureBackOffKey(p.name, image), credentialFailureBackOff.Clock.Now())
+		return credentialprovider.DockerConfig{}, fmt.Errorf("%w: invalid response type returned by external credential provider", ErrMalformedResponse)
 	}
-
+	credentialFailureBackOff.Reset(credentialFailureBackOffKey(p.name, image))
+
+	// A registry federating across identity providers may need a token for a
+	// different audience than the default one. The plugin names one of the
+	// configured audiences in its response; the kubelet re-mints the token for
+	// it and repeats the invocation. The follow-up response must not switch
+	// audiences again, so a misbehaving plugin cannot loop.
+	if requestedAudience := response.ServiceAccountTokenAudience; len(requestedAudience) > 0 {
+		if p.serviceAccountProvider == nil || len(serviceAccountToken) == 0 {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("%w: credential provider plugin requested service account token audience %q but no service account token was sent", ErrMalformedResponse, requestedAudience)
+		}
+		if !p.serviceAccountProvider.isAudienceAllowed(requestedAudience) {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("credential provider plugin requested service account token audience %q, which is not among the audiences configured in tokenAttributes", requestedAudience)
+		}
+
+		if requestedAudience != p.serviceAccountProvider.audience {
+			switchedToken, err := p.serviceAccountProvider.getServiceAccountTokenForAudience(requestedAudience, podNamespace, podName, serviceAccountName, serviceAccountUID, podUID)
+			if err != nil {
+				return credentialprovider.DockerConfig{}, fmt.Errorf("%w for service account %s/%s: %v", ErrTokenMintFailed, podNamespace, serviceAccountName, err)
+			}
+
+			kubeletCredentialProviderPluginInvocations.WithLabelValues(invocationMetricLabels(p.name, podNamespace, serviceAccountName)...).Inc()
+			var switched *credentialproviderapi.CredentialProviderResponse
+			pprof.Do(ctx, pprof.Labels("plugin_name", p.name, "registry", parseRegistry(image)), func(ctx context.Context) {
+				if batch, ok := p.plugin.(BatchPlugin); ok && len(batchImages) > 1 {
+					switched, err = batch.ExecPluginBatch(ctx, image, batchImages, switchedToken, saAnnotations, podAnnotationsForPlugin)
+				} else {
+					switched, err = p.plugin.ExecPlugin(ctx, image, switchedToken, saAnnotations, podAnnotationsForPlugin)
+				}
+			})
+			if err != nil {
+				p.recordPluginError(err)
+				credentialFailureBackOff.Next(credentialFailureBackOffKey(p.name, image), credentialFailureBackOff.Clock.Now())
+				return credentialprovider.DockerConfig{}, err
+			}
+			if len(switched.ServiceAccountTokenAudience) > 0 && switched.ServiceAccountTokenAudience != requestedAudience {
+				return credentialprovider.DockerConfig{}, fmt.Errorf("%w: credential provider plugin requested a second service account token audience switch to %q", ErrMalformedResponse, switched.ServiceAccountTokenAudience)
+			}
+			response = switched
+		}
+	}
+
 	var cacheKey string
 	switch cacheKeyType := response.CacheKeyType; cacheKeyType {
 	case credentialproviderapi.ImagePluginCacheKeyType:
@@ -461,51 +1551,218 @@ func (p *pluginProvider) provide(image, podNamespace, podName string, podUID typ
 	case credentialproviderapi.GlobalPluginCacheKeyType:
 		cacheKey = globalCacheKey
 	default:
-		klog.Errorf("credential provider plugin did not return a valid cacheKeyType: %q", cacheKeyType)
-		return credentialprovider.DockerConfig{}
+		return credentialprovider.DockerConfig{}, fmt.Errorf("%w: credential provider plugin did not return a valid cacheKeyType: %q", ErrMalformedResponse, cacheKeyType)
 	}
 
+	// A batched invocation resolved credentials for every image in the batch, so
+	// the response is cached under the plugin-declared scope of each of them;
+	// otherwise a later pull of a sibling image would invoke the plugin again and
+	// defeat the batching.
+	cacheBaseKeys := []string{cacheKey}
+	if len(batchImages) > 1 {
+		switch response.CacheKeyType {
+		case credentialproviderapi.ImagePluginCacheKeyType:
+			cacheBaseKeys = batchImages
+		case credentialproviderapi.RegistryPluginCacheKeyType:
+			registries := sets.New[string]()
+			for _, batchImage := range batchImages {
+				registries.Insert(parseRegistry(batchImage))
+			}
+			cacheBaseKeys = sets.List(registries)
+		}
+	}
+
+	// Determine the service account scope credentials are cached under from the
+	// cache type the plugin declared in its response. An unset cache type defaults
+	// to service account scope, matching the behavior of plugins that predate the
+	// field.
+	storeServiceAccountCacheKey := serviceAccountCacheKey
+	cacheResponse := true
+	switch response.ServiceAccountTokenCacheType {
+	case "", credentialproviderapi.ServiceAccountServiceAccountTokenCacheType:
+		// Credentials may be served to any pod using the same service account with
+		// the same annotations.
+	case credentialproviderapi.PodServiceAccountTokenCacheType:
+		if len(podScopedCacheKey) > 0 {
+			storeServiceAccountCacheKey = podScopedCacheKey
+		} else {
+			// Without a service account token there is no pod identity to scope the
+			// credentials to, so don't cache them at all.
+			cacheResponse = false
+		}
+	default:
+		return credentialprovider.DockerConfig{}, fmt.Errorf("%w: credential provider plugin did not return a valid serviceAccountTokenCacheType: %q", ErrMalformedResponse, response.ServiceAccountTokenCacheType)
+	}
+
+	// A plugin upgrade may change the cache key strategy between responses.
+	// Entries cached under the previous strategy are invalidated so they cannot
+	// keep serving credentials at the old, possibly broader, scope.
+	strategy := cacheKeyStrategy{keyType: response.CacheKeyType, tokenCacheType: response.ServiceAccountTokenCacheType}
+	if len(strategy.tokenCacheType) == 0 {
+		strategy.tokenCacheType = credentialproviderapi.ServiceAccountServiceAccountTokenCacheType
+	}
+	p.migrateCacheKeyStrategy(strategy)
+
 	dockerConfig := make(credentialprovider.DockerConfig, len(response.Auth))
 	for matchImage, authConfig := range response.Auth {
-		dockerConfig[matchImage] = credentialprovider.DockerConfigEntry{
-			Username: authConfig.Username,
-			Password: authConfig.Password,
+		// Keys may scope credentials down to a repository path, including path
+		// wildcards such as registry.io/team-a/*, so one plugin can hand out
+		// differently privileged tokens per repository namespace.
+		if err := credentialprovider.ValidateMatchImagePattern(matchImage); err != nil {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("%w: credential provider plugin returned an invalid auth key %q: %v", ErrMalformedResponse, matchImage, err)
+		}
+		entry := credentialprovider.DockerConfigEntry{
+			Username:     authConfig.Username,
+			Password:     authConfig.Password,
+			PullEndpoint: authConfig.PullEndpoint,
+		}
+		for _, header := range authConfig.Headers {
+			entry.Headers = append(entry.Headers, credentialprovider.AuthConfigHeader{Name: header.Name, Value: header.Value})
+		}
+		for _, use := range authConfig.CredentialUse {
+			entry.CredentialUse = append(entry.CredentialUse, credentialprovider.AuthConfigCredentialUse(use))
+		}
+		if authConfig.TLS != nil {
+			inline := len(authConfig.TLS.ClientCertificate) > 0 || len(authConfig.TLS.ClientKey) > 0
+			files := len(authConfig.TLS.ClientCertificateFile) > 0 || len(authConfig.TLS.ClientKeyFile) > 0
+			if inline && files {
+				return credentialprovider.DockerConfig{}, fmt.Errorf("%w: credential provider plugin returned TLS material mixing inline and file forms for %q", ErrMalformedResponse, matchImage)
+			}
+			entry.TLS = &credentialprovider.AuthConfigTLS{
+				ClientCertificate:     authConfig.TLS.ClientCertificate,
+				ClientKey:             authConfig.TLS.ClientKey,
+				ClientCertificateFile: authConfig.TLS.ClientCertificateFile,
+				ClientKeyFile:         authConfig.TLS.ClientKeyFile,
+			}
+		}
+		if authConfig.Signing != nil {
+			if len(authConfig.Signing.Algorithm) == 0 {
+				return credentialprovider.DockerConfig{}, fmt.Errorf("%w: credential provider plugin returned signing parameters without an algorithm for %q", ErrMalformedResponse, matchImage)
+			}
+			if credentialprovider.StrictFIPSMode() && !credentialprovider.FIPSApprovedSigningAlgorithm(authConfig.Signing.Algorithm) {
+				return credentialprovider.DockerConfig{}, fmt.Errorf("credential provider plugin returned signing algorithm %q for %q, which is not FIPS-approved and the kubelet was built in strict FIPS mode", authConfig.Signing.Algorithm, matchImage)
+			}
+			entry.Signing = &credentialprovider.AuthConfigSigning{
+				Algorithm:    authConfig.Signing.Algorithm,
+				KeyReference: authConfig.Signing.KeyReference,
+			}
 		}
+		dockerConfig[matchImage] = entry
 	}
 
+	// Passwords that are JWTs carry their own expiry; cross-check it against the
+	// node clock before any cache-related early return, so skewed nodes surface
+	// a clear warning instead of only confusing 401s from the registry.
+	embeddedTokenExpiry := p.checkCredentialClockSkew(dockerConfig)
+
 	// cache duration was explicitly 0 so don't cache this response at all.
 	if response.CacheDuration != nil && response.CacheDuration.Duration == 0 {
-		return dockerConfig
+		return dockerConfig, nil
 	}
 
-	var expiresAt time.Time
+	if !cacheResponse {
+		return dockerConfig, nil
+	}
+
+	var cacheDuration time.Duration
 	// nil cache duration means use the default cache duration
 	if response.CacheDuration == nil {
 		if p.defaultCacheDuration == 0 {
-			return dockerConfig
+			return dockerConfig, nil
 		}
-		expiresAt = p.clock.Now().Add(p.defaultCacheDuration)
+		cacheDuration = p.defaultCacheDuration
 	} else {
-		expiresAt = p.clock.Now().Add(response.CacheDuration.Duration)
+		cacheDuration = response.CacheDuration.Duration
 	}
 
-	cacheKey, err = generateCacheKey(cacheKey, serviceAccountCacheKey)
-	if err != nil {
-		klog.Errorf("Error generating cache key: %v", err)
-		return credentialprovider.DockerConfig{}
+	// The kubelet-level cap applies last, as a defense-in-depth bound on how
+	// long any plugin response can pin credentials in memory.
+	if maxCacheDuration > 0 && cacheDuration > maxCacheDuration {
+		klog.V(4).Infof("Capping cache duration of credentials from plugin %s from %s to the kubelet-level maximum %s", p.name, cacheDuration, maxCacheDuration)
+		cacheDuration = maxCacheDuration
 	}
 
-	cachedEntry := &cacheEntry{
-		key:         cacheKey,
-		credentials: dockerConfig,
-		expiresAt:   expiresAt,
+	// Credentials embedding a token must not be cached past the token's own
+	// expiry. An already-expired token is still returned for this pull attempt,
+	// which was warned about above, but is not cached.
+	if !embeddedTokenExpiry.IsZero() {
+		remaining := embeddedTokenExpiry.Sub(p.clock.Now())
+		if remaining <= 0 {
+			return dockerConfig, nil
+		}
+		if remaining < cacheDuration {
+			klog.V(4).Infof("Capping cache duration of credentials from plugin %s from %s to the embedded token lifetime %s", p.name, cacheDuration, remaining)
+			cacheDuration = remaining
+		}
 	}
 
-	if err := p.cache.Add(cachedEntry); err != nil {
-		klog.Errorf("Error adding auth entry to cache: %v", err)
+	// Credentials minted with a service account token must not outlive the
+	// identity they were minted from: the cache duration is capped at the
+	// token's remaining validity, less the configured slack. Without this a
+	// plugin-declared cacheDuration could keep serving credentials for a
+	// service account whose token, and possibly access, has already expired.
+	if len(serviceAccountToken) > 0 {
+		expiry, err := tokenExpiry(serviceAccountToken)
+		if err != nil {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("error reading expiry of service account token: %w", err)
+		}
+		if !expiry.IsZero() {
+			remaining := expiry.Sub(p.clock.Now()) - p.serviceAccountProvider.tokenExpirySlack
+			if remaining <= 0 {
+				return dockerConfig, nil
+			}
+			if remaining < cacheDuration {
+				klog.V(4).Infof("Capping cache duration of credentials from plugin %s from %s to the remaining service account token validity %s", p.name, cacheDuration, remaining)
+				cacheDuration = remaining
+			}
+		}
 	}
 
-	return dockerConfig
+	// Jitter is applied after every cap, and only ever shortens the lifetime, so
+	// credentials are never cached past the expiry the plugin declared. Spreading
+	// out the expiry of entries primed together keeps a node boot from turning
+	// into a synchronized expiry storm of plugin invocations later.
+	if p.cacheJitterPercent > 0 {
+		jitter := time.Duration(p.jitterRand() * float64(cacheDuration) * float64(p.cacheJitterPercent) / 100)
+		cacheDuration -= jitter
+		p.recordAppliedJitter(jitter)
+		klog.V(4).Infof("Shortened cache duration of credentials from plugin %s by %s of jitter", p.name, jitter)
+	}
+
+	// baseKey keeps the plugin-declared image/registry/global scope even when a
+	// cacheKeyTemplate overrides the cache key, so registry-targeted cache
+	// invalidation still finds template-keyed entries.
+	for _, baseKey := range cacheBaseKeys {
+		cacheKey := baseKey
+		if p.cacheKeyTemplate != nil {
+			cacheKey = templateCacheKey
+		}
+		cacheKey, err = generateCacheKey(cacheKey, storeServiceAccountCacheKey)
+		if err != nil {
+			return credentialprovider.DockerConfig{}, fmt.Errorf("error generating cache key: %w", err)
+		}
+
+		cachedEntry := &cacheEntry{
+			key:         cacheKey,
+			baseKey:     baseKey,
+			strategy:    strategy,
+			credentials: newCachedCredentials(dockerConfig),
+			expiresAt:   p.clock.Now().Add(cacheDuration),
+		}
+		if p.refreshAheadPercent > 0 {
+			cachedEntry.refreshAt = p.clock.Now().Add(cacheDuration * time.Duration(p.refreshAheadPercent) / 100)
+		}
+		if p.serviceAccountProvider != nil && len(serviceAccountName) > 0 {
+			cachedEntry.saNamespace = podNamespace
+			cachedEntry.saName = serviceAccountName
+		}
+
+		if err := p.cache.Add(cachedEntry); err != nil {
+			klog.Errorf("Error adding auth entry to cache: %v", err)
+		}
+	}
+
+	return dockerConfig, nil
 }
 
 // Enabled always returns true since registration of the plugin via kubelet implies it should be enabled.
@@ -513,8 +1770,38 @@ func (p *pluginProvider) Enabled() bool {
 	return true
 }
 
+// sharingScope returns the identity components cached credentials are
+// partitioned by under the provider's credentialSharingPolicy, and whether
+// partitioning applies at all. The Node policy, and an unset policy, do not
+// partition.
+func (p *pluginProvider) sharingScope(podNamespace, serviceAccountName string) (namespace, saName string, scoped bool) {
+	switch p.credentialSharingPolicy {
+	case kubeletconfig.SameNamespaceCredentialSharingPolicy:
+		return podNamespace, "", true
+	case kubeletconfig.SameServiceAccountCredentialSharingPolicy:
+		return podNamespace, serviceAccountName, true
+	}
+	return "", "", false
+}
+
+// batchImagesFor returns the sorted, deduplicated subset of the pod's images
+// the provider matches, always including the image being pulled. Sorting keeps
+// the batch identical for every pull of the pod, so concurrent sibling pulls
+// build the same singleflight key and collapse into one plugin invocation.
+func (p *pluginProvider) batchImagesFor(image string, podImages []string) []string {
+	images := sets.New(image)
+	for _, podImage := range podImages {
+		if !images.Has(podImage) && p.isImageAllowed(podImage) {
+			images.Insert(podImage)
+		}
+	}
+	return sets.List(images)
+}
+
 // isImageAllowed returns true if the image matches against the list of allowed matches by the plugin.
+// Configured image transforms are applied to the reference before matching.
 func (p *pluginProvider) isImageAllowed(image string) bool {
+	image = applyImageTransforms(image, p.imageTransforms)
 	for _, matchImage := range p.matchImages {
 		if matched, _ := credentialprovider.URLsMatchStr(matchImage, image); matched {
 			return true
@@ -524,8 +1811,68 @@ func (p *pluginProvider) isImageAllowed(image string) bool {
 	return false
 }
 
-// getCachedCredentials returns a credentialprovider.DockerConfig if cached from the plugin.
-func (p *pluginProvider) getCachedCredentials(image, serviceAccountCacheKey string) (credentialprovider.DockerConfig, bool, error) {
+// podAnnotationsForPlugin filters the pod's annotations down to the keys the
+// provider is configured to pass to the plugin. It returns false when a
+// required annotation key is missing from the pod, in which case the plugin
+// should not be invoked at all.
+func (p *pluginProvider) podAnnotationsForPlugin(podAnnotations map[string]string) (map[string]string, bool) {
+	if len(p.requiredPodAnnotationKeys) == 0 && len(p.optionalPodAnnotationKeys) == 0 {
+		return nil, true
+	}
+
+	annotations := make(map[string]string, len(p.requiredPodAnnotationKeys)+len(p.optionalPodAnnotationKeys))
+	for _, key := range p.requiredPodAnnotationKeys {
+		value, ok := podAnnotations[key]
+		if !ok {
+			return nil, false
+		}
+		annotations[key] = value
+	}
+	for _, key := range p.optionalPodAnnotationKeys {
+		if value, ok := podAnnotations[key]; ok {
+			annotations[key] = value
+		}
+	}
+
+	return annotations, true
+}
+
+// lookupCachedCredentials returns cached credentials for a pull. With a
+// cacheKeyTemplate configured, the rendered template key is the only cache
+// scope consulted; otherwise the plugin-declared image, registry and global
+// scopes are tried in order.
+func (p *pluginProvider) lookupCachedCredentials(image, templateCacheKey, serviceAccountCacheKey string) (credentialprovider.DockerConfig, bool, bool, error) {
+	if p.cacheKeyTemplate != nil {
+		return p.getCachedCredentialsByBaseKey(templateCacheKey, serviceAccountCacheKey)
+	}
+	return p.getCachedCredentials(image, serviceAccountCacheKey)
+}
+
+// getCachedCredentialsByBaseKey returns cached credentials stored under exactly
+// the given base key, without the image/registry/global fallback chain.
+func (p *pluginProvider) getCachedCredentialsByBaseKey(baseKey, serviceAccountCacheKey string) (credentialprovider.DockerConfig, bool, bool, error) {
+	p.purgeExpiredCacheEntries()
+
+	cacheKey, err := generateCacheKey(baseKey, serviceAccountCacheKey)
+	if err != nil {
+		return nil, false, false, fmt.Errorf("error generating cache key: %w", err)
+	}
+
+	obj, found, err := p.cache.GetByKey(cacheKey)
+	if err != nil {
+		return nil, false, false, err
+	}
+
+	if found {
+		entry := obj.(*cacheEntry)
+		return entry.dockerConfig(), true, p.refreshDue(entry), nil
+	}
+
+	return nil, false, false, nil
+}
+
+// purgeExpiredCacheEntries periodically forces expired entries out of the cache.
+func (p *pluginProvider) purgeExpiredCacheEntries() {
 	p.Lock()
 	if p.clock.Now().After(p.lastCachePurge.Add(cachePurgeInterval)) {
 		// NewExpirationCache purges expired entries when List() is called
@@ -535,71 +1882,292 @@ func (p *pluginProvider) getCachedCredentials(image, serviceAccountCacheKey stri
 		p.lastCachePurge = p.clock.Now()
 	}
 	p.Unlock()
+}
+
+// getCachedCredentials returns a credentialprovider.DockerConfig if cached from the plugin.
+// refreshDue is true when the entry has passed the provider's refreshAheadPercent
+// threshold of its lifetime, so the caller should kick off a background refresh
+// while serving the still-valid cached credentials.
+func (p *pluginProvider) getCachedCredentials(image, serviceAccountCacheKey string) (credentialprovider.DockerConfig, bool, bool, error) {
+	p.purgeExpiredCacheEntries()
 
 	cacheKey, err := generateCacheKey(image, serviceAccountCacheKey)
 	if err != nil {
-		return nil, false, fmt.Errorf("error generating cache key: %w", err)
+		return nil, false, false, fmt.Errorf("error generating cache key: %w", err)
 	}
 
 	obj, found, err := p.cache.GetByKey(cacheKey)
 	if err != nil {
-		return nil, false, err
+		return nil, false, false, err
 	}
 
 	if found {
-		return obj.(*cacheEntry).credentials, true, nil
+		entry := obj.(*cacheEntry)
+		return entry.dockerConfig(), true, p.refreshDue(entry), nil
 	}
 
 	registry := parseRegistry(image)
 
 	cacheKey, err = generateCacheKey(registry, serviceAccountCacheKey)
 	if err != nil {
-		return nil, false, fmt.Errorf("error generating cache key: %w", err)
+		return nil, false, false, fmt.Errorf("error generating cache key: %w", err)
 	}
 
 	obj, found, err = p.cache.GetByKey(cacheKey)
 	if err != nil {
-		return nil, false, err
+		return nil, false, false, err
 	}
 
 	if found {
-		return obj.(*cacheEntry).credentials, true, nil
+		entry := obj.(*cacheEntry)
+		return entry.dockerConfig(), true, p.refreshDue(entry), nil
 	}
 
 	cacheKey, err = generateCacheKey(globalCacheKey, serviceAccountCacheKey)
 	if err != nil {
-		return nil, false, fmt.Errorf("error generating cache key: %w", err)
+		return nil, false, false, fmt.Errorf("error generating cache key: %w", err)
 	}
 
 	obj, found, err = p.cache.GetByKey(cacheKey)
 	if err != nil {
-		return nil, false, err
+		return nil, false, false, err
 	}
 
 	if found {
-		return obj.(*cacheEntry).credentials, true, nil
+		entry := obj.(*cacheEntry)
+		return entry.dockerConfig(), true, p.refreshDue(entry), nil
+	}
+
+	return nil, false, false, nil
+}
+
+// peekCachedEntry returns the cache entry the provider would serve for the
+// image under the node-wide (empty) service account scope, trying the image,
+// registry and global cache scopes in order. It only inspects the cache and
+// never invokes the plugin.
+func (p *pluginProvider) peekCachedEntry(image string) (*cacheEntry, bool) {
+	p.purgeExpiredCacheEntries()
+
+	for _, baseKey := range []string{image, parseRegistry(image), globalCacheKey} {
+		cacheKey, err := generateCacheKey(baseKey, "")
+		if err != nil {
+			return nil, false
+		}
+		obj, found, err := p.cache.GetByKey(cacheKey)
+		if err != nil || !found {
+			continue
+		}
+		return obj.(*cacheEntry), true
+	}
+
+	return nil, false
+}
+
+// refreshDue reports whether a cached entry has entered its refresh-ahead
+// window, i.e. it is still valid but more than refreshAheadPercent of its
+// lifetime has elapsed.
+func (p *pluginProvider) refreshDue(entry *cacheEntry) bool {
+	return !entry.refreshAt.IsZero() && p.clock.Now().After(entry.refreshAt)
+}
+
+// refreshAhead kicks off a background plugin invocation to refresh cached
+// credentials that have entered their refresh-ahead window, so the image pull
+// that hit the cache is served the still-valid credentials without waiting on
+// plugin latency. Concurrent refreshes for the same image and pod identity are
+// deduplicated. If the refresh fails, the cached credentials stay in place
+// until they expire.
+func (p *pluginProvider) refreshAhead(image, podNamespace, podName string, podUID types.UID, serviceAccountName string, podAnnotations map[string]string) {
+	key := strings.Join([]string{image, podNamespace, podName, serviceAccountName}, "\x00")
+	if _, loaded := p.refreshing.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	go func() {
+		defer p.refreshing.Delete(key)
+		// The refresh deliberately does not inherit the triggering pull's
+		// context: it outlives the pull that hit the cache, and the plugin's
+		// own invocation timeout still bounds it.
+		if _, err := p.provideWithError(context.Background(), image, nil, podNamespace, podName, podUID, serviceAccountName, podAnnotations, false, true); err != nil && !errors.Is(err, ErrNoMatch) {
+			klog.V(2).Infof("Background refresh of credentials from external registry credential provider %s for image %s failed, cached credentials remain in use: %v", p.name, image, err)
+		}
+	}()
+}
+
+// migrateCacheKeyStrategy reconciles the cache with the key strategy declared
+// by the latest plugin response. When a plugin upgrade changes the strategy,
+// for example from registry-wide keying to per-service-account caching,
+// entries cached under the previous strategy would either keep serving
+// credentials at the old, broader scope or linger unreachable under keys no
+// lookup builds anymore. Only those entries are invalidated; entries already
+// cached under the new strategy, such as ones stored by concurrent pulls after
+// the upgrade, are kept, so a strategy change does not flush the whole cache.
+// Providers with a cacheKeyTemplate pin the cache scope at configuration time,
+// so the strategy a response declares does not affect their keys and no
+// migration is performed.
+func (p *pluginProvider) migrateCacheKeyStrategy(strategy cacheKeyStrategy) {
+	if p.cacheKeyTemplate != nil {
+		return
+	}
+
+	p.Lock()
+	changed := p.lastCacheKeyStrategy != (cacheKeyStrategy{}) && p.lastCacheKeyStrategy != strategy
+	p.lastCacheKeyStrategy = strategy
+	p.Unlock()
+	if !changed {
+		return
+	}
+
+	removed := 0
+	for _, obj := range p.cache.List() {
+		entry := obj.(*cacheEntry)
+		if entry.strategy == strategy {
+			continue
+		}
+
+		if err := p.cache.Delete(entry); err != nil {
+			klog.Errorf("Error removing auth entry from cache: %v", err)
+			continue
+		}
+		entry.zero()
+		removed++
+	}
+
+	if removed > 0 {
+		klog.V(2).InfoS("Invalidated credentials cached under a previous cache key strategy", "plugin", p.name, "removedEntries", removed)
+	}
+}
+
+// invalidateCache removes cached credentials from the plugin cache. If registry is empty,
+// all cached entries are removed. Otherwise only entries cached for the given registry are
+// removed, including per-image entries from that registry and global entries, which may
+// span registries. It returns the number of entries removed.
+func (p *pluginProvider) invalidateCache(registry string) int {
+	removed := 0
+	for _, obj := range p.cache.List() {
+		entry := obj.(*cacheEntry)
+		if len(registry) > 0 && entry.baseKey != globalCacheKey && parseRegistry(entry.baseKey) != registry {
+			continue
+		}
+
+		if err := p.cache.Delete(entry); err != nil {
+			klog.Errorf("Error removing auth entry from cache: %v", err)
+			continue
+		}
+		entry.zero()
+		removed++
 	}
 
-	return nil, false, nil
+	return removed
+}
+
+// invalidateCacheForServiceAccount removes cached credentials that were obtained
+// using a token for the given service account, so a rotated or deleted identity
+// does not keep serving credentials until cacheDuration elapses. It returns the
+// number of entries removed.
+func (p *pluginProvider) invalidateCacheForServiceAccount(namespace, name string) int {
+	removed := 0
+	for _, obj := range p.cache.List() {
+		entry := obj.(*cacheEntry)
+		if entry.saNamespace != namespace || entry.saName != name {
+			continue
+		}
+
+		if err := p.cache.Delete(entry); err != nil {
+			klog.Errorf("Error removing auth entry from cache: %v", err)
+			continue
+		}
+		entry.zero()
+		removed++
+	}
+
+	return removed
 }
 
 // Plugin is the interface calling ExecPlugin. This is mainly for testability
 // so tests don't have to actually exec any processes.
 type Plugin interface {
-	ExecPlugin(ctx context.Context, image, serviceAccountToken string, serviceAccountAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error)
+	ExecPlugin(ctx context.Context, image, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error)
+}
+
+// BatchPlugin is the optional interface of plugins that can resolve
+// credentials for several images in a single invocation. It is only consulted
+// for providers configured with batchRequests; image is the image being pulled
+// and must be among images.
+type BatchPlugin interface {
+	ExecPluginBatch(ctx context.Context, image string, images []string, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error)
 }
 
 // execPlugin is the implementation of the Plugin interface that execs a credential provider plugin based
 // on it's name provided in CredentialProviderConfig. It is assumed that the executable is available in the
 // plugin directory provided by the kubelet.
 type execPlugin struct {
-	name         string
-	apiVersion   string
-	encoder      runtime.Encoder
-	args         []string
-	envVars      []kubeletconfig.ExecEnvVar
+	name       string
+	apiVersion string
+	encoder    runtime.Encoder
+	// command optionally overrides how the plugin is invoked, allowing script-based
+	// plugins to be run via an explicit interpreter. If empty, the plugin binary named
+	// after the provider in pluginBinDir is executed.
+	command []string
+	args    []string
+	envVars []kubeletconfig.ExecEnvVar
+	// tmpDir, if non-empty, is exported to the plugin process as TMPDIR so plugin
+	// scratch files are kept out of the kubelet's own temp directory.
+	tmpDir       string
 	pluginBinDir string
-	environ      func() []string
+	// binaryByPlatform maps a platform key such as linux-amd64 to the binary
+	// the kubelet executes on that platform, relative to pluginBinDir. If the
+	// current platform has no entry, the binary named after the provider is
+	// looked up in a per-platform subdirectory of pluginBinDir and then in
+	// pluginBinDir itself.
+	binaryByPlatform map[string]string
+	environ          func() []string
+
+	// tolerateUnknownResponseFields drops unknown fields from plugin responses
+	// with a warning log instead of failing the strict decode, easing version
+	// skew with plugins ahead of the kubelet.
+	tolerateUnknownResponseFields bool
+
+	// nodeAttributes configures the node-derived fields included in requests to
+	// this plugin; nil means no node information is sent.
+	nodeAttributes *kubeletconfig.NodeAttributes
+
+	// isolation confines the plugin process to dedicated Linux namespaces; nil
+	// means the plugin runs with the kubelet's own namespaces.
+	isolation *kubeletconfig.CredentialProviderIsolation
+
+	// systemdScope places the plugin process in a systemd transient scope with
+	// the configured resource limits; nil means the plugin runs as a raw
+	// kubelet child.
+	systemdScope *kubeletconfig.CredentialProviderSystemdScope
+
+	// compressRequests gzips encoded request bodies before they are written to
+	// the plugin's stdin. It is only enabled for plugins that advertise the
+	// gzip-request feature in their self-description, and may be flipped while
+	// invocations are in flight.
+	compressRequests atomic.Bool
+}
+
+// enableRequestCompression switches the plugin over to gzip-compressed request
+// bodies. It is safe to call while invocations are in flight; they pick up the
+// change on their next request.
+func (e *execPlugin) enableRequestCompression() {
+	e.compressRequests.Store(true)
+}
+
+// configEnv returns the environment variables configured for the plugin in the
+// credential provider config, including the TMPDIR override when a dedicated
+// temp directory is configured.
+func (e *execPlugin) configEnv() []string {
+	var configEnvVars []string
+	for _, v := range e.envVars {
+		configEnvVars = append(configEnvVars, fmt.Sprintf("%s=%s", v.Name, v.Value))
+	}
+	if len(e.tmpDir) > 0 {
+		// Appended last so the dedicated temp directory wins over a TMPDIR
+		// configured via env.
+		configEnvVars = append(configEnvVars, fmt.Sprintf("TMPDIR=%s", e.tmpDir))
+	}
+	return configEnvVars
 }
 
 // ExecPlugin executes the plugin binary with arguments and environment variables specified in CredentialProviderConfig:
@@ -608,59 +2176,165 @@ type execPlugin struct {
 //
 // The plugin is expected to receive the CredentialProviderRequest API via stdin from the kubelet and
 // return CredentialProviderResponse via stdout.
-func (e *execPlugin) ExecPlugin(ctx context.Context, image, serviceAccountToken string, serviceAccountAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error) {
+func (e *execPlugin) ExecPlugin(ctx context.Context, image, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error) {
 	klog.V(5).Infof("Getting image %s credentials from external exec plugin %s", image, e.name)
 
-	authRequest := &credentialproviderapi.CredentialProviderRequest{Image: image, ServiceAccountToken: serviceAccountToken, ServiceAccountAnnotations: serviceAccountAnnotations}
+	return e.execRequest(ctx, e.buildRequest(image, serviceAccountToken, serviceAccountAnnotations, podAnnotations))
+}
+
+// ExecPluginBatch is like ExecPlugin, but the single invocation carries the
+// full image list of the batch in the request's images field; image is the
+// image being pulled and must be among images. The response's auth map is
+// expected to cover every image in the batch.
+func (e *execPlugin) ExecPluginBatch(ctx context.Context, image string, images []string, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error) {
+	klog.V(5).Infof("Getting credentials for %d images from external exec plugin %s in a single batched invocation", len(images), e.name)
+
+	authRequest := e.buildRequest(image, serviceAccountToken, serviceAccountAnnotations, podAnnotations)
+	authRequest.Images = images
+	return e.execRequest(ctx, authRequest)
+}
+
+// buildRequest assembles the CredentialProviderRequest for one invocation of
+// the plugin.
+func (e *execPlugin) buildRequest(image, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) *credentialproviderapi.CredentialProviderRequest {
+	nodeTopologyLabels, nodeAnnotations := nodeRequestAttributes(e.nodeAttributes)
+	return &credentialproviderapi.CredentialProviderRequest{
+		Image:                     image,
+		ServiceAccountToken:       serviceAccountToken,
+		ServiceAccountAnnotations: serviceAccountAnnotations,
+		PodAnnotations:            podAnnotations,
+		NodeTopologyLabels:        nodeTopologyLabels,
+		NodeAnnotations:           nodeAnnotations,
+		RequestHash:               computeRequestHash(image, serviceAccountAnnotations),
+	}
+}
+
+// execRequest execs the plugin binary once for the given request and decodes
+// the CredentialProviderResponse it writes to stdout.
+func (e *execPlugin) execRequest(ctx context.Context, authRequest *credentialproviderapi.CredentialProviderRequest) (*credentialproviderapi.CredentialProviderResponse, error) {
+	if err := beginPluginInvocation(e.name); err != nil {
+		return nil, err
+	}
+	defer endPluginInvocation()
+
 	data, err := e.encodeRequest(authRequest)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode auth request: %w", err)
 	}
+	// The encoded request carries the pod's service account token; zero it once
+	// the plugin invocation is over so it is not retained in an intermediate
+	// buffer until garbage collection.
+	defer zeroBytes(data)
+
+	kubeletCredentialProviderRequestSize.WithLabelValues(e.name).Observe(float64(len(data)))
+	if len(data) > maxRequestBodySize {
+		return nil, fmt.Errorf("encoded auth request for credential provider plugin %s is %d bytes, exceeding the maximum request body size of %d bytes", e.name, len(data), maxRequestBodySize)
+	}
+
+	requestBody := data
+	compressed := e.compressRequests.Load()
+	if compressed {
+		if requestBody, err = compressRequestBody(data); err != nil {
+			return nil, fmt.Errorf("failed to compress auth request: %w", err)
+		}
+		// The compressed body carries the same token as the encoded request.
+		defer zeroBytes(requestBody)
+	}
 
 	stdout := &bytes.Buffer{}
 	stderr := &bytes.Buffer{}
-	stdin := bytes.NewBuffer(data)
+	stdin := bytes.NewBuffer(requestBody)
 
-	// Use a catch-all timeout of 1 minute for all exec-based plugins, this should leave enough
-	// head room in case a plugin needs to retry a failed request while ensuring an exec plugin
-	// does not run forever. In the future we may want this timeout to be tweakable from the plugin
-	// config file.
-	ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	// The invocation is bounded by the catch-all plugin timeout, but the timeout
+	// is driven by a resettable timer instead of a fixed context deadline so
+	// heartbeats from the plugin can push it out. In the future we may want this
+	// timeout to be tweakable from the plugin config file.
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
-
-	cmd := exec.CommandContext(ctx, filepath.Join(e.pluginBinDir, e.name), e.args...)
+	defer registerInvocationCancel(cancel)()
+	idleTimer := time.AfterFunc(pluginTimeout, cancel)
+	defer idleTimer.Stop()
+
+	var cmd *exec.Cmd
+	if len(e.command) > 0 {
+		cmdArgs := append(e.command[1:len(e.command):len(e.command)], e.args...)
+		cmd = exec.CommandContext(ctx, e.command[0], cmdArgs...)
+		// Relative script paths in command are resolved against the plugin bin directory.
+		cmd.Dir = e.pluginBinDir
+	} else {
+		pluginBin, err := resolvePluginBinary(e.pluginBinDir, e.name, e.binaryByPlatform)
+		if err != nil {
+			return nil, err
+		}
+		cmd = exec.CommandContext(ctx, pluginBin, e.args...)
+	}
 	cmd.Stdout, cmd.Stderr, cmd.Stdin = stdout, stderr, stdin
+	// On cancellation, whether from the plugin timeout or kubelet shutdown, the
+	// plugin is asked to exit with SIGTERM first so it can clean up scratch
+	// files, and is killed if it is still running after the grace period.
+	cmd.Cancel = func() error { return terminateProcess(cmd.Process) }
+	cmd.WaitDelay = terminateGracePeriod
 
-	var configEnvVars []string
-	for _, v := range e.envVars {
-		configEnvVars = append(configEnvVars, fmt.Sprintf("%s=%s", v.Name, v.Value))
+	if err := e.applyIsolation(cmd); err != nil {
+		return nil, err
 	}
 
 	// Append current system environment variables, to the ones configured in the
 	// credential provider file. Failing to do so may result in unsuccessful execution
 	// of the provider binary, see https://github.com/kubernetes/kubernetes/issues/102750
 	// also, this behaviour is inline with Credential Provider Config spec
-	cmd.Env = mergeEnvVars(e.environ(), configEnvVars)
+	cmd.Env = mergeEnvVars(e.environ(), e.configEnv())
+	if compressed {
+		cmd.Env = append(cmd.Env, requestEncodingEnvVar+"=gzip")
+	}
 
-	if err = e.runPlugin(ctx, cmd, image); err != nil {
+	// When the kubelet has an identity attestation source, every invocation
+	// carries a fresh attestation so plugin backends can reject requests that
+	// did not originate from this node's kubelet. Failing to mint one fails the
+	// invocation instead of silently downgrading the contract.
+	attestation, err := attestationEnv(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Env = append(cmd.Env, attestation...)
+
+	// Plugins that legitimately take longer than the catch-all timeout, such as
+	// a first-time token mint against a cold HSM or an MFA exchange, can write
+	// newline-terminated heartbeats to the file descriptor advertised via
+	// KUBELET_CREDENTIAL_PROVIDER_HEARTBEAT_FD while they work. Each heartbeat
+	// pushes the timeout out again, up to a hard cap so a hung plugin that keeps
+	// heartbeating is still killed eventually.
+	heartbeatRead, heartbeatWrite, err := setupHeartbeatPipe(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create heartbeat pipe: %w", err)
+	}
+	if heartbeatRead != nil {
+		defer heartbeatRead.Close()
+		defer heartbeatWrite.Close()
+		go e.watchHeartbeats(heartbeatRead, idleTimer, pluginTimeout, time.Now().Add(pluginHeartbeatHardCap))
+	}
+
+	if err = e.runPlugin(ctx, cmd, authRequest.Image); err != nil {
 		return nil, fmt.Errorf("%w: %s", err, stderr.String())
 	}
 
 	data = stdout.Bytes()
+	// The raw response carries credentials; zero it once decoded.
+	defer zeroBytes(data)
 	// check that the response apiVersion matches what is expected
 	gvk, err := json.DefaultMetaFactory.Interpret(data)
 	if err != nil {
-		return nil, fmt.Errorf("error reading GVK from response: %w", err)
+		return nil, fmt.Errorf("%w: error reading GVK from response: %v", ErrMalformedResponse, err)
 	}
 
 	if gvk.GroupVersion().String() != e.apiVersion {
-		return nil, fmt.Errorf("apiVersion from credential plugin response did not match expected apiVersion:%s, actual apiVersion:%s", e.apiVersion, gvk.GroupVersion().String())
+		return nil, fmt.Errorf("%w: apiVersion from credential plugin response did not match expected apiVersion:%s, actual apiVersion:%s", ErrMalformedResponse, e.apiVersion, gvk.GroupVersion().String())
 	}
 
 	response, err := e.decodeResponse(data)
 	if err != nil {
 		// err is explicitly not wrapped since it may contain credentials in the response.
-		return nil, errors.New("error decoding credential provider plugin response from stdout")
+		return nil, fmt.Errorf("%w: error decoding credential provider plugin response from stdout", ErrMalformedResponse)
 	}
 
 	return response, nil
@@ -669,21 +2343,87 @@ func (e *execPlugin) ExecPlugin(ctx context.Context, image, serviceAccountToken
 func (e *execPlugin) runPlugin(ctx context.Context, cmd *exec.Cmd, image string) error {
 	startTime := time.Now()
 	defer func() {
-		kubeletCredentialProviderPluginDuration.WithLabelValues(e.name).Observe(time.Since(startTime).Seconds())
+		// Observing through the context attaches a trace exemplar to the duration
+		// histogram when tracing is enabled and the invocation's span is sampled,
+		// so slow invocations on a latency dashboard link directly to their trace.
+		kubeletCredentialProviderPluginDuration.WithContext(ctx).WithLabelValues(e.name).Observe(time.Since(startTime).Seconds())
 	}()
 
-	err := cmd.Run()
+	err := cmd.Start()
+	if err == nil {
+		if scopeErr := e.startSystemdScope(ctx, cmd); scopeErr != nil {
+			// The plugin must not keep running without the scope the operator
+			// configured; terminate it and fail the invocation.
+			_ = terminateProcess(cmd.Process)
+			_ = cmd.Wait()
+			kubeletCredentialProviderPluginErrors.WithLabelValues(e.name).Inc()
+			scopeErr = fmt.Errorf("%w: error placing credential provider plugin %s in a systemd scope for image %s: %v", ErrPluginCrashed, e.name, image, scopeErr)
+			publishEvent(Event{Type: InvocationFailedEvent, Provider: e.name, Err: scopeErr})
+			return scopeErr
+		}
+		err = cmd.Wait()
+	}
+	if cmd.ProcessState != nil {
+		recordPluginResourceUsage(e.name, cmd.ProcessState)
+	}
 	if ctx.Err() != nil {
 		kubeletCredentialProviderPluginErrors.WithLabelValues(e.name).Inc()
-		return fmt.Errorf("error execing credential provider plugin %s for image %s: %w", e.name, image, ctx.Err())
+		err := fmt.Errorf("%w: error execing credential provider plugin %s for image %s: %v", ErrPluginTimeout, e.name, image, ctx.Err())
+		publishEvent(Event{Type: InvocationFailedEvent, Provider: e.name, Err: err})
+		return err
 	}
 	if err != nil {
 		kubeletCredentialProviderPluginErrors.WithLabelValues(e.name).Inc()
-		return fmt.Errorf("error execing credential provider plugin %s for image %s: %w", e.name, image, err)
+		err := fmt.Errorf("%w: error execing credential provider plugin %s for image %s: %v", ErrPluginCrashed, e.name, image, err)
+		publishEvent(Event{Type: InvocationFailedEvent, Provider: e.name, Err: err})
+		return err
 	}
 	return nil
 }
 
+// computeRequestHash returns the deterministic hash identifying a credential
+// provider request that the kubelet publishes in the requestHash field, so
+// long-running plugins can deduplicate or memoize requests on their side. The
+// hash covers the image and the sorted service account annotation key/value
+// pairs, each terminated by a NUL byte; the service account token is excluded
+// because it is rotated independently of the request content. The canonical
+// form is part of the plugin API contract and must not change across kubelet
+// versions, as plugins may persist hashes across kubelet upgrades.
+func computeRequestHash(image string, serviceAccountAnnotations map[string]string) string {
+	hash := sha256.New()
+	hash.Write([]byte(image))
+	hash.Write([]byte{0})
+
+	keys := make([]string, 0, len(serviceAccountAnnotations))
+	for key := range serviceAccountAnnotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write([]byte(serviceAccountAnnotations[key]))
+		hash.Write([]byte{0})
+	}
+
+	return fmt.Sprintf("sha256:%x", hash.Sum(nil))
+}
+
+// compressRequestBody gzips an encoded request so plugins that advertise the
+// gzip-request feature receive a body that stays within pipe buffer limits
+// even when the service account token carries large claims.
+func compressRequestBody(data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	if _, err := gz.Write(data); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // encodeRequest encodes the internal CredentialProviderRequest type into the v1alpha1 version in json
 func (e *execPlugin) encodeRequest(request *credentialproviderapi.CredentialProviderRequest) ([]byte, error) {
 	data, err := runtime.Encode(e.encoder, request)
@@ -698,7 +2438,19 @@ func (e *execPlugin) encodeRequest(request *credentialproviderapi.CredentialProv
 func (e *execPlugin) decodeResponse(data []byte) (*credentialproviderapi.CredentialProviderResponse, error) {
 	obj, gvk, err := codecs.UniversalDecoder().Decode(data, nil, nil)
 	if err != nil {
-		return nil, err
+		if !e.tolerateUnknownResponseFields || !runtime.IsStrictDecodingError(err) {
+			return nil, err
+		}
+
+		// The provider opted in to tolerating responses from plugins using a newer
+		// API minor version than the kubelet. Retry the decode leniently so unknown
+		// fields are dropped instead of failing the response. The strict error is
+		// not logged since it may contain credentials.
+		klog.Warningf("Dropping unknown fields from credential provider plugin %s response", e.name)
+		obj, gvk, err = lenientCodecs.UniversalDecoder().Decode(data, nil, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if gvk.Kind != "CredentialProviderResponse" {
@@ -768,6 +2520,80 @@ func generateServiceAccountCacheKey(serviceAccountNamespace, serviceAccountName
 	return string(keyBytes), nil
 }
 
+// generatePodScopedCacheKey narrows a service account cache key to a single pod,
+// so credentials the plugin declared cacheable per-pod only are never served to
+// other pods using the same service account.
+func generatePodScopedCacheKey(serviceAccountCacheKey string, podUID types.UID) (string, error) {
+	b := cryptobyte.NewBuilder(nil)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes([]byte(serviceAccountCacheKey))
+	})
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes([]byte(podUID))
+	})
+
+	keyBytes, err := b.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return string(keyBytes), nil
+}
+
+// generateSharingScopeCacheKey extends a cache key with the pod namespace and,
+// under the SameServiceAccount policy, the service account name, so cached
+// credentials are partitioned by the provider's credential sharing scope.
+func generateSharingScopeCacheKey(baseKey, namespace, serviceAccountName string) (string, error) {
+	b := cryptobyte.NewBuilder(nil)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes([]byte(baseKey))
+	})
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes([]byte(namespace))
+	})
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes([]byte(serviceAccountName))
+	})
+
+	keyBytes, err := b.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return string(keyBytes), nil
+}
+
+// generatePodAnnotationsCacheKey extends a cache key with the allowlisted pod
+// annotations passed to the plugin, so credentials minted for one set of
+// annotation values are not served for a different set.
+func generatePodAnnotationsCacheKey(baseKey string, podAnnotations map[string]string) (string, error) {
+	b := cryptobyte.NewBuilder(nil)
+	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddBytes([]byte(baseKey))
+	})
+
+	// add the length of annotations to the cache key
+	b.AddUint32(uint32(len(podAnnotations)))
+
+	// Sort the annotations by key to ensure the cache key is deterministic
+	keys := sets.StringKeySet(podAnnotations).List()
+	for _, k := range keys {
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes([]byte(k))
+		})
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddBytes([]byte(podAnnotations[k]))
+		})
+	}
+
+	keyBytes, err := b.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return string(keyBytes), nil
+}
+
 func generateCacheKey(baseKey, serviceAccountCacheKey string) (string, error) {
 	b := cryptobyte.NewBuilder(nil)
 	b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
@@ -17,14 +17,20 @@ limitations under the License.
 package plugin
 
 import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
-	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/credentialprovider"
-	"k8s.io/kubernetes/pkg/features"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
 )
 
 type provider struct {
@@ -32,45 +38,323 @@ type provider struct {
 	impl *pluginProvider
 }
 
+// CredentialProviderConfigHashAnnotation is the node annotation on which the kubelet
+// publishes a hash of the loaded credential provider configuration when opted in via
+// the --image-credential-provider-publish-config-hash flag. The hash covers provider
+// names and apiVersions only and contains no secrets.
+const CredentialProviderConfigHashAnnotation = "credentialprovider.kubelet.kubernetes.io/config-hash"
+
 var providersMutex sync.RWMutex
 var providers = make([]provider, 0)
 var seenProviderNames = sets.NewString()
+var loadedConfigHash string
+var loadedProviderOrder []ProviderOrderRecord
+var providerPins = make(map[string]string)
+var legacyKeyringDisabled bool
 
-func registerCredentialProviderPlugin(name string, p *pluginProvider) {
+// setLegacyKeyringDisabled records whether the loaded configuration opted out
+// of the legacy node-wide docker credential search via disableLegacyKeyring.
+func setLegacyKeyringDisabled(disabled bool) {
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+	legacyKeyringDisabled = disabled
+}
+
+// LegacyKeyringDisabled reports whether the loaded credential provider
+// configuration set disableLegacyKeyring, in which case the kubelet must not
+// probe /var/lib/kubelet/config.json, .dockercfg or HOME paths for node-wide
+// credentials. It returns false if no configuration has been loaded.
+func LegacyKeyringDisabled() bool {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+	return legacyKeyringDisabled
+}
+
+// setProviderPins records the digest-pinned image references from the loaded
+// configuration, mapping each pinned image to the name of the provider that
+// must resolve it.
+func setProviderPins(pins []kubeletconfig.CredentialProviderPin) {
 	providersMutex.Lock()
 	defer providersMutex.Unlock()
 
+	for _, pin := range pins {
+		providerPins[pin.Image] = pin.Provider
+	}
+}
+
+// recordConfigHash computes and stores a hash summarizing the loaded credential provider
+// configuration. The hash is derived from the sorted provider name/apiVersion pairs so it
+// is stable across nodes loading equivalent configuration from differently ordered files.
+func recordConfigHash(config *kubeletconfig.CredentialProviderConfig) {
+	summaries := make([]string, 0, len(config.Providers))
+	for _, p := range config.Providers {
+		summaries = append(summaries, fmt.Sprintf("%s/%s", p.Name, p.APIVersion))
+	}
+	sort.Strings(summaries)
+
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+	loadedConfigHash = fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(strings.Join(summaries, ","))))
+}
+
+// recordProviderOrder stores the final resolved provider ordering of the loaded
+// configuration. Providers are consulted in this order during image pulls and
+// the first provider whose matchImages pattern matches an image wins, so the
+// ordering is the precedence operators need to verify. sources maps provider
+// names to the config file they were loaded from; providers without an entry
+// were embedded in the kubelet configuration.
+func recordProviderOrder(config *kubeletconfig.CredentialProviderConfig, sources map[string]string) {
+	order := make([]ProviderOrderRecord, 0, len(config.Providers))
+	names := make([]string, 0, len(config.Providers))
+	for _, p := range config.Providers {
+		order = append(order, ProviderOrderRecord{Name: p.Name, SourceFile: sources[p.Name]})
+		names = append(names, p.Name)
+	}
+
+	providersMutex.Lock()
+	loadedProviderOrder = order
+	providersMutex.Unlock()
+
+	klog.V(2).InfoS("Resolved credential provider precedence", "order", names)
+}
+
+// LoadedConfigHash returns the hash of the credential provider configuration recorded at
+// plugin registration, or an empty string if no configuration has been loaded.
+func LoadedConfigHash() string {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+	return loadedConfigHash
+}
+
+func registerCredentialProviderPlugin(name string, p *pluginProvider) {
+	providersMutex.Lock()
 	if seenProviderNames.Has(name) {
+		providersMutex.Unlock()
 		klog.Fatalf("Credential provider %q was registered twice", name)
 	}
 	seenProviderNames.Insert(name)
 
 	providers = append(providers, provider{name, p})
+	providersMutex.Unlock()
+
 	klog.V(4).Infof("Registered credential provider %q", name)
+	publishEvent(Event{Type: ProviderLoadedEvent, Provider: name})
+}
+
+// InvalidateCache removes credentials cached by registered credential provider plugins,
+// forcing the next image pull to invoke the plugins again. This allows operators to force
+// a credential refresh after rotating registry secrets instead of waiting for the cached
+// credentials to expire. If providerName is empty, the cache of every registered provider
+// is invalidated, otherwise only the cache of the named provider. If registry is non-empty,
+// only credentials cached for that registry are removed. An error is returned if a
+// non-empty providerName does not match any registered provider.
+func InvalidateCache(providerName, registry string) error {
+	providersMutex.RLock()
+
+	if len(providerName) > 0 && !seenProviderNames.Has(providerName) {
+		providersMutex.RUnlock()
+		return fmt.Errorf("credential provider %q is not registered", providerName)
+	}
+
+	evictions := make(map[string]int)
+	for _, p := range providers {
+		if len(providerName) > 0 && p.name != providerName {
+			continue
+		}
+
+		removed := p.impl.invalidateCache(registry)
+		klog.V(2).Infof("Invalidated %d cached credential entries for provider %q", removed, p.name)
+		if removed > 0 {
+			evictions[p.name] = removed
+		}
+	}
+	providersMutex.RUnlock()
+
+	for name, removed := range evictions {
+		publishEvent(Event{Type: CacheEvictedEvent, Provider: name, EntriesEvicted: removed})
+	}
+
+	return nil
+}
+
+// InCredentialProviderBackOff reports whether a registered credential provider
+// matching the image is currently backing off after resolution failures. The
+// image puller uses it to attribute registry authorization failures to
+// credential resolution, emitting a distinct pod event and keeping the image
+// pull backoff from growing while the provider is the actual problem.
+func InCredentialProviderBackOff(image string) bool {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	now := credentialFailureBackOff.Clock.Now()
+	for _, p := range providers {
+		if !p.impl.isImageAllowed(image) {
+			continue
+		}
+
+		if credentialFailureBackOff.IsInBackOffSinceUpdate(credentialFailureBackOffKey(p.name, image), now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AnyProviderRequiredForNodeReady reports whether any registered provider is
+// marked requiredForNodeReady, in which case the kubelet publishes the
+// CredentialProviderNotReady node condition. It returns false if no
+// configuration has been loaded.
+func AnyProviderRequiredForNodeReady() bool {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	for _, p := range providers {
+		if p.impl.requiredForNodeReady {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NotReadyProviders returns the names of registered providers marked
+// requiredForNodeReady that have not passed a probe invocation: their most
+// recent probe failed, or their first probe has not completed yet. The kubelet
+// reports a non-empty result as the CredentialProviderNotReady node condition
+// being true, so schedulers avoid placing image-pulling workloads on nodes
+// that cannot authenticate to the registries those providers serve.
+func NotReadyProviders() []string {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	var notReady []string
+	for _, p := range providers {
+		if p.impl.notReadyForNode() {
+			notReady = append(notReady, p.name)
+		}
+	}
+
+	return notReady
+}
+
+// CachedCredentialInfo describes a credential cache entry a registered
+// credential provider would serve for an image. It carries cache metadata
+// only and never the credential values.
+type CachedCredentialInfo struct {
+	// Provider is the name of the provider holding the cached entry.
+	Provider string
+	// ExpiresAt is when the cached entry expires, after which a pull of a
+	// matching image blocks on a plugin invocation again.
+	ExpiresAt time.Time
+	// RefreshDue indicates the entry has passed the provider's
+	// refreshAheadPercent threshold of its lifetime, so the next pull serves
+	// the cached credentials but triggers a background refresh.
+	RefreshDue bool
+}
+
+// PeekCredentials reports whether a registered credential provider would serve
+// credentials for the image from its in-memory cache, without invoking any
+// plugin. Providers are consulted in registration order and the first provider
+// matching the image with a cached entry wins, mirroring the precedence of an
+// actual pull. Only node-wide cache scopes are consulted: entries cached under
+// pod-scoped keys by providers using service account tokens, a non-default
+// credentialSharingPolicy or a cacheKeyTemplate are never reported. It is
+// intended for debugging and for heuristics such as predicting whether an
+// image pull can proceed immediately or will block on credential resolution.
+func PeekCredentials(image string) (CachedCredentialInfo, bool) {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	for _, p := range providers {
+		if !p.impl.isImageAllowed(image) {
+			continue
+		}
+
+		entry, found := p.impl.peekCachedEntry(image)
+		if !found {
+			continue
+		}
+		return CachedCredentialInfo{
+			Provider:   p.name,
+			ExpiresAt:  entry.expiresAt,
+			RefreshDue: p.impl.refreshDue(entry),
+		}, true
+	}
+
+	return CachedCredentialInfo{}, false
+}
+
+// InvalidateCacheForServiceAccount removes credentials cached by every registered
+// credential provider plugin for the given service account identity. It is hooked
+// into the kubelet's service account token manager so that credentials obtained
+// with a pod's service account token are dropped as soon as that token is rotated
+// or the pod is deleted, instead of lingering until their cacheDuration elapses.
+func InvalidateCacheForServiceAccount(namespace, name string) {
+	providersMutex.RLock()
+
+	evictions := make(map[string]int)
+	for _, p := range providers {
+		if removed := p.impl.invalidateCacheForServiceAccount(namespace, name); removed > 0 {
+			klog.V(2).Infof("Invalidated %d cached credential entries of provider %q for service account %s/%s", removed, p.name, namespace, name)
+			evictions[p.name] = removed
+		}
+	}
+	providersMutex.RUnlock()
+
+	for providerName, removed := range evictions {
+		publishEvent(Event{Type: CacheEvictedEvent, Provider: providerName, EntriesEvicted: removed})
+	}
 }
 
 type externalCredentialProviderKeyring struct {
-	providers []credentialprovider.DockerConfigProvider
+	// ctx is the context of the image pull the keyring was built for. The
+	// DockerKeyring interface has no context parameter, so the request-scoped
+	// context is captured at construction and handed to the providers on
+	// Lookup, bounding plugin invocations by the pull's deadline.
+	ctx context.Context
+
+	providers []*perPodPluginProvider
 }
 
-func NewExternalCredentialProviderDockerKeyring(podNamespace, podName, podUID, serviceAccountName string) credentialprovider.DockerKeyring {
+// NewExternalCredentialProviderDockerKeyring returns a keyring backed by the
+// registered credential provider plugins for a single image pull. ctx is the
+// context of the pull; its deadline and cancellation propagate into plugin
+// invocations made through the keyring. image is the
+// image reference being pulled, as specified on the container; if it matches a
+// digest-pinned image from the pins section of the configuration, only the
+// pinned provider is consulted regardless of matchImages pattern overlap.
+// podAnnotations is the full set of annotations on the pod the image is being
+// pulled for; each provider filters it down to its configured allowlist.
+// podImages is the full list of images in the pod's spec, so providers
+// configured with batchRequests can resolve credentials for all of them in a
+// single invocation.
+func NewExternalCredentialProviderDockerKeyring(ctx context.Context, image, podNamespace, podName, podUID, serviceAccountName string, podAnnotations map[string]string, podImages []string) credentialprovider.DockerKeyring {
 	providersMutex.RLock()
 	defer providersMutex.RUnlock()
 
 	keyring := &externalCredentialProviderKeyring{
-		providers: make([]credentialprovider.DockerConfigProvider, 0, len(providers)),
+		ctx:       ctx,
+		providers: make([]*perPodPluginProvider, 0, len(providers)),
 	}
 
+	pinnedProvider, pinned := providerPins[image]
 	for _, p := range providers {
 		if !p.impl.Enabled() {
 			continue
 		}
 
+		if pinned && p.name != pinnedProvider {
+			klog.V(4).InfoS("Skipping credential provider for pinned image", "provider", p.name, "image", image, "pinnedProvider", pinnedProvider)
+			continue
+		}
+
 		pp := &perPodPluginProvider{
-			name:     p.name,
-			provider: p.impl,
+			name:           p.name,
+			provider:       p.impl,
+			podAnnotations: podAnnotations,
+			podImages:      podImages,
 		}
-		if utilfeature.DefaultFeatureGate.Enabled(features.KubeletServiceAccountTokenForCredentialProviders) {
+		if saTokenForCredentialProvidersEnabled() {
 			klog.V(4).InfoS("Generating per pod credential provider", "provider", p.name, "podName", podName, "podNamespace", podNamespace, "podUID", podUID, "serviceAccountName", serviceAccountName)
 
 			pp.podNamespace = podNamespace
@@ -91,8 +375,20 @@ func (k *externalCredentialProviderKeyring) Lookup(image string) ([]credentialpr
 	keyring := &credentialprovider.BasicDockerKeyring{}
 
 	for _, p := range k.providers {
-		// TODO: modify the credentialprovider.CredentialSource to contain the SA/pod information
-		keyring.Add(nil, p.Provide(image))
+		config, err := p.provideWithContext(k.ctx, image)
+		if err != nil {
+			if errors.Is(err, ErrNoMatch) {
+				continue
+			}
+			// A single failing provider must not hold back credentials from the
+			// remaining providers: record the failure and keep composing the
+			// keyring from the partial results.
+			klog.Errorf("Failed getting credentials for image %s from external registry credential provider %s: %v", image, p.name, err)
+			kubeletCredentialProviderKeyringLookupErrors.WithLabelValues(p.name).Inc()
+			publishEvent(Event{Type: ProviderLookupFailedEvent, Provider: p.name, Err: err})
+			continue
+		}
+		keyring.Add(p.credentialSource(), config)
 	}
 
 	return keyring.Lookup(image)
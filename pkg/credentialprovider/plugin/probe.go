@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"runtime/pprof"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// probeTimeout bounds the probe invocation. It is more generous than the
+// describe budget because a probe is a full credential request that may reach
+// out to a cloud IAM endpoint.
+const probeTimeout = 30 * time.Second
+
+// probePlugin smoke-tests the provider by invoking the plugin once with the
+// configured probe image, catching missing binaries and broken registry access
+// right after the configuration is loaded instead of when the first real
+// workload pulls through the provider. A failed probe marks the provider
+// degraded via the plugin degraded metric, a ProviderDegraded lifecycle event
+// and DumpState, but the provider stays in service. A no-op when no probeImage
+// is configured.
+func (p *pluginProvider) probePlugin() {
+	if p.probeImage == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+
+	// The plugin is invoked directly rather than through provideWithError so
+	// the probe response never enters the credential cache and no failure
+	// backoff is accrued against real pulls. Probes carry no pod identity, so
+	// no service account token or pod annotations are passed. Like real
+	// invocations, the goroutine is pprof-labeled with the provider and the
+	// registry being probed.
+	var err error
+	pprof.Do(ctx, pprof.Labels("plugin_name", p.name, "registry", parseRegistry(p.probeImage)), func(ctx context.Context) {
+		_, err = p.plugin.ExecPlugin(ctx, p.probeImage, "", nil, nil)
+	})
+	p.recordProbeResult(err)
+}
+
+// recordProbeResult publishes the outcome of a probe invocation.
+func (p *pluginProvider) recordProbeResult(err error) {
+	p.statusMu.Lock()
+	p.degraded = err != nil
+	p.probed = true
+	p.statusMu.Unlock()
+
+	if err != nil {
+		kubeletCredentialProviderPluginDegraded.WithLabelValues(p.name).Set(1)
+		klog.ErrorS(err, "Credential provider plugin failed its probe invocation and is marked degraded", "provider", p.name, "probeImage", p.probeImage)
+		publishEvent(Event{Type: ProviderDegradedEvent, Provider: p.name, Err: err})
+		return
+	}
+
+	kubeletCredentialProviderPluginDegraded.WithLabelValues(p.name).Set(0)
+	klog.V(4).InfoS("Credential provider plugin passed its probe invocation", "provider", p.name, "probeImage", p.probeImage)
+}
+
+// notReadyForNode reports whether this provider holds back node readiness: it
+// is marked requiredForNodeReady and has not yet passed a probe invocation,
+// either because its most recent probe failed or because the first probe has
+// not completed.
+func (p *pluginProvider) notReadyForNode() bool {
+	if !p.requiredForNodeReady {
+		return false
+	}
+
+	p.statusMu.Lock()
+	defer p.statusMu.Unlock()
+	return !p.probed || p.degraded
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/client-go/tools/cache"
+	credentialproviderapi "k8s.io/kubelet/pkg/apis/credentialprovider"
+	"k8s.io/utils/clock"
+)
+
+// failingFakeExecPlugin is a fakeExecPlugin whose invocations fail with a
+// fixed error.
+type failingFakeExecPlugin struct {
+	fakeExecPlugin
+	err error
+}
+
+func (f *failingFakeExecPlugin) ExecPlugin(ctx context.Context, image, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error) {
+	return nil, f.err
+}
+
+func Test_probePlugin(t *testing.T) {
+	tclock := clock.RealClock{}
+	newProvider := func(plugin Plugin, probeImage string) *pluginProvider {
+		return &pluginProvider{
+			name:           "test",
+			clock:          tclock,
+			matchImages:    []string{"test.registry.io"},
+			cache:          cache.NewExpirationStore(cacheKeyFunc, &cacheExpirationPolicy{clock: tclock}),
+			lastCachePurge: tclock.Now(),
+			plugin:         plugin,
+			probeImage:     probeImage,
+		}
+	}
+
+	t.Run("no probe image configured leaves the plugin uninvoked", func(t *testing.T) {
+		execPlugin := &invocationCountingFakeExecPlugin{}
+		p := newProvider(execPlugin, "")
+
+		p.probePlugin()
+
+		if execPlugin.count != 0 {
+			t.Errorf("expected no plugin invocations, got %d", execPlugin.count)
+		}
+	})
+
+	t.Run("failed probe marks the provider degraded", func(t *testing.T) {
+		var events []Event
+		t.Cleanup(SubscribeToEvents(func(event Event) {
+			events = append(events, event)
+		}))
+
+		probeErr := errors.New("exec: no such file or directory")
+		p := newProvider(&failingFakeExecPlugin{err: probeErr}, "test.registry.io/probe")
+
+		p.probePlugin()
+
+		if !p.dumpState().Degraded {
+			t.Error("expected the provider to be marked degraded after a failed probe")
+		}
+		if len(events) != 1 || events[0].Type != ProviderDegradedEvent || events[0].Provider != "test" || !errors.Is(events[0].Err, probeErr) {
+			t.Errorf("expected a ProviderDegraded event carrying the probe error, got %v", events)
+		}
+	})
+
+	t.Run("successful probe clears a previous degraded state", func(t *testing.T) {
+		execPlugin := &invocationCountingFakeExecPlugin{
+			fakeExecPlugin: fakeExecPlugin{
+				cacheKeyType: credentialproviderapi.RegistryPluginCacheKeyType,
+				auth: map[string]credentialproviderapi.AuthConfig{
+					"test.registry.io": {Username: "user", Password: "password"},
+				},
+			},
+		}
+		p := newProvider(execPlugin, "test.registry.io/probe")
+		p.degraded = true
+
+		p.probePlugin()
+
+		if execPlugin.count != 1 {
+			t.Errorf("expected 1 plugin invocation, got %d", execPlugin.count)
+		}
+		if p.dumpState().Degraded {
+			t.Error("expected the degraded state to clear after a successful probe")
+		}
+		if entries := len(p.cache.List()); entries != 0 {
+			t.Errorf("expected the probe response to stay out of the credential cache, found %d entries", entries)
+		}
+	})
+}
+
+func Test_notReadyForNode(t *testing.T) {
+	testcases := []struct {
+		name             string
+		required         bool
+		probed           bool
+		degraded         bool
+		expectedNotReady bool
+	}{
+		{
+			name:             "provider not marked required never holds back readiness",
+			required:         false,
+			probed:           false,
+			degraded:         false,
+			expectedNotReady: false,
+		},
+		{
+			name:             "required provider before its first probe completes",
+			required:         true,
+			probed:           false,
+			degraded:         false,
+			expectedNotReady: true,
+		},
+		{
+			name:             "required provider whose probe failed",
+			required:         true,
+			probed:           true,
+			degraded:         true,
+			expectedNotReady: true,
+		},
+		{
+			name:             "required provider that passed its probe",
+			required:         true,
+			probed:           true,
+			degraded:         false,
+			expectedNotReady: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &pluginProvider{
+				name:                 "test",
+				requiredForNodeReady: tc.required,
+				probed:               tc.probed,
+				degraded:             tc.degraded,
+			}
+
+			if notReady := p.notReadyForNode(); notReady != tc.expectedNotReady {
+				t.Errorf("expected notReadyForNode=%v, got %v", tc.expectedNotReady, notReady)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// registryLatencyWindowSize is the number of most recent credential
+// resolutions retained per registry for percentile computation. A small
+// sliding window keeps the summary responsive to latency changes and bounds
+// memory regardless of pull volume.
+const registryLatencyWindowSize = 128
+
+// registryLatencyWindow holds the credential resolution latency samples
+// observed for a single registry.
+type registryLatencyWindow struct {
+	// count is the total number of resolutions observed since kubelet start,
+	// including those whose samples have since rotated out of the window.
+	count uint64
+	// samples is a ring buffer of the most recent resolution latencies.
+	samples []time.Duration
+	next    int
+}
+
+var (
+	registryLatencyMutex sync.Mutex
+	registryLatencies    = make(map[string]*registryLatencyWindow)
+)
+
+// recordRegistryLatency records the wall time of one credential resolution
+// against the registry it served. Failed resolutions are recorded too: their
+// latency delays the pull just the same, which is what node-startup analysis
+// is after.
+func recordRegistryLatency(registry string, latency time.Duration) {
+	registryLatencyMutex.Lock()
+	defer registryLatencyMutex.Unlock()
+
+	window, ok := registryLatencies[registry]
+	if !ok {
+		window = &registryLatencyWindow{}
+		registryLatencies[registry] = window
+	}
+
+	window.count++
+	if len(window.samples) < registryLatencyWindowSize {
+		window.samples = append(window.samples, latency)
+		return
+	}
+	window.samples[window.next] = latency
+	window.next = (window.next + 1) % registryLatencyWindowSize
+}
+
+// RegistryLatencyStats summarizes the credential resolution latency the
+// registered credential provider plugins exhibited for a single registry.
+// Percentiles are computed over a sliding window of the most recent
+// resolutions; count covers every resolution since kubelet start.
+type RegistryLatencyStats struct {
+	Registry string
+	Count    uint64
+	P50      time.Duration
+	P90      time.Duration
+	P99      time.Duration
+}
+
+// RegistryLatencySummaries returns per-registry credential resolution latency
+// percentiles, sorted by registry, for the kubelet's stats summary endpoint.
+// It returns nil if no plugin has been invoked yet.
+func RegistryLatencySummaries() []RegistryLatencyStats {
+	registryLatencyMutex.Lock()
+	defer registryLatencyMutex.Unlock()
+
+	if len(registryLatencies) == 0 {
+		return nil
+	}
+
+	stats := make([]RegistryLatencyStats, 0, len(registryLatencies))
+	for registry, window := range registryLatencies {
+		sorted := make([]time.Duration, len(window.samples))
+		copy(sorted, window.samples)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+		stats = append(stats, RegistryLatencyStats{
+			Registry: registry,
+			Count:    window.count,
+			P50:      latencyPercentile(sorted, 50),
+			P90:      latencyPercentile(sorted, 90),
+			P99:      latencyPercentile(sorted, 99),
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Registry < stats[j].Registry })
+	return stats
+}
+
+// latencyPercentile returns the p-th percentile of the ascending sorted
+// samples using the nearest-rank method.
+func latencyPercentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := p * len(sorted) / 100
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
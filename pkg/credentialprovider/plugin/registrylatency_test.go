@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"testing"
+	"time"
+)
+
+// latencyStatsFor picks the summary of a single registry out of the global
+// stats, since other tests in the package record latencies of their own.
+func latencyStatsFor(t *testing.T, registry string) RegistryLatencyStats {
+	t.Helper()
+	for _, stats := range RegistryLatencySummaries() {
+		if stats.Registry == registry {
+			return stats
+		}
+	}
+	t.Fatalf("no latency stats recorded for registry %s", registry)
+	return RegistryLatencyStats{}
+}
+
+func Test_recordRegistryLatency(t *testing.T) {
+	t.Run("percentiles over recorded samples", func(t *testing.T) {
+		registry := "percentiles.registry.io"
+		for i := 1; i <= 100; i++ {
+			recordRegistryLatency(registry, time.Duration(i)*time.Millisecond)
+		}
+
+		stats := latencyStatsFor(t, registry)
+		if stats.Count != 100 {
+			t.Errorf("expected count 100, got %d", stats.Count)
+		}
+		if expected := 51 * time.Millisecond; stats.P50 != expected {
+			t.Errorf("expected p50 of %v, got %v", expected, stats.P50)
+		}
+		if expected := 91 * time.Millisecond; stats.P90 != expected {
+			t.Errorf("expected p90 of %v, got %v", expected, stats.P90)
+		}
+		if expected := 100 * time.Millisecond; stats.P99 != expected {
+			t.Errorf("expected p99 of %v, got %v", expected, stats.P99)
+		}
+	})
+
+	t.Run("window rotation keeps recent samples", func(t *testing.T) {
+		registry := "rotation.registry.io"
+		for i := 0; i < registryLatencyWindowSize; i++ {
+			recordRegistryLatency(registry, time.Hour)
+		}
+		// Overwrite the full window; the old samples must no longer contribute.
+		for i := 0; i < registryLatencyWindowSize; i++ {
+			recordRegistryLatency(registry, time.Millisecond)
+		}
+
+		stats := latencyStatsFor(t, registry)
+		if expected := uint64(2 * registryLatencyWindowSize); stats.Count != expected {
+			t.Errorf("expected count %d, got %d", expected, stats.Count)
+		}
+		if stats.P99 != time.Millisecond {
+			t.Errorf("expected rotated-out samples to be dropped, got p99 of %v", stats.P99)
+		}
+	})
+
+	t.Run("summaries are sorted by registry", func(t *testing.T) {
+		recordRegistryLatency("sorted-b.registry.io", time.Millisecond)
+		recordRegistryLatency("sorted-a.registry.io", time.Millisecond)
+
+		summaries := RegistryLatencySummaries()
+		for i := 1; i < len(summaries); i++ {
+			if summaries[i-1].Registry >= summaries[i].Registry {
+				t.Fatalf("summaries are not sorted by registry: %q before %q", summaries[i-1].Registry, summaries[i].Registry)
+			}
+		}
+	})
+}
+
+func Test_latencyPercentile(t *testing.T) {
+	if got := latencyPercentile(nil, 99); got != 0 {
+		t.Errorf("expected zero percentile without samples, got %v", got)
+	}
+	if got := latencyPercentile([]time.Duration{time.Second}, 99); got != time.Second {
+		t.Errorf("expected the only sample, got %v", got)
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+const (
+	// remoteConfigTimeout bounds a single fetch of a remote credential provider
+	// config document.
+	remoteConfigTimeout = 30 * time.Second
+
+	// remoteConfigMaxSize bounds the size of a fetched config document. Provider
+	// configurations are small; anything larger indicates a misconfigured URL.
+	remoteConfigMaxSize = 1 << 20 // 1 MiB
+
+	// remoteConfigRefreshInterval is how often a remote config is re-fetched in
+	// the background to keep the local cache copy fresh.
+	remoteConfigRefreshInterval = 1 * time.Hour
+
+	// remoteConfigCacheFile is the name of the local copy of the last verified
+	// remote config document, kept so the kubelet can start while the config
+	// server is unreachable.
+	remoteConfigCacheFile = ".remote-credential-provider-config"
+)
+
+// remoteConfigChecksum is the hex-encoded SHA256 checksum a remote credential
+// provider config document must match. Pinning is mandatory for remote configs:
+// it keeps the config server from becoming an unaudited source of node
+// credentials configuration.
+var remoteConfigChecksum string
+
+// SetRemoteConfigChecksum records the pinned SHA256 checksum for a credential
+// provider config loaded from an https:// URL. It must be called before
+// plugins are registered.
+func SetRemoteConfigChecksum(checksum string) {
+	remoteConfigChecksum = strings.ToLower(checksum)
+}
+
+// isRemoteConfigPath reports whether the credential provider config path names
+// a remote document rather than a file or directory on the node.
+func isRemoteConfigPath(configPath string) bool {
+	return strings.HasPrefix(configPath, "https://")
+}
+
+// remoteConfigCachePath returns where the last verified remote config document
+// is cached. The plugin bin directory is used because it is the one
+// credential-provider-specific directory guaranteed to exist on the node.
+func remoteConfigCachePath(pluginBinDir string) string {
+	return filepath.Join(pluginBinDir, remoteConfigCacheFile)
+}
+
+// verifyRemoteConfigChecksum checks a fetched document against the pinned
+// hex-encoded SHA256 checksum.
+func verifyRemoteConfigChecksum(data []byte, checksum string) error {
+	sum := fmt.Sprintf("%x", sha256.Sum256(data))
+	if sum != checksum {
+		return fmt.Errorf("remote credential provider config has checksum %s, which does not match the pinned checksum %s", sum, checksum)
+	}
+	return nil
+}
+
+// fetchRemoteConfig downloads the config document at url and verifies it
+// against the pinned checksum.
+func fetchRemoteConfig(url, checksum string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteConfigTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build request for %q: %w", url, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %q: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q fetching %q", resp.Status, url)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, remoteConfigMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response from %q: %w", url, err)
+	}
+	if len(data) > remoteConfigMaxSize {
+		return nil, fmt.Errorf("remote credential provider config at %q exceeds the maximum size of %d bytes", url, remoteConfigMaxSize)
+	}
+
+	if err := verifyRemoteConfigChecksum(data, checksum); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// loadRemoteConfig fetches, verifies and decodes a credential provider config
+// from an https:// URL. A verified document is cached locally; when the config
+// server is unreachable, the cached copy is used instead, after re-verifying
+// it against the pinned checksum. The returned source map attributes every
+// provider to the URL.
+func loadRemoteConfig(url, pluginBinDir string) (*kubeletconfig.CredentialProviderConfig, map[string]string, error) {
+	checksum := remoteConfigChecksum
+	if checksum == "" {
+		return nil, nil, fmt.Errorf("credential provider config URL %q requires a pinned SHA256 checksum, set --image-credential-provider-config-sha256", url)
+	}
+
+	cachePath := remoteConfigCachePath(pluginBinDir)
+	data, err := fetchRemoteConfig(url, checksum)
+	if err != nil {
+		cached, readErr := os.ReadFile(cachePath)
+		if readErr != nil {
+			return nil, nil, fmt.Errorf("unable to fetch remote credential provider config and no cached copy is available: %w", err)
+		}
+		if verifyErr := verifyRemoteConfigChecksum(cached, checksum); verifyErr != nil {
+			return nil, nil, fmt.Errorf("unable to fetch remote credential provider config and the cached copy failed verification: %w", verifyErr)
+		}
+		klog.ErrorS(err, "Falling back to the cached copy of the remote credential provider config", "url", url, "path", cachePath)
+		data = cached
+	} else if writeErr := writeRemoteConfigCache(cachePath, data); writeErr != nil {
+		klog.ErrorS(writeErr, "Unable to cache the remote credential provider config locally", "path", cachePath)
+	}
+
+	config, err := decode(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding remote credential provider config from %q: %w", url, err)
+	}
+	applyConfigDefaults(config)
+
+	providerSources := make(map[string]string, len(config.Providers))
+	for _, provider := range config.Providers {
+		providerSources[provider.Name] = url
+	}
+	return config, providerSources, nil
+}
+
+// refreshRemoteConfig periodically re-fetches a remote credential provider
+// config. Because the checksum pins the document content, a successful refresh
+// can only reconfirm the loaded configuration; its purpose is to keep the
+// local cache copy fresh and to surface fetch failures before the next kubelet
+// restart has to depend on the cache.
+func refreshRemoteConfig(url, cachePath string) {
+	for {
+		select {
+		case <-shutdownCh:
+			return
+		case <-time.After(remoteConfigRefreshInterval):
+		}
+
+		data, err := fetchRemoteConfig(url, remoteConfigChecksum)
+		if err != nil {
+			klog.ErrorS(err, "Periodic refresh of the remote credential provider config failed", "url", url)
+			continue
+		}
+		if err := writeRemoteConfigCache(cachePath, data); err != nil {
+			klog.ErrorS(err, "Unable to cache the remote credential provider config locally", "path", cachePath)
+		}
+	}
+}
+
+// writeRemoteConfigCache replaces the cached copy of the remote config by
+// writing to a temporary file in the same directory and renaming it into
+// place. A kubelet killed mid-write therefore never truncates or corrupts an
+// existing good copy.
+func writeRemoteConfigCache(cachePath string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(cachePath), remoteConfigCacheFile+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), cachePath)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func Test_loadRemoteConfig(t *testing.T) {
+	validConfig := `---
+kind: CredentialProviderConfig
+apiVersion: kubelet.config.k8s.io/v1
+providers:
+  - name: test
+    matchImages:
+    - "registry.io/foobar"
+    defaultCacheDuration: 10m
+    apiVersion: credentialprovider.kubelet.k8s.io/v1
+`
+	validChecksum := fmt.Sprintf("%x", sha256.Sum256([]byte(validConfig)))
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, validConfig)
+	}))
+	defer server.Close()
+	// The test server uses a self-signed certificate, so verification is skipped
+	// for the duration of the test.
+	savedTransport := http.DefaultClient.Transport
+	http.DefaultClient.Transport = server.Client().Transport
+	t.Cleanup(func() { http.DefaultClient.Transport = savedTransport })
+
+	setChecksum := func(t *testing.T, checksum string) {
+		SetRemoteConfigChecksum(checksum)
+		t.Cleanup(func() { SetRemoteConfigChecksum("") })
+	}
+
+	t.Run("fetches and verifies a pinned config", func(t *testing.T) {
+		setChecksum(t, validChecksum)
+		binDir := t.TempDir()
+
+		config, sources, err := loadRemoteConfig(server.URL, binDir)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(config.Providers) != 1 || config.Providers[0].Name != "test" {
+			t.Fatalf("unexpected providers: %v", config.Providers)
+		}
+		if sources["test"] != server.URL {
+			t.Errorf("expected provider source %q, got %q", server.URL, sources["test"])
+		}
+
+		cached, err := os.ReadFile(remoteConfigCachePath(binDir))
+		if err != nil {
+			t.Fatalf("expected a cached copy of the config: %v", err)
+		}
+		if string(cached) != validConfig {
+			t.Error("cached copy does not match the fetched config")
+		}
+	})
+
+	t.Run("checksum pin is required", func(t *testing.T) {
+		_, _, err := loadRemoteConfig(server.URL, t.TempDir())
+		if err == nil || !strings.Contains(err.Error(), "requires a pinned SHA256 checksum") {
+			t.Fatalf("expected a missing checksum error, got: %v", err)
+		}
+	})
+
+	t.Run("checksum mismatch is fatal", func(t *testing.T) {
+		setChecksum(t, fmt.Sprintf("%x", sha256.Sum256([]byte("something else"))))
+		_, _, err := loadRemoteConfig(server.URL, t.TempDir())
+		if err == nil || !strings.Contains(err.Error(), "does not match the pinned checksum") {
+			t.Fatalf("expected a checksum mismatch error, got: %v", err)
+		}
+	})
+
+	t.Run("falls back to the cached copy when the server is unreachable", func(t *testing.T) {
+		setChecksum(t, validChecksum)
+		binDir := t.TempDir()
+		if err := os.WriteFile(remoteConfigCachePath(binDir), []byte(validConfig), 0600); err != nil {
+			t.Fatalf("unexpected error seeding the cache: %v", err)
+		}
+
+		config, _, err := loadRemoteConfig("https://localhost:1", binDir)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(config.Providers) != 1 || config.Providers[0].Name != "test" {
+			t.Fatalf("unexpected providers: %v", config.Providers)
+		}
+	})
+
+	t.Run("unreachable server without a cached copy is fatal", func(t *testing.T) {
+		setChecksum(t, validChecksum)
+		_, _, err := loadRemoteConfig("https://localhost:1", t.TempDir())
+		if err == nil || !strings.Contains(err.Error(), "no cached copy is available") {
+			t.Fatalf("expected a fetch error, got: %v", err)
+		}
+	})
+
+	t.Run("tampered cached copy is rejected", func(t *testing.T) {
+		setChecksum(t, validChecksum)
+		binDir := t.TempDir()
+		if err := os.WriteFile(remoteConfigCachePath(binDir), []byte("tampered"), 0600); err != nil {
+			t.Fatalf("unexpected error seeding the cache: %v", err)
+		}
+
+		_, _, err := loadRemoteConfig("https://localhost:1", binDir)
+		if err == nil || !strings.Contains(err.Error(), "cached copy failed verification") {
+			t.Fatalf("expected a cache verification error, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"os"
+)
+
+// recordPluginResourceUsage publishes the CPU time and peak resident set size
+// of a reaped plugin process. Wall time is covered separately by the plugin
+// duration metric observed around each invocation.
+func recordPluginResourceUsage(pluginName string, state *os.ProcessState) {
+	kubeletCredentialProviderPluginCPUUsage.WithLabelValues(pluginName).Add((state.UserTime() + state.SystemTime()).Seconds())
+	if maxRSS, ok := pluginMaxRSS(state); ok {
+		kubeletCredentialProviderPluginMaxRSS.WithLabelValues(pluginName).Set(float64(maxRSS))
+	}
+}
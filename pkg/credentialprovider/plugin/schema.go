@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	configv1 "k8s.io/kubelet/config/v1"
+)
+
+// CredentialProviderConfigJSONSchema returns a JSON Schema (draft 2020-12)
+// document describing the kubelet.config.k8s.io/v1 CredentialProviderConfig
+// file format, for pipelines that validate configurations offline before
+// rolling them out to nodes. The schema is generated from the Go types at call
+// time, so it cannot drift from the fields the kubelet decodes, and it rejects
+// unknown fields like the kubelet's strict config decoding does. It describes
+// structure only: cross-field rules enforced when the kubelet loads the
+// configuration, such as matchImages pattern syntax or mutually exclusive
+// fields, are not expressible in it, so a config that validates against the
+// schema may still be rejected by the kubelet.
+func CredentialProviderConfigJSONSchema() ([]byte, error) {
+	generator := &schemaGenerator{definitions: map[string]any{}}
+	rootType := reflect.TypeOf(configv1.CredentialProviderConfig{})
+	if _, err := generator.schemaFor(rootType); err != nil {
+		return nil, fmt.Errorf("error generating credential provider config schema: %w", err)
+	}
+	document := map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   "kubelet.config.k8s.io/v1 CredentialProviderConfig",
+		"$ref":    "#/$defs/" + rootType.Name(),
+		"$defs":   generator.definitions,
+	}
+	return json.MarshalIndent(document, "", "  ")
+}
+
+// schemaGenerator accumulates one schema definition per named struct type
+// encountered while walking the config types.
+type schemaGenerator struct {
+	definitions map[string]any
+}
+
+// durationType is special-cased because metav1.Duration marshals to a Go
+// duration string such as "30s", not to the JSON shape of its struct fields.
+var durationType = reflect.TypeOf(metav1.Duration{})
+
+// schemaFor returns the JSON schema for a Go type. Named struct types are
+// added to the generator's definitions and referenced by name, so a type used
+// from several fields is defined once.
+func (g *schemaGenerator) schemaFor(t reflect.Type) (map[string]any, error) {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schemaFor(t.Elem())
+	case reflect.String:
+		return map[string]any{"type": "string"}, nil
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}, nil
+	case reflect.Int32:
+		return map[string]any{"type": "integer", "format": "int32"}, nil
+	case reflect.Int64:
+		return map[string]any{"type": "integer", "format": "int64"}, nil
+	case reflect.Slice:
+		items, err := g.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "array", "items": items}, nil
+	case reflect.Map:
+		values, err := g.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "object", "additionalProperties": values}, nil
+	case reflect.Struct:
+		if t == durationType {
+			return map[string]any{"type": "string"}, nil
+		}
+		name := t.Name()
+		if _, ok := g.definitions[name]; !ok {
+			// Reserve the name before descending so a self-referential type
+			// does not recurse forever.
+			g.definitions[name] = nil
+			definition, err := g.objectSchema(t)
+			if err != nil {
+				return nil, err
+			}
+			g.definitions[name] = definition
+		}
+		return map[string]any{"$ref": "#/$defs/" + name}, nil
+	default:
+		return nil, fmt.Errorf("unhandled kind %s for type %s", t.Kind(), t)
+	}
+}
+
+// objectSchema returns the schema of a struct type as a JSON object: one
+// property per serialized field, fields without omitempty required, and
+// unknown fields rejected like the kubelet's strict config decoding does.
+func (g *schemaGenerator) objectSchema(t reflect.Type) (map[string]any, error) {
+	properties, required, err := g.structFields(t)
+	if err != nil {
+		return nil, err
+	}
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema, nil
+}
+
+// structFields returns the properties and required property names contributed
+// by the serialized fields of a struct type, with inlined embedded structs
+// such as TypeMeta contributing their fields to the embedding object.
+func (g *schemaGenerator) structFields(t reflect.Type) (map[string]any, []string, error) {
+	properties := map[string]any{}
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		name, options, _ := strings.Cut(structField.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if structField.Anonymous && len(name) == 0 {
+			embeddedProperties, embeddedRequired, err := g.structFields(structField.Type)
+			if err != nil {
+				return nil, nil, err
+			}
+			for propertyName, propertySchema := range embeddedProperties {
+				properties[propertyName] = propertySchema
+			}
+			required = append(required, embeddedRequired...)
+			continue
+		}
+		if len(name) == 0 {
+			name = structField.Name
+		}
+		propertySchema, err := g.schemaFor(structField.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+		properties[name] = propertySchema
+		if !strings.Contains(","+options+",", ",omitempty,") {
+			required = append(required, name)
+		}
+	}
+	return properties, required, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func Test_CredentialProviderConfigJSONSchema(t *testing.T) {
+	schemaBytes, err := CredentialProviderConfigJSONSchema()
+	if err != nil {
+		t.Fatalf("unexpected error generating schema: %v", err)
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal(schemaBytes, &document); err != nil {
+		t.Fatalf("unexpected error parsing schema document: %v", err)
+	}
+
+	if ref, _ := document["$ref"].(string); ref != "#/$defs/CredentialProviderConfig" {
+		t.Errorf("expected root $ref %q, got %q", "#/$defs/CredentialProviderConfig", ref)
+	}
+
+	definitions, ok := document["$defs"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected $defs object, got %T", document["$defs"])
+	}
+
+	definition := func(name string) map[string]any {
+		t.Helper()
+		def, ok := definitions[name].(map[string]any)
+		if !ok {
+			t.Fatalf("expected definition for %s, got %T", name, definitions[name])
+		}
+		return def
+	}
+	properties := func(def map[string]any) map[string]any {
+		t.Helper()
+		props, ok := def["properties"].(map[string]any)
+		if !ok {
+			t.Fatalf("expected properties object, got %T", def["properties"])
+		}
+		return props
+	}
+	requiredNames := func(def map[string]any) []string {
+		t.Helper()
+		var names []string
+		entries, _ := def["required"].([]any)
+		for _, entry := range entries {
+			names = append(names, entry.(string))
+		}
+		return names
+	}
+
+	configDefinition := definition("CredentialProviderConfig")
+	configProperties := properties(configDefinition)
+	for _, property := range []string{"kind", "apiVersion", "providers", "pins", "defaults", "disableLegacyKeyring"} {
+		if _, ok := configProperties[property]; !ok {
+			t.Errorf("expected CredentialProviderConfig schema to have property %s", property)
+		}
+	}
+	if got := requiredNames(configDefinition); !reflect.DeepEqual(got, []string{"providers"}) {
+		t.Errorf("expected CredentialProviderConfig required fields [providers], got %v", got)
+	}
+	if additional, ok := configDefinition["additionalProperties"].(bool); !ok || additional {
+		t.Errorf("expected CredentialProviderConfig schema to reject unknown fields, got additionalProperties %v", configDefinition["additionalProperties"])
+	}
+
+	providerDefinition := definition("CredentialProvider")
+	providerProperties := properties(providerDefinition)
+	for _, property := range []string{"name", "matchImages", "tokenAttributes", "command", "binaryByPlatform", "systemdScope", "cacheKeyTemplate"} {
+		if _, ok := providerProperties[property]; !ok {
+			t.Errorf("expected CredentialProvider schema to have property %s", property)
+		}
+	}
+	if got := requiredNames(providerDefinition); !reflect.DeepEqual(got, []string{"name", "matchImages", "defaultCacheDuration", "apiVersion"}) {
+		t.Errorf("expected CredentialProvider required fields [name matchImages defaultCacheDuration apiVersion], got %v", got)
+	}
+
+	// metav1.Duration fields marshal as duration strings, not objects.
+	if got := providerProperties["defaultCacheDuration"]; !reflect.DeepEqual(got, map[string]any{"type": "string"}) {
+		t.Errorf("expected defaultCacheDuration schema to be a string, got %v", got)
+	}
+
+	// Named struct types are referenced so each is defined once.
+	if got := providerProperties["tokenAttributes"]; !reflect.DeepEqual(got, map[string]any{"$ref": "#/$defs/ServiceAccountTokenAttributes"}) {
+		t.Errorf("expected tokenAttributes schema to reference ServiceAccountTokenAttributes, got %v", got)
+	}
+
+	if got := providerProperties["binaryByPlatform"]; !reflect.DeepEqual(got, map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}}) {
+		t.Errorf("expected binaryByPlatform schema to be a string map, got %v", got)
+	}
+
+	tokenAttributesDefinition := definition("ServiceAccountTokenAttributes")
+	if got := requiredNames(tokenAttributesDefinition); !reflect.DeepEqual(got, []string{"serviceAccountTokenAudience", "requireServiceAccount"}) {
+		t.Errorf("expected ServiceAccountTokenAttributes required fields [serviceAccountTokenAudience requireServiceAccount], got %v", got)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// drainTimeout bounds how long Shutdown waits for in-flight plugin
+	// invocations to complete before terminating their processes.
+	drainTimeout = 10 * time.Second
+	// terminateGracePeriod is how long a plugin process has to exit after
+	// receiving SIGTERM before it is killed.
+	terminateGracePeriod = 5 * time.Second
+)
+
+var (
+	// shutdownMu guards the drain bookkeeping below.
+	shutdownMu sync.Mutex
+	// draining is set once Shutdown begins; new plugin invocations are
+	// rejected from that point on.
+	draining bool
+	// inFlightInvocations counts plugin invocations currently executing, so
+	// Shutdown can wait for them to complete.
+	inFlightInvocations sync.WaitGroup
+	// inFlightInvocationCount mirrors inFlightInvocations as a readable
+	// counter, feeding the credential resolution saturation signal.
+	inFlightInvocationCount atomic.Int64
+	// invocationCancels holds the context cancel funcs of in-flight
+	// invocations, so Shutdown can terminate plugin processes that outlive
+	// the drain phase.
+	invocationCancels = make(map[int64]context.CancelFunc)
+	nextInvocationID  int64
+
+	// shutdownCh is closed when Shutdown begins; background maintenance
+	// goroutines such as the remote config refresher watch it and stop, so
+	// nothing writes to disk while the kubelet is exiting.
+	shutdownCh = make(chan struct{})
+
+	shutdownOnce sync.Once
+)
+
+// beginPluginInvocation records the start of a plugin invocation for shutdown
+// draining. It fails once Shutdown has begun so no new plugin processes are
+// started while the kubelet is exiting.
+func beginPluginInvocation(providerName string) error {
+	shutdownMu.Lock()
+	defer shutdownMu.Unlock()
+	if draining {
+		return fmt.Errorf("credential provider plugin %s was not invoked because the kubelet is shutting down", providerName)
+	}
+	inFlightInvocations.Add(1)
+	inFlightInvocationCount.Add(1)
+	return nil
+}
+
+// endPluginInvocation records the completion of a plugin invocation started
+// with beginPluginInvocation.
+func endPluginInvocation() {
+	inFlightInvocationCount.Add(-1)
+	inFlightInvocations.Done()
+}
+
+// registerInvocationCancel makes an in-flight invocation's context cancel func
+// reachable from Shutdown, so invocations that outlive the drain phase can
+// have their plugin processes terminated. The returned func removes the
+// registration and must be called when the invocation completes.
+func registerInvocationCancel(cancel context.CancelFunc) func() {
+	shutdownMu.Lock()
+	defer shutdownMu.Unlock()
+	nextInvocationID++
+	id := nextInvocationID
+	invocationCancels[id] = cancel
+	return func() {
+		shutdownMu.Lock()
+		defer shutdownMu.Unlock()
+		delete(invocationCancels, id)
+	}
+}
+
+// Shutdown drains the credential provider subsystem during kubelet shutdown.
+// It stops admitting new plugin invocations, waits up to drainTimeout for
+// in-flight invocations to complete, terminates plugin processes that are
+// still running after that, and stops the background maintenance goroutines
+// so on-disk state is not written mid-teardown. Calls after the first are
+// no-ops.
+func Shutdown() {
+	shutdownOnce.Do(shutdown)
+}
+
+func shutdown() {
+	shutdownMu.Lock()
+	draining = true
+	shutdownMu.Unlock()
+	close(shutdownCh)
+
+	if !drainInvocations(drainTimeout) {
+		klog.InfoS("Credential provider plugin invocations still in flight after the drain timeout, terminating their processes", "drainTimeout", drainTimeout)
+		cancelInvocations()
+		// Cancellation asks each plugin process to exit with SIGTERM and
+		// kills it after the grace period, so a second bounded wait covers
+		// both outcomes.
+		if !drainInvocations(2 * terminateGracePeriod) {
+			klog.InfoS("Credential provider plugin invocations did not complete after termination, abandoning them")
+		}
+	}
+
+	// Reused plugin processes outlive individual invocations and are stopped
+	// explicitly so they are not orphaned when the kubelet exits.
+	providersMutex.RLock()
+	snapshot := make([]provider, len(providers))
+	copy(snapshot, providers)
+	providersMutex.RUnlock()
+	for _, p := range snapshot {
+		if dp, ok := p.impl.plugin.(interface{ shutdown() }); ok {
+			dp.shutdown()
+		}
+		publishEvent(Event{Type: ProviderUnloadedEvent, Provider: p.name})
+	}
+
+	klog.V(2).InfoS("Credential provider subsystem drained")
+}
+
+// drainInvocations waits up to timeout for all in-flight plugin invocations to
+// complete and reports whether they did.
+func drainInvocations(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		inFlightInvocations.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// cancelInvocations cancels the contexts of all in-flight plugin invocations,
+// terminating their plugin processes.
+func cancelInvocations() {
+	shutdownMu.Lock()
+	cancels := make([]context.CancelFunc, 0, len(invocationCancels))
+	for _, cancel := range invocationCancels {
+		cancels = append(cancels, cancel)
+	}
+	shutdownMu.Unlock()
+
+	for _, cancel := range cancels {
+		cancel()
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_beginPluginInvocation(t *testing.T) {
+	t.Run("invocations are admitted before shutdown", func(t *testing.T) {
+		if err := beginPluginInvocation("test"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		endPluginInvocation()
+	})
+
+	t.Run("invocations are rejected once draining", func(t *testing.T) {
+		shutdownMu.Lock()
+		draining = true
+		shutdownMu.Unlock()
+		t.Cleanup(func() {
+			shutdownMu.Lock()
+			draining = false
+			shutdownMu.Unlock()
+		})
+
+		err := beginPluginInvocation("test")
+		if err == nil {
+			t.Fatal("expected an error, got none")
+		}
+		if !strings.Contains(err.Error(), "shutting down") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func Test_drainInvocations(t *testing.T) {
+	if err := beginPluginInvocation("test"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if drainInvocations(10 * time.Millisecond) {
+		t.Error("expected drain to time out while an invocation is in flight")
+	}
+
+	endPluginInvocation()
+	if !drainInvocations(time.Minute) {
+		t.Error("expected drain to complete once the invocation finished")
+	}
+}
+
+func Test_cancelInvocations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	unregister := registerInvocationCancel(cancel)
+
+	cancelInvocations()
+	select {
+	case <-ctx.Done():
+	default:
+		t.Error("expected the registered invocation context to be cancelled")
+	}
+
+	unregister()
+	shutdownMu.Lock()
+	remaining := len(invocationCancels)
+	shutdownMu.Unlock()
+	if remaining != 0 {
+		t.Errorf("expected no registered cancel funcs after unregistering, got %d", remaining)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/credentialprovider"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+// SimulationResult reports which providers of a candidate credential provider
+// configuration would be consulted for a single image.
+type SimulationResult struct {
+	// Image is the image reference the result is for.
+	Image string
+	// Providers are the names of the providers whose matchImages patterns
+	// match the image, in the order they would be consulted during a pull. An
+	// empty list means no provider would serve the image and the pull would
+	// fall back to other credential sources.
+	Providers []string
+	// PinnedProvider is the name of the provider a pin in the configuration
+	// forces for the image, or empty when the image is not pinned. When set,
+	// Providers contains at most the pinned provider.
+	PinnedProvider string
+}
+
+// SimulateConfig replays a list of image references, typically the node's
+// recent pull history from the image manager, against a candidate credential
+// provider configuration and reports which providers would serve each image.
+// It lets operators validate a configuration change against real workload
+// traffic before rolling it out, surfacing images that would silently lose
+// their provider. The candidate configuration is validated first; no plugins
+// are invoked, the simulation only evaluates matchImages patterns and pins.
+func SimulateConfig(config *kubeletconfig.CredentialProviderConfig, images []string) ([]SimulationResult, error) {
+	if config == nil {
+		return nil, fmt.Errorf("credential provider config is required")
+	}
+	// tokenAttributes are accepted regardless of the local feature gate state,
+	// since the simulation only evaluates image matching.
+	if errs := validateCredentialProviderConfig(config, true).ToAggregate(); errs != nil {
+		return nil, fmt.Errorf("invalid credential provider config: %w", errs)
+	}
+
+	pins := make(map[string]string, len(config.Pins))
+	for _, pin := range config.Pins {
+		pins[pin.Image] = pin.Provider
+	}
+
+	results := make([]SimulationResult, 0, len(images))
+	for _, image := range images {
+		result := SimulationResult{Image: image}
+		pinnedProvider, pinned := pins[image]
+		if pinned {
+			result.PinnedProvider = pinnedProvider
+		}
+
+		for _, provider := range config.Providers {
+			if pinned && provider.Name != pinnedProvider {
+				continue
+			}
+			for _, matchImage := range provider.MatchImages {
+				if matched, _ := credentialprovider.URLsMatchStr(matchImage, image); matched {
+					result.Providers = append(result.Providers, provider.Name)
+					break
+				}
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+func Test_SimulateConfig(t *testing.T) {
+	pinnedImage := "registry.io/infra/agent@sha256:7031c1b283388d2c2e09b57badb803c05ebed362dc88d84b480cc47f72a21097"
+	config := &kubeletconfig.CredentialProviderConfig{
+		Providers: []kubeletconfig.CredentialProvider{
+			{
+				Name:                 "ecr-provider",
+				MatchImages:          []string{"*.dkr.ecr.*.amazonaws.com"},
+				DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+				APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			},
+			{
+				Name:                 "internal-provider",
+				MatchImages:          []string{"registry.io", "*.dkr.ecr.*.amazonaws.com"},
+				DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+				APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			},
+		},
+		Pins: []kubeletconfig.CredentialProviderPin{
+			{Image: pinnedImage, Provider: "internal-provider"},
+		},
+	}
+
+	results, err := SimulateConfig(config, []string{
+		"123456789.dkr.ecr.us-east-1.amazonaws.com/app:v1",
+		"registry.io/infra/agent:latest",
+		pinnedImage,
+		"docker.io/library/busybox:1.36",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []SimulationResult{
+		{
+			Image:     "123456789.dkr.ecr.us-east-1.amazonaws.com/app:v1",
+			Providers: []string{"ecr-provider", "internal-provider"},
+		},
+		{
+			Image:     "registry.io/infra/agent:latest",
+			Providers: []string{"internal-provider"},
+		},
+		{
+			Image:          pinnedImage,
+			Providers:      []string{"internal-provider"},
+			PinnedProvider: "internal-provider",
+		},
+		{
+			Image: "docker.io/library/busybox:1.36",
+		},
+	}
+	if !reflect.DeepEqual(expected, results) {
+		t.Errorf("expected results %+v, got %+v", expected, results)
+	}
+}
+
+func Test_SimulateConfig_invalidConfig(t *testing.T) {
+	config := &kubeletconfig.CredentialProviderConfig{
+		Providers: []kubeletconfig.CredentialProvider{
+			{
+				Name:                 "broken-provider",
+				DefaultCacheDuration: &metav1.Duration{Duration: time.Minute},
+				APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			},
+		},
+	}
+
+	if _, err := SimulateConfig(config, []string{"registry.io/app:v1"}); err == nil || !strings.Contains(err.Error(), "invalid credential provider config") {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	if _, err := SimulateConfig(nil, []string{"registry.io/app:v1"}); err == nil || !strings.Contains(err.Error(), "credential provider config is required") {
+		t.Fatalf("expected missing config error, got %v", err)
+	}
+}
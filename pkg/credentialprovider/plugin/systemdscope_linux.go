@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	godbus "github.com/godbus/dbus/v5"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// startSystemdScope moves the freshly started plugin process into a new
+// systemd transient scope unit carrying the configured resource limits. The
+// scope gives the plugin proper cgroup accounting visible in systemd tooling
+// and lets systemd clean up any processes the plugin leaks; without it the
+// plugin runs as an unaccounted child of the kubelet. A no-op when the
+// provider is not configured with a systemd scope.
+func (e *execPlugin) startSystemdScope(ctx context.Context, cmd *exec.Cmd) error {
+	if e.systemdScope == nil {
+		return nil
+	}
+
+	conn, err := systemddbus.NewWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to systemd: %w", err)
+	}
+	defer conn.Close()
+
+	properties := []systemddbus.Property{
+		systemddbus.PropDescription(fmt.Sprintf("kubelet credential provider plugin %s", e.name)),
+		systemddbus.PropPids(uint32(cmd.Process.Pid)),
+	}
+	if e.systemdScope.CPUQuotaPercent != nil {
+		// systemd expresses the CPU quota as microseconds of CPU time granted
+		// per second of wall-clock time.
+		properties = append(properties, systemddbus.Property{
+			Name:  "CPUQuotaPerSecUSec",
+			Value: godbus.MakeVariant(uint64(*e.systemdScope.CPUQuotaPercent) * 10000),
+		})
+	}
+	if len(e.systemdScope.MemoryMax) > 0 {
+		// The quantity was validated when the config was loaded.
+		quantity, err := resource.ParseQuantity(e.systemdScope.MemoryMax)
+		if err != nil {
+			return fmt.Errorf("failed to parse memoryMax: %w", err)
+		}
+		properties = append(properties, systemddbus.Property{
+			Name:  "MemoryMax",
+			Value: godbus.MakeVariant(uint64(quantity.Value())),
+		})
+	}
+	if e.systemdScope.TasksMax != nil {
+		properties = append(properties, systemddbus.Property{
+			Name:  "TasksMax",
+			Value: godbus.MakeVariant(uint64(*e.systemdScope.TasksMax)),
+		})
+	}
+
+	// The pid makes the unit name unique across concurrent invocations of the
+	// same plugin; the scope is cleaned up by systemd once the process exits.
+	scopeName := fmt.Sprintf("kubelet-credential-provider-%s-%d.scope", e.name, cmd.Process.Pid)
+	statusChan := make(chan string, 1)
+	if _, err := conn.StartTransientUnitContext(ctx, scopeName, "fail", properties, statusChan); err != nil {
+		return fmt.Errorf("failed to start transient scope %s: %w", scopeName, err)
+	}
+	select {
+	case status := <-statusChan:
+		if status != "done" {
+			return fmt.Errorf("transient scope %s entered unexpected state %q", scopeName, status)
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+//go:build !linux
+// +build !linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+// startSystemdScope fails invocations of providers configured with a systemd
+// scope, which requires a systemd host. Failing loudly is preferred over
+// silently running the plugin without the limits the operator configured.
+func (e *execPlugin) startSystemdScope(ctx context.Context, cmd *exec.Cmd) error {
+	if e.systemdScope == nil {
+		return nil
+	}
+	return fmt.Errorf("credential provider plugin %s is configured with systemdScope, which is only supported on Linux hosts running systemd", e.name)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/klog/v2"
+	credentialproviderapi "k8s.io/kubelet/pkg/apis/credentialprovider"
+)
+
+// WASMInvocation describes a single run of a WASI credential provider module.
+type WASMInvocation struct {
+	// Name is the provider name, for engine-side logs and diagnostics.
+	Name string
+	// Module is the compiled WASI module.
+	Module []byte
+	// Stdin carries the encoded CredentialProviderRequest.
+	Stdin io.Reader
+	// Stdout receives the CredentialProviderResponse written by the module.
+	Stdout io.Writer
+	// Stderr receives module diagnostics, surfaced in error messages when the
+	// invocation fails.
+	Stderr io.Writer
+	// Env is the environment exposed to the module through WASI, as
+	// NAME=VALUE pairs.
+	Env []string
+}
+
+// WASMRuntime is the embedded WASI runtime providers configured with
+// runtime: wasm are executed in.
+type WASMRuntime interface {
+	// Run instantiates the module and drives its WASI entrypoint to
+	// completion, honoring cancellation of ctx. A non-zero module exit is
+	// returned as an error.
+	Run(ctx context.Context, invocation WASMInvocation) error
+}
+
+var (
+	wasmRuntimeMutex sync.RWMutex
+	wasmRuntime      WASMRuntime
+)
+
+// SetWASMRuntime wires in the embedded WASI runtime used for providers
+// configured with runtime: wasm. The engine is injected here rather than
+// imported directly, keeping this package free of a hard dependency on a
+// particular engine. It must be called before RegisterCredentialProviderPlugins;
+// registering a wasm provider fails when no runtime is wired in.
+func SetWASMRuntime(runtime WASMRuntime) {
+	wasmRuntimeMutex.Lock()
+	defer wasmRuntimeMutex.Unlock()
+	wasmRuntime = runtime
+}
+
+// registeredWASMRuntime returns the wired-in WASI runtime, or nil when this
+// kubelet build does not include one.
+func registeredWASMRuntime() WASMRuntime {
+	wasmRuntimeMutex.RLock()
+	defer wasmRuntimeMutex.RUnlock()
+	return wasmRuntime
+}
+
+// wasmPlugin is the Plugin implementation that runs the provider as a WASI
+// module inside the kubelet process instead of forking a native binary. The
+// module speaks the same stdin/stdout protocol as a native exec plugin, so a
+// provider can be recompiled between the two runtimes without changes. One
+// module serves every node architecture, which makes wasm plugins a good fit
+// for multi-arch and air-gapped fleets.
+type wasmPlugin struct {
+	// base carries the provider's request encoding, response decoding and
+	// environment configuration, shared with the native exec implementation.
+	base *execPlugin
+	// runtime is the embedded WASI runtime the module is executed in.
+	runtime WASMRuntime
+
+	// moduleMutex guards module, which caches the module bytes after the
+	// first invocation reads them from the plugin bin directory.
+	moduleMutex sync.Mutex
+	module      []byte
+}
+
+// loadModule reads and caches the provider's module. The module is resolved
+// like a native plugin binary: a file in the plugin bin directory named after
+// the provider, with a .wasm extension.
+func (w *wasmPlugin) loadModule() ([]byte, error) {
+	w.moduleMutex.Lock()
+	defer w.moduleMutex.Unlock()
+
+	if w.module != nil {
+		return w.module, nil
+	}
+
+	module, err := os.ReadFile(filepath.Join(w.base.pluginBinDir, w.base.name+".wasm"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading wasm module for credential provider plugin %s: %w", w.base.name, err)
+	}
+	w.module = module
+	return module, nil
+}
+
+// ExecPlugin runs the provider's WASI module in the embedded runtime. The
+// module receives the CredentialProviderRequest on stdin and returns the
+// CredentialProviderResponse on stdout, exactly like a native exec plugin.
+func (w *wasmPlugin) ExecPlugin(ctx context.Context, image, serviceAccountToken string, serviceAccountAnnotations, podAnnotations map[string]string) (*credentialproviderapi.CredentialProviderResponse, error) {
+	klog.V(5).Infof("Getting image %s credentials from wasm credential provider plugin %s", image, w.base.name)
+
+	if err := beginPluginInvocation(w.base.name); err != nil {
+		return nil, err
+	}
+	defer endPluginInvocation()
+
+	nodeTopologyLabels, nodeAnnotations := nodeRequestAttributes(w.base.nodeAttributes)
+	authRequest := &credentialproviderapi.CredentialProviderRequest{
+		Image:                     image,
+		ServiceAccountToken:       serviceAccountToken,
+		ServiceAccountAnnotations: serviceAccountAnnotations,
+		PodAnnotations:            podAnnotations,
+		NodeTopologyLabels:        nodeTopologyLabels,
+		NodeAnnotations:           nodeAnnotations,
+		RequestHash:               computeRequestHash(image, serviceAccountAnnotations),
+	}
+	data, err := w.base.encodeRequest(authRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode auth request: %w", err)
+	}
+	// The encoded request carries the pod's service account token; zero it once
+	// the plugin invocation is over so it is not retained in an intermediate
+	// buffer until garbage collection.
+	defer zeroBytes(data)
+
+	kubeletCredentialProviderRequestSize.WithLabelValues(w.base.name).Observe(float64(len(data)))
+	if len(data) > maxRequestBodySize {
+		return nil, fmt.Errorf("encoded auth request for credential provider plugin %s is %d bytes, exceeding the maximum request body size of %d bytes", w.base.name, len(data), maxRequestBodySize)
+	}
+
+	module, err := w.loadModule()
+	if err != nil {
+		return nil, err
+	}
+
+	env := mergeEnvVars(w.base.environ(), w.base.configEnv())
+	// Like native invocations, every run carries a fresh identity attestation
+	// when the kubelet has an attestation source.
+	attestation, err := attestationEnv(ctx)
+	if err != nil {
+		return nil, err
+	}
+	env = append(env, attestation...)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	// Modules have no heartbeat channel, so the invocation is bounded by the
+	// catch-all plugin timeout as a fixed deadline.
+	ctx, cancel := context.WithTimeout(ctx, pluginTimeout)
+	defer cancel()
+	defer registerInvocationCancel(cancel)()
+
+	if err := w.run(ctx, WASMInvocation{
+		Name:   w.base.name,
+		Module: module,
+		Stdin:  bytes.NewBuffer(data),
+		Stdout: stdout,
+		Stderr: stderr,
+		Env:    env,
+	}, image); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, stderr.String())
+	}
+
+	out := stdout.Bytes()
+	// The raw response carries credentials; zero it once decoded.
+	defer zeroBytes(out)
+	// check that the response apiVersion matches what is expected
+	gvk, err := json.DefaultMetaFactory.Interpret(out)
+	if err != nil {
+		return nil, fmt.Errorf("%w: error reading GVK from response: %v", ErrMalformedResponse, err)
+	}
+
+	if gvk.GroupVersion().String() != w.base.apiVersion {
+		return nil, fmt.Errorf("%w: apiVersion from credential plugin response did not match expected apiVersion:%s, actual apiVersion:%s", ErrMalformedResponse, w.base.apiVersion, gvk.GroupVersion().String())
+	}
+
+	response, err := w.base.decodeResponse(out)
+	if err != nil {
+		// err is explicitly not wrapped since it may contain credentials in the response.
+		return nil, fmt.Errorf("%w: error decoding credential provider plugin response from stdout", ErrMalformedResponse)
+	}
+
+	return response, nil
+}
+
+// run drives the module in the embedded runtime, recording the same duration
+// and error metrics and publishing the same lifecycle events as a native
+// plugin invocation.
+func (w *wasmPlugin) run(ctx context.Context, invocation WASMInvocation, image string) error {
+	startTime := time.Now()
+	defer func() {
+		kubeletCredentialProviderPluginDuration.WithContext(ctx).WithLabelValues(w.base.name).Observe(time.Since(startTime).Seconds())
+	}()
+
+	err := w.runtime.Run(ctx, invocation)
+	if ctx.Err() != nil {
+		kubeletCredentialProviderPluginErrors.WithLabelValues(w.base.name).Inc()
+		err := fmt.Errorf("%w: error running wasm credential provider plugin %s for image %s: %v", ErrPluginTimeout, w.base.name, image, ctx.Err())
+		publishEvent(Event{Type: InvocationFailedEvent, Provider: w.base.name, Err: err})
+		return err
+	}
+	if err != nil {
+		kubeletCredentialProviderPluginErrors.WithLabelValues(w.base.name).Inc()
+		err := fmt.Errorf("%w: error running wasm credential provider plugin %s for image %s: %v", ErrPluginCrashed, w.base.name, image, err)
+		publishEvent(Event{Type: InvocationFailedEvent, Provider: w.base.name, Err: err})
+		return err
+	}
+	return nil
+}
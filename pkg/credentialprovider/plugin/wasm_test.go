@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	credentialproviderapi "k8s.io/kubelet/pkg/apis/credentialprovider"
+	credentialproviderv1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1"
+	kubeletconfig "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+// fakeWASMRuntime is a WASMRuntime that decodes the request from stdin, writes
+// a canned response to stdout, and records what it was invoked with.
+type fakeWASMRuntime struct {
+	response []byte
+	err      error
+
+	invocations  int
+	module       []byte
+	env          []string
+	requestImage string
+}
+
+func (f *fakeWASMRuntime) Run(_ context.Context, invocation WASMInvocation) error {
+	f.invocations++
+	f.module = invocation.Module
+	f.env = invocation.Env
+
+	stdin, err := io.ReadAll(invocation.Stdin)
+	if err != nil {
+		return err
+	}
+	request := &credentialproviderapi.CredentialProviderRequest{}
+	if err := json.Unmarshal(stdin, request); err != nil {
+		return err
+	}
+	f.requestImage = request.Image
+
+	if f.err != nil {
+		fmt.Fprint(invocation.Stderr, "module diagnostics")
+		return f.err
+	}
+	_, err = invocation.Stdout.Write(f.response)
+	return err
+}
+
+// newTestWASMPlugin returns a wasmPlugin whose module file lives in a temp
+// plugin bin directory and whose base exec plugin encodes v1 requests.
+func newTestWASMPlugin(t *testing.T, runtime WASMRuntime) *wasmPlugin {
+	t.Helper()
+
+	pluginBinDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(pluginBinDir, "wasm-provider.wasm"), []byte("\x00asm fake module"), 0644); err != nil {
+		t.Fatalf("unexpected error writing module: %v", err)
+	}
+
+	return &wasmPlugin{
+		base:    newTestWASMBaseExecPlugin(t, pluginBinDir),
+		runtime: runtime,
+	}
+}
+
+func newTestWASMBaseExecPlugin(t *testing.T, pluginBinDir string) *execPlugin {
+	t.Helper()
+
+	mediaType := "application/json"
+	info, ok := runtime.SerializerInfoForMediaType(codecs.SupportedMediaTypes(), mediaType)
+	if !ok {
+		t.Fatalf("unsupported media type: %s", mediaType)
+	}
+
+	return &execPlugin{
+		name:         "wasm-provider",
+		apiVersion:   credentialproviderv1.SchemeGroupVersion.String(),
+		encoder:      codecs.EncoderForVersion(info.Serializer, credentialproviderv1.SchemeGroupVersion),
+		pluginBinDir: pluginBinDir,
+		envVars:      []kubeletconfig.ExecEnvVar{{Name: "WASM_ENV", Value: "config-value"}},
+		environ:      func() []string { return []string{"SYSTEM_ENV=system-value"} },
+	}
+}
+
+func Test_wasmPluginExecPlugin(t *testing.T) {
+	testcases := []struct {
+		name        string
+		runtime     *fakeWASMRuntime
+		wantAuth    map[string]credentialproviderapi.AuthConfig
+		wantErr     error
+		wantErrText string
+	}{
+		{
+			name: "successful invocation",
+			runtime: &fakeWASMRuntime{
+				response: []byte(`{"kind":"CredentialProviderResponse","apiVersion":"credentialprovider.kubelet.k8s.io/v1","cacheKeyType":"Registry","auth":{"*.registry.io":{"username":"user","password":"password"}}}`),
+			},
+			wantAuth: map[string]credentialproviderapi.AuthConfig{
+				"*.registry.io": {Username: "user", Password: "password"},
+			},
+		},
+		{
+			name: "module error is classified as a plugin crash",
+			runtime: &fakeWASMRuntime{
+				err: errors.New("wasm trap: unreachable"),
+			},
+			wantErr:     ErrPluginCrashed,
+			wantErrText: "module diagnostics",
+		},
+		{
+			name: "response apiVersion mismatch",
+			runtime: &fakeWASMRuntime{
+				response: []byte(`{"kind":"CredentialProviderResponse","apiVersion":"credentialprovider.kubelet.k8s.io/v1alpha1","cacheKeyType":"Registry"}`),
+			},
+			wantErr: ErrMalformedResponse,
+		},
+		{
+			name: "response is not valid JSON",
+			runtime: &fakeWASMRuntime{
+				response: []byte(`not a response`),
+			},
+			wantErr: ErrMalformedResponse,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			plugin := newTestWASMPlugin(t, testcase.runtime)
+
+			response, err := plugin.ExecPlugin(context.Background(), "test.registry.io/foo/bar", "", nil, nil)
+			if testcase.wantErr != nil {
+				if !errors.Is(err, testcase.wantErr) {
+					t.Fatalf("expected error %v, got %v", testcase.wantErr, err)
+				}
+				if len(testcase.wantErrText) > 0 && !strings.Contains(err.Error(), testcase.wantErrText) {
+					t.Fatalf("expected error to contain %q, got %v", testcase.wantErrText, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if testcase.runtime.invocations != 1 {
+				t.Errorf("expected 1 invocation, got %d", testcase.runtime.invocations)
+			}
+			if got := testcase.runtime.requestImage; got != "test.registry.io/foo/bar" {
+				t.Errorf("expected request image test.registry.io/foo/bar, got %q", got)
+			}
+			if got := string(testcase.runtime.module); got != "\x00asm fake module" {
+				t.Errorf("unexpected module bytes: %q", got)
+			}
+
+			gotEnv := sets.New[string](testcase.runtime.env...)
+			for _, want := range []string{"SYSTEM_ENV=system-value", "WASM_ENV=config-value"} {
+				if !gotEnv.Has(want) {
+					t.Errorf("expected env to contain %q, got %v", want, testcase.runtime.env)
+				}
+			}
+
+			if !reflect.DeepEqual(response.Auth, testcase.wantAuth) {
+				t.Errorf("expected auth %v, got %v", testcase.wantAuth, response.Auth)
+			}
+		})
+	}
+}
+
+func Test_wasmPluginLoadModule(t *testing.T) {
+	engine := &fakeWASMRuntime{
+		response: []byte(`{"kind":"CredentialProviderResponse","apiVersion":"credentialprovider.kubelet.k8s.io/v1","cacheKeyType":"Registry"}`),
+	}
+	plugin := newTestWASMPlugin(t, engine)
+
+	if _, err := plugin.ExecPlugin(context.Background(), "test.registry.io/foo/bar", "", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The module is cached after the first invocation, so removing the file
+	// must not affect subsequent invocations.
+	if err := os.Remove(filepath.Join(plugin.base.pluginBinDir, "wasm-provider.wasm")); err != nil {
+		t.Fatalf("unexpected error removing module: %v", err)
+	}
+	if _, err := plugin.ExecPlugin(context.Background(), "test.registry.io/foo/bar", "", nil, nil); err != nil {
+		t.Fatalf("unexpected error after module removal: %v", err)
+	}
+	if engine.invocations != 2 {
+		t.Errorf("expected 2 invocations, got %d", engine.invocations)
+	}
+}
+
+func Test_wasmPluginMissingModule(t *testing.T) {
+	plugin := &wasmPlugin{
+		base:    newTestWASMBaseExecPlugin(t, t.TempDir()),
+		runtime: &fakeWASMRuntime{},
+	}
+
+	_, err := plugin.ExecPlugin(context.Background(), "test.registry.io/foo/bar", "", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "error reading wasm module for credential provider plugin wasm-provider") {
+		t.Fatalf("expected missing module error, got %v", err)
+	}
+}
+
+func Test_newPluginProvider_wasmRuntime(t *testing.T) {
+	provider := kubeletconfig.CredentialProvider{
+		Name:                 "wasm-provider",
+		APIVersion:           credentialproviderv1.SchemeGroupVersion.String(),
+		MatchImages:          []string{"*.registry.io"},
+		DefaultCacheDuration: &metav1.Duration{Duration: 0},
+		Runtime:              kubeletconfig.WASMCredentialProviderRuntime,
+	}
+
+	if _, err := newPluginProvider(t.TempDir(), provider, nil, nil); err == nil || !strings.Contains(err.Error(), "no WASM runtime is wired into this kubelet build") {
+		t.Fatalf("expected missing runtime error, got %v", err)
+	}
+
+	SetWASMRuntime(&fakeWASMRuntime{})
+	t.Cleanup(func() { SetWASMRuntime(nil) })
+
+	pluginProvider, err := newPluginProvider(t.TempDir(), provider, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := pluginProvider.plugin.(*wasmPlugin); !ok {
+		t.Fatalf("expected plugin to be a wasmPlugin, got %T", pluginProvider.plugin)
+	}
+	if pluginProvider.describePlugin != nil {
+		t.Errorf("expected describePlugin to be nil for a wasm provider")
+	}
+	if pluginProvider.enableRequestCompression != nil {
+		t.Errorf("expected enableRequestCompression to be nil for a wasm provider")
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialprovider
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/klog/v2"
+)
+
+// RegistryRequestSigner resolves request signing parameters returned by a
+// credential provider plugin into credentials the container runtime can
+// present, for registries that refuse long-lived static credentials and
+// require each request to be signed, such as registries fronted by AWS SigV4.
+type RegistryRequestSigner interface {
+	// SignPullRequest resolves the signing parameters for a pull of image into
+	// short-lived credentials. The returned AuthConfig typically carries a
+	// RegistryToken or username/password derived from the signature; it must
+	// not itself carry signing parameters.
+	SignPullRequest(ctx context.Context, image string, signing AuthConfigSigning) (*AuthConfig, error)
+}
+
+var (
+	requestSignerMutex sync.RWMutex
+	requestSigners     map[string]RegistryRequestSigner
+)
+
+// RegisterRequestSigner registers a signer for the given signing algorithm,
+// for example "aws-sigv4". It replaces any signer previously registered for
+// the algorithm and is expected to be called during kubelet initialization,
+// before images are pulled. In strict FIPS mode, registrations for algorithms
+// that are not FIPS-approved are refused, so signing parameters naming them
+// fail the pull instead of producing a non-compliant signature.
+func RegisterRequestSigner(algorithm string, signer RegistryRequestSigner) {
+	if StrictFIPSMode() && !FIPSApprovedSigningAlgorithm(algorithm) {
+		klog.Warningf("Refusing to register request signer for algorithm %q: not FIPS-approved and the kubelet was built in strict FIPS mode", algorithm)
+		return
+	}
+
+	requestSignerMutex.Lock()
+	defer requestSignerMutex.Unlock()
+	if requestSigners == nil {
+		requestSigners = make(map[string]RegistryRequestSigner)
+	}
+	requestSigners[algorithm] = signer
+}
+
+// RequestSignerFor returns the signer registered for the given signing
+// algorithm, or nil if no signer is registered for it.
+func RequestSignerFor(algorithm string) RegistryRequestSigner {
+	requestSignerMutex.RLock()
+	defer requestSignerMutex.RUnlock()
+	return requestSigners[algorithm]
+}
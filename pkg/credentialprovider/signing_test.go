@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialprovider
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeRegistryRequestSigner struct {
+	token string
+}
+
+func (s *fakeRegistryRequestSigner) SignPullRequest(_ context.Context, image string, signing AuthConfigSigning) (*AuthConfig, error) {
+	return &AuthConfig{RegistryToken: s.token}, nil
+}
+
+func TestRegisterRequestSigner(t *testing.T) {
+	if signer := RequestSignerFor("test-register-algorithm"); signer != nil {
+		t.Fatalf("expected no signer registered for test-register-algorithm, got %v", signer)
+	}
+
+	first := &fakeRegistryRequestSigner{token: "first"}
+	RegisterRequestSigner("test-register-algorithm", first)
+	if signer := RequestSignerFor("test-register-algorithm"); signer != first {
+		t.Errorf("expected registered signer, got %v", signer)
+	}
+
+	// Registering again for the same algorithm replaces the previous signer.
+	second := &fakeRegistryRequestSigner{token: "second"}
+	RegisterRequestSigner("test-register-algorithm", second)
+	if signer := RequestSignerFor("test-register-algorithm"); signer != second {
+		t.Errorf("expected replacement signer, got %v", signer)
+	}
+}
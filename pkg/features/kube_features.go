@@ -420,6 +420,13 @@ const (
 	// authorization.
 	KubeletFineGrainedAuthz featuregate.Feature = "KubeletFineGrainedAuthz"
 
+	// owner: @stlaz
+	// kep: https://kep.k8s.io/2535
+	//
+	// Encrypts the image pull intent and pulled record files persisted by the
+	// kubelet with a node-local key managed in a kubelet-owned key file.
+	KubeletImagePullRecordEncryption featuregate.Feature = "KubeletImagePullRecordEncryption"
+
 	// owner: @AkihiroSuda
 	//
 	// Enables support for running kubelet in a user namespace.
@@ -1287,6 +1294,10 @@ var defaultVersionedKubernetesFeatureGates = map[featuregate.Feature]featuregate
 		{Version: version.MustParse("1.33"), Default: true, PreRelease: featuregate.Beta},
 	},
 
+	KubeletImagePullRecordEncryption: {
+		{Version: version.MustParse("1.34"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
 	KubeletInUserNamespace: {
 		{Version: version.MustParse("1.22"), Default: false, PreRelease: featuregate.Alpha},
 	},
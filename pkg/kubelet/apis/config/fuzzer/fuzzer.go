@@ -128,10 +128,39 @@ func Funcs(codecs runtimeserializer.CodecFactory) []interface{} {
 			}
 		},
 
-		// tokenAttributes field is only supported in v1 CredentialProvider
+		// tokenAttributes and command fields are only supported in v1 CredentialProvider
 		func(obj *kubeletconfig.CredentialProvider, c randfill.Continue) {
 			c.FillNoCustom(obj)
 			obj.TokenAttributes = nil
+			obj.Command = nil
+			obj.ReuseProcess = false
+			obj.TmpDir = ""
+			obj.TolerateUnknownResponseFields = false
+			obj.NodeAttributes = nil
+			obj.RefreshAheadPercent = nil
+			obj.CacheJitterPercent = nil
+			obj.RequiredPodAnnotationKeys = nil
+			obj.OptionalPodAnnotationKeys = nil
+			obj.Override = false
+			obj.MetricsLabels = nil
+			obj.Isolation = nil
+			obj.SystemdScope = nil
+			obj.CredentialSharingPolicy = ""
+			obj.ProbeImage = ""
+			obj.RequiredForNodeReady = false
+			obj.CacheKeyTemplate = ""
+			obj.Runtime = ""
+			obj.BatchRequests = false
+			obj.ImageTransforms = nil
+		},
+
+		// pins, defaults and disableLegacyKeyring fields are only supported in v1
+		// CredentialProviderConfig
+		func(obj *kubeletconfig.CredentialProviderConfig, c randfill.Continue) {
+			c.FillNoCustom(obj)
+			obj.Pins = nil
+			obj.Defaults = nil
+			obj.DisableLegacyKeyring = false
 		},
 	}
 }
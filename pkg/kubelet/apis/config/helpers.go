@@ -28,5 +28,8 @@ func KubeletConfigurationPathRefs(kc *KubeletConfiguration) []*string {
 	paths = append(paths, &kc.ResolverConfig)
 	paths = append(paths, &kc.VolumePluginDir)
 	paths = append(paths, &kc.PodLogsDir)
+	for i := range kc.CredentialProviders {
+		paths = append(paths, &kc.CredentialProviders[i].TmpDir)
+	}
 	return paths
 }
@@ -157,6 +157,7 @@ var (
 		"TLSPrivateKeyFile",
 		"ResolverConfig",
 		"PodLogsDir",
+		"CredentialProviders[*].TmpDir",
 	)
 
 	// KubeletConfiguration fields that do not contain file paths.
@@ -189,6 +190,48 @@ var (
 		"ContainerLogMaxWorkers",
 		"ContainerLogMonitorInterval",
 		"ContentType",
+		"CredentialProviders[*].APIVersion",
+		"CredentialProviders[*].Args[*]",
+		"CredentialProviders[*].BatchRequests",
+		"CredentialProviders[*].BinaryByPlatform[*]",
+		"CredentialProviders[*].CacheJitterPercent",
+		"CredentialProviders[*].CacheKeyTemplate",
+		"CredentialProviders[*].Command[*]",
+		"CredentialProviders[*].CredentialSharingPolicy",
+		"CredentialProviders[*].DefaultCacheDuration.Duration",
+		"CredentialProviders[*].Env[*].Name",
+		"CredentialProviders[*].Env[*].Value",
+		"CredentialProviders[*].ImageTransforms[*].MapHostname.From",
+		"CredentialProviders[*].ImageTransforms[*].MapHostname.To",
+		"CredentialProviders[*].ImageTransforms[*].Type",
+		"CredentialProviders[*].Isolation.MountNamespace",
+		"CredentialProviders[*].Isolation.NetworkNamespace",
+		"CredentialProviders[*].MatchImages[*]",
+		"CredentialProviders[*].MetricsLabels[*]",
+		"CredentialProviders[*].Name",
+		"CredentialProviders[*].NodeAttributes.AnnotationKeys[*]",
+		"CredentialProviders[*].NodeAttributes.SendTopologyLabels",
+		"CredentialProviders[*].OptionalPodAnnotationKeys[*]",
+		"CredentialProviders[*].Override",
+		"CredentialProviders[*].ProbeImage",
+		"CredentialProviders[*].RefreshAheadPercent",
+		"CredentialProviders[*].RequiredForNodeReady",
+		"CredentialProviders[*].RequiredPodAnnotationKeys[*]",
+		"CredentialProviders[*].ReuseProcess",
+		"CredentialProviders[*].Runtime",
+		"CredentialProviders[*].SystemdScope.CPUQuotaPercent",
+		"CredentialProviders[*].SystemdScope.MemoryMax",
+		"CredentialProviders[*].SystemdScope.TasksMax",
+		"CredentialProviders[*].TokenAttributes.AllowedNamespaces[*]",
+		"CredentialProviders[*].TokenAttributes.AllowedServiceAccountNames[*]",
+		"CredentialProviders[*].TokenAttributes.OptionalServiceAccountAnnotationKeys[*]",
+		"CredentialProviders[*].TokenAttributes.RequireServiceAccount",
+		"CredentialProviders[*].TokenAttributes.RequiredServiceAccountAnnotationKeys[*]",
+		"CredentialProviders[*].TokenAttributes.ServiceAccountTokenAudience",
+		"CredentialProviders[*].TokenAttributes.ServiceAccountTokenAudiences[*]",
+		"CredentialProviders[*].TokenAttributes.TokenExpirySlack.Duration",
+		"CredentialProviders[*].TolerateUnknownResponseFields",
+		"CredentialSourceOrder[*]",
 		"EnableContentionProfiling",
 		"EnableControllerAttachDetach",
 		"EnableDebugFlagsHandler",
@@ -244,6 +287,7 @@ var (
 		"ImageMinimumGCAge.Duration",
 		"ImageMaximumGCAge.Duration",
 		"ImagePullCredentialsVerificationPolicy",
+		"InvalidateCredentialsOnImageGC",
 		"KernelMemcgNotification",
 		"KubeAPIBurst",
 		"KubeAPIQPS",
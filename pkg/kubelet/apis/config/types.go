@@ -174,6 +174,21 @@ type KubeletConfiguration struct {
 	// The list accepts a full path segment wildcard suffix "/*".
 	// Only use image specs without an image tag or digest.
 	PreloadedImagesVerificationAllowlist []string
+	// credentialSourceOrder optionally defines the order in which the kubelet consults
+	// image pull credential sources. Credentials from sources earlier in the list are
+	// attempted first. Valid entries are:
+	//   - PodImagePullSecrets
+	//       - the imagePullSecrets referenced by the pod being pulled
+	//   - NodeDockerConfig
+	//       - the node-level docker config file search, which also covers the behavior
+	//         previously provided by the in-tree legacy credential providers
+	//   - ExternalCredentialProviders
+	//       - the exec credential provider plugins configured via
+	//         --image-credential-provider-config
+	// Sources omitted from the list are not consulted. An empty list preserves the
+	// kubelet's historical ordering: pod imagePullSecrets first, then the node docker
+	// config, then the external credential provider plugins.
+	CredentialSourceOrder []string
 	// eventRecordQPS is the maximum event creations per second. If 0, there
 	// is no limit enforced.
 	EventRecordQPS int32
@@ -224,6 +239,10 @@ type KubeletConfiguration struct {
 	// The default of this field is "0s", which disables this field--meaning images won't be garbage
 	// collected based on being unused for too long.
 	ImageMaximumGCAge metav1.Duration
+	// invalidateCredentialsOnImageGC controls whether cached image pull credentials
+	// from external credential provider plugins are evicted for a registry once the
+	// last image from that registry has been garbage collected.
+	InvalidateCredentialsOnImageGC bool
 	// imageGCHighThresholdPercent is the percent of disk usage after which
 	// image garbage collection is always run. The percent is calculated as
 	// this field value out of 100.
@@ -543,6 +562,15 @@ type KubeletConfiguration struct {
 	// +featureGate=UserNamespacesSupport
 	// +optional
 	UserNamespaces *UserNamespaces
+
+	// CredentialProviders is a list of exec credential provider plugins enabled
+	// directly from the kubelet configuration, as an alternative to a separate
+	// config file or directory given via --image-credential-provider-config.
+	// Providers listed here are merged with the providers loaded from that path
+	// and are subject to the same validation; provider names must be unique
+	// across both sources.
+	// +optional
+	CredentialProviders []CredentialProvider
 }
 
 // KubeletAuthorizationMode denotes the authorization mode for the kubelet
@@ -635,6 +663,68 @@ type CredentialProviderConfig struct {
 	// for a single image, the results are combined. If providers return overlapping
 	// auth keys, the value from the provider earlier in this list is attempted first.
 	Providers []CredentialProvider
+
+	// pins is an optional list of exact, digest-pinned image references that must always
+	// be resolved by a specific provider, regardless of matchImages pattern overlap.
+	// Each pinned provider must be listed in providers.
+	// +optional
+	Pins []CredentialProviderPin
+
+	// defaults is an optional set of default values that provider entries inherit
+	// when they omit the corresponding field. Inherited values are validated the
+	// same way as explicitly configured ones.
+	// +optional
+	Defaults *CredentialProviderConfigDefaults
+
+	// disableLegacyKeyring disables the kubelet's legacy search for node-wide
+	// docker credentials, such as /var/lib/kubelet/config.json and the .docker
+	// directories under HOME. When set, the configured plugin providers are the
+	// only source of registry credentials on the node, removing ambiguity about
+	// where a credential came from. Pod image pull secrets are unaffected.
+	// +optional
+	DisableLegacyKeyring bool
+}
+
+// CredentialProviderConfigDefaults holds default values that provider entries
+// of a CredentialProviderConfig inherit when they omit the corresponding field.
+type CredentialProviderConfigDefaults struct {
+	// pluginAPIVersion is the apiVersion that providers omitting apiVersion inherit.
+	// It is validated against the same set of supported CredentialProviderRequest
+	// versions as a per-provider apiVersion.
+	// +optional
+	PluginAPIVersion string
+
+	// env is a set of environment variables that every provider entry inherits.
+	// Variables a provider sets itself take precedence over inherited variables
+	// of the same name; the remaining inherited variables are passed to the
+	// plugin alongside the provider's own.
+	// +optional
+	Env []ExecEnvVar
+
+	// defaultCacheDuration is the defaultCacheDuration that providers omitting
+	// defaultCacheDuration inherit.
+	// +optional
+	DefaultCacheDuration *metav1.Duration
+
+	// maxCacheDuration is an upper bound on the defaultCacheDuration of every
+	// provider entry in this config, whether inherited or set explicitly.
+	// Values above the bound are lowered to it.
+	// +optional
+	MaxCacheDuration *metav1.Duration
+}
+
+// CredentialProviderPin maps an exact, digest-pinned image reference to a specific
+// credential provider. Pins guarantee that critical system images always use a
+// known-good credential path even when multiple providers' matchImages patterns
+// overlap for the image.
+type CredentialProviderPin struct {
+	// image is the exact image reference to pin. It must be pinned by digest,
+	// e.g. registry.k8s.io/pause@sha256:....
+	Image string
+
+	// provider is the name of the credential provider that must be used for the
+	// pinned image. It must match the name of a provider in providers.
+	Provider string
 }
 
 // CredentialProvider represents an exec plugin to be invoked by the kubelet. The plugin is only
@@ -712,6 +802,302 @@ type CredentialProvider struct {
 	// credentials are not shared across pods (even if they are using the same service account).
 	// +optional
 	TokenAttributes *ServiceAccountTokenAttributes
+
+	// command is an optional command used to invoke the plugin, allowing script-based
+	// plugins to be invoked via an explicit interpreter, e.g. ["/usr/bin/python3", "provider.py"].
+	// The first element must be an absolute path to the interpreter and the interpreter
+	// must be owned by root. Relative paths in the remaining elements are resolved
+	// relative to the plugin bin directory. If command is empty, the kubelet execs the
+	// provider binary named after the provider in the plugin bin directory.
+	// +optional
+	Command []string
+
+	// reuseProcess keeps the plugin process running between invocations. The kubelet
+	// sends length-prefixed requests over the process's stdin and reads length-prefixed
+	// responses from its stdout, amortizing process startup cost for plugins with heavy
+	// runtime initialization. If the process exits or misbehaves, it is restarted on the
+	// next invocation.
+	// +optional
+	ReuseProcess bool
+
+	// tmpDir is an optional directory the plugin should use for temporary files,
+	// exported to the plugin process as TMPDIR. It isolates plugin scratch files
+	// from the kubelet's and lets operators point secret-bearing temporary files
+	// at a ramdisk. If empty, the plugin inherits the kubelet's own TMPDIR, if any.
+	// +optional
+	TmpDir string
+
+	// binaryByPlatform optionally maps a platform key of the form <os>-<arch>,
+	// such as linux-amd64, to the plugin binary the kubelet executes on that
+	// platform, as a path relative to the plugin bin directory. It lets a single
+	// configuration shared across a mixed-architecture fleet name a different
+	// binary per platform, and must contain an entry for the platform the
+	// kubelet runs on. If this field is empty, the kubelet looks for the binary
+	// named after the provider in an <os>-<arch> subdirectory of the plugin bin
+	// directory before falling back to the bin directory itself.
+	// This field cannot be combined with command.
+	// +optional
+	BinaryByPlatform map[string]string
+
+	// tolerateUnknownResponseFields, if true, causes the kubelet to drop unknown
+	// fields from this plugin's responses with a warning log instead of failing
+	// the strict decode. This eases version skew during rolling upgrades where
+	// plugins are updated to a newer API minor version ahead of kubelets.
+	// +optional
+	TolerateUnknownResponseFields bool
+
+	// nodeAttributes configures node-derived information included in each
+	// CredentialProviderRequest sent to this plugin, letting plugins pick
+	// regional registry or STS endpoints without calling the API server
+	// themselves.
+	// +optional
+	NodeAttributes *NodeAttributes
+
+	// refreshAheadPercent enables asynchronous refresh of cached credentials
+	// before they expire. Once more than this percentage of a cached
+	// credential's lifetime has elapsed, a cache hit still serves the cached
+	// credential immediately but also triggers a background plugin invocation
+	// to refresh it, taking plugin latency out of the pod startup critical
+	// path. Must be between 1 and 99 inclusive. If nil, cached credentials are
+	// only refreshed synchronously after they expire.
+	// +optional
+	RefreshAheadPercent *int32
+
+	// cacheJitterPercent randomizes the cache duration of credentials on
+	// insert: up to this percentage of the duration is randomly subtracted
+	// from each cached entry's lifetime, so the hundreds of entries primed
+	// together at node boot do not all expire simultaneously and trigger a
+	// burst of plugin invocations. Jitter only ever shortens a lifetime, so
+	// credentials are never served past the expiry the plugin declared. Must
+	// be between 1 and 99 inclusive. If nil, no jitter is applied.
+	// +optional
+	CacheJitterPercent *int32
+
+	// requiredPodAnnotationKeys is the list of annotation keys that the plugin is
+	// interested in and that are required to be present on the pod the image is
+	// being pulled for. The keys defined in this list will be extracted from the
+	// pod and passed to the plugin as part of the CredentialProviderRequest. If any
+	// of the keys defined in this list are not present on the pod, kubelet will not
+	// invoke the plugin for that pull. This lets workloads opt in to plugin
+	// invocation and lets plugins scope registry access on workload-declared hints
+	// such as a tenant ID. Keys in this list must be unique and mutually exclusive
+	// with optionalPodAnnotationKeys.
+	// +optional
+	RequiredPodAnnotationKeys []string
+
+	// optionalPodAnnotationKeys is the list of annotation keys that the plugin is
+	// interested in and that are optional to be present on the pod the image is
+	// being pulled for. If present, they will be passed to the plugin as part of
+	// the CredentialProviderRequest; the plugin is responsible for validating their
+	// existence and values. Keys in this list must be unique.
+	// +optional
+	OptionalPodAnnotationKeys []string
+
+	// override, if true, marks this provider entry as an intentional replacement
+	// of a provider with the same name defined in an earlier config file. It
+	// lets layered configurations (a fleet-wide base directory plus
+	// site-specific files) replace individual providers without tripping
+	// duplicate detection. An override entry whose name does not match an
+	// earlier provider is an error.
+	// +optional
+	Override bool
+
+	// metricsLabels is an optional small set of static labels (for example team
+	// or tier) attached to this provider. Each entry is published through the
+	// kubelet_credential_provider_plugin_labels metric so alerts on this
+	// provider's metrics can be routed to the owning team. Label names must
+	// match ^[a-zA-Z_][a-zA-Z0-9_]*$ and at most 8 labels may be set.
+	// +optional
+	MetricsLabels map[string]string
+
+	// isolation confines the plugin process to dedicated Linux namespaces,
+	// limiting what a compromised or misbehaving plugin can reach. It is only
+	// supported on Linux; configuring it on other platforms fails plugin
+	// invocations.
+	// +optional
+	Isolation *CredentialProviderIsolation
+
+	// systemdScope, if set, runs the plugin process inside a systemd transient
+	// scope unit instead of as a raw kubelet child. The scope gives the plugin
+	// proper cgroup accounting visible in systemd tooling and lets systemd
+	// clean up any processes the plugin leaks. Resource limits configured on
+	// the scope bound the plugin's CPU, memory and task consumption; an empty
+	// scope provides accounting only. It is only supported on hosts running
+	// systemd; configuring it elsewhere fails plugin invocations.
+	// +optional
+	SystemdScope *CredentialProviderSystemdScope
+
+	// credentialSharingPolicy controls which pods on the node may share the
+	// credentials this provider returns. Node, the default, caches credentials
+	// node-wide and records image pulls as accessible to every pod on the node.
+	// SameNamespace caches credentials per pod namespace, and SameServiceAccount
+	// per namespace and service account name; under both, pods outside the scope
+	// the credentials were resolved for trigger a fresh plugin invocation, and
+	// reuse of an already-pulled image requires presenting matching credentials.
+	// +optional
+	CredentialSharingPolicy CredentialSharingPolicy
+
+	// probeImage is an optional image reference the kubelet uses to smoke-test
+	// the plugin once right after loading it, catching missing binaries and
+	// broken registry access before real workloads fail. The probe runs in the
+	// background and never blocks kubelet startup; a failed probe marks the
+	// provider degraded through metrics and lifecycle events but does not take
+	// it out of service. The image must match one of the provider's matchImages
+	// patterns. Probes run without a pod identity, so probeImage cannot be
+	// combined with tokenAttributes.
+	// +optional
+	ProbeImage string
+
+	// requiredForNodeReady marks this provider as required for the node to take
+	// image-pulling workloads. While the provider's probe invocation is failing,
+	// or before its first probe has completed, the kubelet reports the
+	// CredentialProviderNotReady node condition as true so schedulers can steer
+	// workloads away from nodes that cannot authenticate to the registries this
+	// provider serves. Requires probeImage to be set, as the probe is what
+	// determines the provider's readiness.
+	// +optional
+	RequiredForNodeReady bool
+
+	// cacheKeyTemplate overrides the cache scope the plugin declares in its
+	// responses with an operator-chosen one, expressed as a template over the
+	// pull being served, e.g. "{{.Registry}}/{{.Namespace}}". Only the variables
+	// {{.Image}}, {{.Registry}}, {{.Namespace}} and {{.ServiceAccountName}} may
+	// be referenced; control structures, pipelines and function calls are not
+	// allowed. Each referenced variable is bound unambiguously when the key is
+	// built, so values containing template separator characters cannot collide
+	// with keys built from different values.
+	// +optional
+	CacheKeyTemplate string
+
+	// runtime selects how the plugin is executed. Exec, the default when empty,
+	// forks the plugin as a native binary from the plugin bin directory. Wasm
+	// runs the plugin as a WASI module in a runtime embedded in the kubelet:
+	// the module named after the provider with a .wasm extension is loaded from
+	// the plugin bin directory, receives the CredentialProviderRequest on stdin
+	// and returns the CredentialProviderResponse on stdout, exactly like an
+	// exec plugin. WASM modules are portable across node architectures and run
+	// sandboxed inside the kubelet process, so they cannot be combined with the
+	// process-level command, reuseProcess and isolation settings.
+	// +optional
+	Runtime CredentialProviderRuntime
+
+	// batchRequests, when true, sends every image of a pod this provider matches
+	// in a single CredentialProviderRequest instead of one request per image: the
+	// images field of the request carries the full list and the response must
+	// cover each image in it. Credentials from the batched response are cached
+	// for every image sent, cutting plugin invocations per pod down to one for
+	// plugins with expensive per-call setup. Requires the
+	// credentialprovider.kubelet.k8s.io/v1 API version and cannot be combined
+	// with the wasm runtime or cacheKeyTemplate.
+	// +optional
+	BatchRequests bool
+
+	// imageTransforms is an ordered list of built-in transforms applied to the
+	// image reference before it is matched against matchImages, so operators
+	// can adapt matching to unusual internal naming conventions without plugin
+	// changes. Transforms only affect matching: the plugin is still invoked
+	// with, and credentials are still cached under, the original image
+	// reference.
+	// +optional
+	ImageTransforms []ImageTransform
+}
+
+// CredentialSharingPolicy is the scope of pods that may share the credentials
+// a credential provider plugin returns.
+type CredentialSharingPolicy string
+
+const (
+	// NodeCredentialSharingPolicy shares credentials across all pods on the
+	// node. This is the default and matches the historical behavior.
+	NodeCredentialSharingPolicy CredentialSharingPolicy = "Node"
+	// SameNamespaceCredentialSharingPolicy shares credentials only across pods
+	// in the same namespace.
+	SameNamespaceCredentialSharingPolicy CredentialSharingPolicy = "SameNamespace"
+	// SameServiceAccountCredentialSharingPolicy shares credentials only across
+	// pods using the same service account in the same namespace.
+	SameServiceAccountCredentialSharingPolicy CredentialSharingPolicy = "SameServiceAccount"
+)
+
+// CredentialProviderRuntime is the mechanism a credential provider plugin is
+// executed with.
+type CredentialProviderRuntime string
+
+const (
+	// ExecCredentialProviderRuntime forks the plugin as a native binary. This
+	// is the default and matches the historical behavior.
+	ExecCredentialProviderRuntime CredentialProviderRuntime = "exec"
+	// WASMCredentialProviderRuntime runs the plugin as a WASI module in a
+	// runtime embedded in the kubelet.
+	WASMCredentialProviderRuntime CredentialProviderRuntime = "wasm"
+)
+
+// CredentialProviderNetworkNamespace is the network namespace a credential
+// provider plugin process runs in.
+type CredentialProviderNetworkNamespace string
+
+const (
+	// HostNetworkNamespace runs the plugin in the host network namespace,
+	// giving it the same network access as the kubelet.
+	HostNetworkNamespace CredentialProviderNetworkNamespace = "Host"
+	// NoneNetworkNamespace runs the plugin in a new network namespace with
+	// only a loopback interface, cutting it off from the network entirely.
+	NoneNetworkNamespace CredentialProviderNetworkNamespace = "None"
+)
+
+// CredentialProviderIsolation configures the Linux namespaces a credential
+// provider plugin process is confined to.
+type CredentialProviderIsolation struct {
+	// mountNamespace, if true, runs the plugin in a new mount namespace, so
+	// mounts the plugin creates do not propagate to the host.
+	// +optional
+	MountNamespace bool
+
+	// networkNamespace controls the network namespace the plugin runs in.
+	// Host gives the plugin the kubelet's own network access and is the
+	// default; None confines plugins that only need local files, such as
+	// static credential stores, to a namespace with no network at all.
+	// +optional
+	NetworkNamespace CredentialProviderNetworkNamespace
+}
+
+// CredentialProviderSystemdScope configures the systemd transient scope unit
+// a credential provider plugin process runs in.
+type CredentialProviderSystemdScope struct {
+	// cpuQuotaPercent limits the CPU time the plugin process may consume to
+	// this percentage of a single CPU, enforced via the systemd CPUQuota
+	// property. Must be at least 1.
+	// +optional
+	CPUQuotaPercent *int32
+
+	// memoryMax is a hard limit on the memory the plugin process may consume,
+	// expressed as a resource quantity such as 128Mi and enforced via the
+	// systemd MemoryMax property. A plugin exceeding the limit is killed.
+	// +optional
+	MemoryMax string
+
+	// tasksMax limits the number of tasks (processes and threads) the plugin
+	// may create, enforced via the systemd TasksMax property. Must be at
+	// least 1.
+	// +optional
+	TasksMax *int64
+}
+
+// NodeAttributes configures the node-derived information passed to a credential
+// provider plugin as part of CredentialProviderRequest.
+type NodeAttributes struct {
+	// sendTopologyLabels, if true, includes the node's topology.kubernetes.io/zone
+	// and topology.kubernetes.io/region labels in the nodeTopologyLabels field of
+	// the CredentialProviderRequest. Labels not present on the node are omitted.
+	// +optional
+	SendTopologyLabels bool
+
+	// annotationKeys is the list of node annotation keys the plugin is interested in.
+	// Annotations present on the node for the listed keys are passed to the plugin in
+	// the nodeAnnotations field of the CredentialProviderRequest; keys absent from the
+	// node are omitted and the plugin is responsible for handling their absence.
+	// Keys in this list must be unique.
+	// +optional
+	AnnotationKeys []string
 }
 
 // ServiceAccountTokenAttributes is the configuration for the service account token that will be passed to the plugin.
@@ -720,6 +1106,16 @@ type ServiceAccountTokenAttributes struct {
 	// +required
 	ServiceAccountTokenAudience string
 
+	// serviceAccountTokenAudiences is an optional list of additional audiences the
+	// plugin may request tokens for. The kubelet mints tokens for
+	// serviceAccountTokenAudience by default; a plugin response may name any entry
+	// of this list to have the token re-minted for that audience and the plugin
+	// invoked again with it. This supports registries that federate across
+	// multiple identity providers. Entries must be unique and must not repeat
+	// serviceAccountTokenAudience.
+	// +optional
+	ServiceAccountTokenAudiences []string
+
 	// requireServiceAccount indicates whether the plugin requires the pod to have a service account.
 	// If set to true, kubelet will only invoke the plugin if the pod has a service account.
 	// If set to false, kubelet will invoke the plugin even if the pod does not have a service account
@@ -752,6 +1148,36 @@ type ServiceAccountTokenAttributes struct {
 	// Keys in this list must be unique.
 	// +optional
 	OptionalServiceAccountAnnotationKeys []string
+
+	// allowedNamespaces is the list of namespaces the plugin may receive service
+	// account tokens for. If a pod's namespace is not in this list, kubelet will
+	// not mint a token and will not invoke the plugin for that pod. This limits
+	// the blast radius of a compromised plugin to the approved set of identities.
+	// If empty, tokens may be minted for service accounts in any namespace.
+	// Entries in this list must be unique.
+	// +optional
+	AllowedNamespaces []string
+
+	// allowedServiceAccountNames is the list of service account names the plugin
+	// may receive tokens for. If a pod's service account name is not in this
+	// list, kubelet will not mint a token and will not invoke the plugin for
+	// that pod. It composes with allowedNamespaces: both restrictions must be
+	// satisfied when both are set.
+	// If empty, tokens may be minted for service accounts with any name.
+	// Entries in this list must be unique.
+	// +optional
+	AllowedServiceAccountNames []string
+
+	// tokenExpirySlack shortens how long credentials minted with a service
+	// account token may be cached, relative to the token's remaining validity.
+	// Credentials obtained with a token are never cached past the token's
+	// expiry minus this slack, regardless of the cache duration the plugin
+	// declares, so credentials cannot be served from the cache after the
+	// identity they were minted from has expired.
+	// If not set, the cache duration is capped at exactly the token's expiry.
+	// Must not be negative.
+	// +optional
+	TokenExpirySlack *metav1.Duration
 }
 
 // ExecEnvVar is used for setting environment variables when executing an exec-based
@@ -761,6 +1187,46 @@ type ExecEnvVar struct {
 	Value string
 }
 
+// ImageTransformType is a built-in transform applied to an image reference
+// before it is matched against a provider's matchImages patterns.
+type ImageTransformType string
+
+const (
+	// StripDigestImageTransformType removes a trailing @digest from the image
+	// reference before matching.
+	StripDigestImageTransformType ImageTransformType = "StripDigest"
+	// StripTagImageTransformType removes a trailing :tag from the image
+	// reference before matching.
+	StripTagImageTransformType ImageTransformType = "StripTag"
+	// MapHostnameImageTransformType replaces the registry hostname of the
+	// image reference with another hostname before matching.
+	MapHostnameImageTransformType ImageTransformType = "MapHostname"
+)
+
+// ImageTransform is a single transform applied to an image reference before
+// matching.
+type ImageTransform struct {
+	// type is the transform to apply, one of StripDigest, StripTag and
+	// MapHostname.
+	Type ImageTransformType
+
+	// mapHostname configures the hostname mapping for the MapHostname
+	// transform. Required for that transform type and forbidden for others.
+	// +optional
+	MapHostname *HostnameMapping
+}
+
+// HostnameMapping maps one registry hostname to another.
+type HostnameMapping struct {
+	// from is the registry hostname, including any port, to replace. The
+	// transform is a no-op for images hosted on any other registry.
+	From string
+
+	// to is the registry hostname, including any port, the image reference is
+	// rewritten to before matching.
+	To string
+}
+
 // MemoryReservation specifies the memory reservation of different types for each NUMA node
 type MemoryReservation struct {
 	NumaNode int32
@@ -813,6 +1279,20 @@ const (
 	AlwaysVerify ImagePullCredentialsVerificationPolicy = "AlwaysVerify"
 )
 
+// CredentialSource identifies a source of image pull credentials used by the kubelet.
+type CredentialSource string
+
+const (
+	// PodImagePullSecretsSource uses the imagePullSecrets referenced by the pod being pulled.
+	PodImagePullSecretsSource CredentialSource = "PodImagePullSecrets"
+	// NodeDockerConfigSource uses the node-level docker config file search, which also
+	// covers the behavior previously provided by the in-tree legacy credential providers.
+	NodeDockerConfigSource CredentialSource = "NodeDockerConfig"
+	// ExternalCredentialProvidersSource uses the exec credential provider plugins
+	// configured via --image-credential-provider-config.
+	ExternalCredentialProvidersSource CredentialSource = "ExternalCredentialProviders"
+)
+
 // ImagePullIntent is a record of the kubelet attempting to pull an image.
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -870,6 +1350,14 @@ type ImagePullCredentials struct {
 	// If true, it is mutually exclusive with the `kubernetesSecrets` field.
 	// +optional
 	NodePodsAccessible bool
+
+	// ExternalCredentialProviders is an index of records of the external
+	// credential provider plugins that supplied the credentials used to pull
+	// the image, along with the identity scope the credentials were resolved
+	// for. It may be set alongside `nodePodsAccessible` when the provider
+	// resolved node-wide credentials.
+	// +optional
+	ExternalCredentialProviders []ImagePullExternalCredentialProvider
 }
 
 // ImagePullSecret is a representation of a Kubernetes secret object coordinates along
@@ -884,6 +1372,29 @@ type ImagePullSecret struct {
 	CredentialHash string
 }
 
+// ImagePullExternalCredentialProvider records that an image was pulled with
+// credentials from an external credential provider plugin, along with the
+// identity scope the credentials were resolved for.
+type ImagePullExternalCredentialProvider struct {
+	// Provider is the name of the credential provider plugin from the credential
+	// provider config. Empty for records migrated from a schema version that did
+	// not track the provider name.
+	Provider string
+
+	// UID, Namespace and Name are the coordinates of the service account the
+	// credentials were resolved for. Only the fields the provider's identity
+	// scope partitions by are set: all three for service-account-scoped
+	// credentials, only Namespace for namespace-scoped ones, and none when the
+	// provider resolved node-wide credentials.
+	UID       string
+	Namespace string
+	Name      string
+
+	// CredentialHash is a SHA-256 hash of the image pull credentials the
+	// provider returned.
+	CredentialHash string
+}
+
 // UserNamespaces contains User Namespace configurations.
 type UserNamespaces struct {
 	// IDsPerPod is the mapping length of UIDs and GIDs.
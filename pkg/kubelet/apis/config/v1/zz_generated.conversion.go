@@ -89,6 +89,27 @@ func autoConvert_v1_CredentialProvider_To_config_CredentialProvider(in *configv1
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
 	out.Env = *(*[]config.ExecEnvVar)(unsafe.Pointer(&in.Env))
 	out.TokenAttributes = (*config.ServiceAccountTokenAttributes)(unsafe.Pointer(in.TokenAttributes))
+	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
+	out.ReuseProcess = in.ReuseProcess
+	out.TmpDir = in.TmpDir
+	out.BinaryByPlatform = *(*map[string]string)(unsafe.Pointer(&in.BinaryByPlatform))
+	out.TolerateUnknownResponseFields = in.TolerateUnknownResponseFields
+	out.NodeAttributes = (*config.NodeAttributes)(unsafe.Pointer(in.NodeAttributes))
+	out.RefreshAheadPercent = (*int32)(unsafe.Pointer(in.RefreshAheadPercent))
+	out.CacheJitterPercent = (*int32)(unsafe.Pointer(in.CacheJitterPercent))
+	out.RequiredPodAnnotationKeys = *(*[]string)(unsafe.Pointer(&in.RequiredPodAnnotationKeys))
+	out.OptionalPodAnnotationKeys = *(*[]string)(unsafe.Pointer(&in.OptionalPodAnnotationKeys))
+	out.Override = in.Override
+	out.MetricsLabels = *(*map[string]string)(unsafe.Pointer(&in.MetricsLabels))
+	out.Isolation = (*config.CredentialProviderIsolation)(unsafe.Pointer(in.Isolation))
+	out.SystemdScope = (*config.CredentialProviderSystemdScope)(unsafe.Pointer(in.SystemdScope))
+	out.CredentialSharingPolicy = config.CredentialSharingPolicy(in.CredentialSharingPolicy)
+	out.ProbeImage = in.ProbeImage
+	out.RequiredForNodeReady = in.RequiredForNodeReady
+	out.CacheKeyTemplate = in.CacheKeyTemplate
+	out.Runtime = config.CredentialProviderRuntime(in.Runtime)
+	out.BatchRequests = in.BatchRequests
+	out.ImageTransforms = *(*[]config.ImageTransform)(unsafe.Pointer(&in.ImageTransforms))
 	return nil
 }
 
@@ -105,6 +126,27 @@ func autoConvert_config_CredentialProvider_To_v1_CredentialProvider(in *config.C
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
 	out.Env = *(*[]configv1.ExecEnvVar)(unsafe.Pointer(&in.Env))
 	out.TokenAttributes = (*configv1.ServiceAccountTokenAttributes)(unsafe.Pointer(in.TokenAttributes))
+	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
+	out.ReuseProcess = in.ReuseProcess
+	out.TmpDir = in.TmpDir
+	out.BinaryByPlatform = *(*map[string]string)(unsafe.Pointer(&in.BinaryByPlatform))
+	out.TolerateUnknownResponseFields = in.TolerateUnknownResponseFields
+	out.NodeAttributes = (*configv1.NodeAttributes)(unsafe.Pointer(in.NodeAttributes))
+	out.RefreshAheadPercent = (*int32)(unsafe.Pointer(in.RefreshAheadPercent))
+	out.CacheJitterPercent = (*int32)(unsafe.Pointer(in.CacheJitterPercent))
+	out.RequiredPodAnnotationKeys = *(*[]string)(unsafe.Pointer(&in.RequiredPodAnnotationKeys))
+	out.OptionalPodAnnotationKeys = *(*[]string)(unsafe.Pointer(&in.OptionalPodAnnotationKeys))
+	out.Override = in.Override
+	out.MetricsLabels = *(*map[string]string)(unsafe.Pointer(&in.MetricsLabels))
+	out.Isolation = (*configv1.CredentialProviderIsolation)(unsafe.Pointer(in.Isolation))
+	out.SystemdScope = (*configv1.CredentialProviderSystemdScope)(unsafe.Pointer(in.SystemdScope))
+	out.CredentialSharingPolicy = configv1.CredentialSharingPolicy(in.CredentialSharingPolicy)
+	out.ProbeImage = in.ProbeImage
+	out.RequiredForNodeReady = in.RequiredForNodeReady
+	out.CacheKeyTemplate = in.CacheKeyTemplate
+	out.Runtime = configv1.CredentialProviderRuntime(in.Runtime)
+	out.BatchRequests = in.BatchRequests
+	out.ImageTransforms = *(*[]configv1.ImageTransform)(unsafe.Pointer(&in.ImageTransforms))
 	return nil
 }
 
@@ -115,6 +157,9 @@ func Convert_config_CredentialProvider_To_v1_CredentialProvider(in *config.Crede
 
 func autoConvert_v1_CredentialProviderConfig_To_config_CredentialProviderConfig(in *configv1.CredentialProviderConfig, out *config.CredentialProviderConfig, s conversion.Scope) error {
 	out.Providers = *(*[]config.CredentialProvider)(unsafe.Pointer(&in.Providers))
+	out.Pins = *(*[]config.CredentialProviderPin)(unsafe.Pointer(&in.Pins))
+	out.Defaults = (*config.CredentialProviderConfigDefaults)(unsafe.Pointer(in.Defaults))
+	out.DisableLegacyKeyring = in.DisableLegacyKeyring
 	return nil
 }
 
@@ -125,6 +170,9 @@ func Convert_v1_CredentialProviderConfig_To_config_CredentialProviderConfig(in *
 
 func autoConvert_config_CredentialProviderConfig_To_v1_CredentialProviderConfig(in *config.CredentialProviderConfig, out *configv1.CredentialProviderConfig, s conversion.Scope) error {
 	out.Providers = *(*[]configv1.CredentialProvider)(unsafe.Pointer(&in.Providers))
+	out.Pins = *(*[]configv1.CredentialProviderPin)(unsafe.Pointer(&in.Pins))
+	out.Defaults = (*configv1.CredentialProviderConfigDefaults)(unsafe.Pointer(in.Defaults))
+	out.DisableLegacyKeyring = in.DisableLegacyKeyring
 	return nil
 }
 
@@ -157,9 +205,13 @@ func Convert_config_ExecEnvVar_To_v1_ExecEnvVar(in *config.ExecEnvVar, out *conf
 
 func autoConvert_v1_ServiceAccountTokenAttributes_To_config_ServiceAccountTokenAttributes(in *configv1.ServiceAccountTokenAttributes, out *config.ServiceAccountTokenAttributes, s conversion.Scope) error {
 	out.ServiceAccountTokenAudience = in.ServiceAccountTokenAudience
+	out.ServiceAccountTokenAudiences = *(*[]string)(unsafe.Pointer(&in.ServiceAccountTokenAudiences))
 	out.RequireServiceAccount = (*bool)(unsafe.Pointer(in.RequireServiceAccount))
 	out.RequiredServiceAccountAnnotationKeys = *(*[]string)(unsafe.Pointer(&in.RequiredServiceAccountAnnotationKeys))
 	out.OptionalServiceAccountAnnotationKeys = *(*[]string)(unsafe.Pointer(&in.OptionalServiceAccountAnnotationKeys))
+	out.AllowedNamespaces = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaces))
+	out.AllowedServiceAccountNames = *(*[]string)(unsafe.Pointer(&in.AllowedServiceAccountNames))
+	out.TokenExpirySlack = (*metav1.Duration)(unsafe.Pointer(in.TokenExpirySlack))
 	return nil
 }
 
@@ -170,9 +222,13 @@ func Convert_v1_ServiceAccountTokenAttributes_To_config_ServiceAccountTokenAttri
 
 func autoConvert_config_ServiceAccountTokenAttributes_To_v1_ServiceAccountTokenAttributes(in *config.ServiceAccountTokenAttributes, out *configv1.ServiceAccountTokenAttributes, s conversion.Scope) error {
 	out.ServiceAccountTokenAudience = in.ServiceAccountTokenAudience
+	out.ServiceAccountTokenAudiences = *(*[]string)(unsafe.Pointer(&in.ServiceAccountTokenAudiences))
 	out.RequireServiceAccount = (*bool)(unsafe.Pointer(in.RequireServiceAccount))
 	out.RequiredServiceAccountAnnotationKeys = *(*[]string)(unsafe.Pointer(&in.RequiredServiceAccountAnnotationKeys))
 	out.OptionalServiceAccountAnnotationKeys = *(*[]string)(unsafe.Pointer(&in.OptionalServiceAccountAnnotationKeys))
+	out.AllowedNamespaces = *(*[]string)(unsafe.Pointer(&in.AllowedNamespaces))
+	out.AllowedServiceAccountNames = *(*[]string)(unsafe.Pointer(&in.AllowedServiceAccountNames))
+	out.TokenExpirySlack = (*metav1.Duration)(unsafe.Pointer(in.TokenExpirySlack))
 	return nil
 }
 
@@ -26,3 +26,8 @@ func Convert_config_CredentialProvider_To_v1alpha1_CredentialProvider(in *config
 	// This conversion intentionally omits the tokenAttributes field which is only supported in v1 CredentialProvider.
 	return autoConvert_config_CredentialProvider_To_v1alpha1_CredentialProvider(in, out, s)
 }
+
+func Convert_config_CredentialProviderConfig_To_v1alpha1_CredentialProviderConfig(in *config.CredentialProviderConfig, out *configv1alpha1.CredentialProviderConfig, s conversion.Scope) error {
+	// This conversion intentionally omits the pins field which is only supported in v1 CredentialProviderConfig.
+	return autoConvert_config_CredentialProviderConfig_To_v1alpha1_CredentialProviderConfig(in, out, s)
+}
@@ -48,11 +48,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*config.CredentialProviderConfig)(nil), (*configv1alpha1.CredentialProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_config_CredentialProviderConfig_To_v1alpha1_CredentialProviderConfig(a.(*config.CredentialProviderConfig), b.(*configv1alpha1.CredentialProviderConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*configv1alpha1.ExecEnvVar)(nil), (*config.ExecEnvVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExecEnvVar_To_config_ExecEnvVar(a.(*configv1alpha1.ExecEnvVar), b.(*config.ExecEnvVar), scope)
 	}); err != nil {
@@ -73,6 +68,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*configv1alpha1.ImagePullExternalCredentialProvider)(nil), (*config.ImagePullExternalCredentialProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImagePullExternalCredentialProvider_To_config_ImagePullExternalCredentialProvider(a.(*configv1alpha1.ImagePullExternalCredentialProvider), b.(*config.ImagePullExternalCredentialProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImagePullExternalCredentialProvider)(nil), (*configv1alpha1.ImagePullExternalCredentialProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImagePullExternalCredentialProvider_To_v1alpha1_ImagePullExternalCredentialProvider(a.(*config.ImagePullExternalCredentialProvider), b.(*configv1alpha1.ImagePullExternalCredentialProvider), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*configv1alpha1.ImagePullIntent)(nil), (*config.ImagePullIntent)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ImagePullIntent_To_config_ImagePullIntent(a.(*configv1alpha1.ImagePullIntent), b.(*config.ImagePullIntent), scope)
 	}); err != nil {
@@ -108,6 +113,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*config.CredentialProviderConfig)(nil), (*configv1alpha1.CredentialProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CredentialProviderConfig_To_v1alpha1_CredentialProviderConfig(a.(*config.CredentialProviderConfig), b.(*configv1alpha1.CredentialProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -134,6 +144,27 @@ func autoConvert_config_CredentialProvider_To_v1alpha1_CredentialProvider(in *co
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
 	out.Env = *(*[]configv1alpha1.ExecEnvVar)(unsafe.Pointer(&in.Env))
 	// WARNING: in.TokenAttributes requires manual conversion: does not exist in peer-type
+	// WARNING: in.Command requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReuseProcess requires manual conversion: does not exist in peer-type
+	// WARNING: in.TmpDir requires manual conversion: does not exist in peer-type
+	// WARNING: in.BinaryByPlatform requires manual conversion: does not exist in peer-type
+	// WARNING: in.TolerateUnknownResponseFields requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeAttributes requires manual conversion: does not exist in peer-type
+	// WARNING: in.RefreshAheadPercent requires manual conversion: does not exist in peer-type
+	// WARNING: in.CacheJitterPercent requires manual conversion: does not exist in peer-type
+	// WARNING: in.RequiredPodAnnotationKeys requires manual conversion: does not exist in peer-type
+	// WARNING: in.OptionalPodAnnotationKeys requires manual conversion: does not exist in peer-type
+	// WARNING: in.Override requires manual conversion: does not exist in peer-type
+	// WARNING: in.MetricsLabels requires manual conversion: does not exist in peer-type
+	// WARNING: in.Isolation requires manual conversion: does not exist in peer-type
+	// WARNING: in.SystemdScope requires manual conversion: does not exist in peer-type
+	// WARNING: in.CredentialSharingPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.ProbeImage requires manual conversion: does not exist in peer-type
+	// WARNING: in.RequiredForNodeReady requires manual conversion: does not exist in peer-type
+	// WARNING: in.CacheKeyTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.Runtime requires manual conversion: does not exist in peer-type
+	// WARNING: in.BatchRequests requires manual conversion: does not exist in peer-type
+	// WARNING: in.ImageTransforms requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -169,14 +200,12 @@ func autoConvert_config_CredentialProviderConfig_To_v1alpha1_CredentialProviderC
 	} else {
 		out.Providers = nil
 	}
+	// WARNING: in.Pins requires manual conversion: does not exist in peer-type
+	// WARNING: in.Defaults requires manual conversion: does not exist in peer-type
+	// WARNING: in.DisableLegacyKeyring requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_config_CredentialProviderConfig_To_v1alpha1_CredentialProviderConfig is an autogenerated conversion function.
-func Convert_config_CredentialProviderConfig_To_v1alpha1_CredentialProviderConfig(in *config.CredentialProviderConfig, out *configv1alpha1.CredentialProviderConfig, s conversion.Scope) error {
-	return autoConvert_config_CredentialProviderConfig_To_v1alpha1_CredentialProviderConfig(in, out, s)
-}
-
 func autoConvert_v1alpha1_ExecEnvVar_To_config_ExecEnvVar(in *configv1alpha1.ExecEnvVar, out *config.ExecEnvVar, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Value = in.Value
@@ -202,6 +231,7 @@ func Convert_config_ExecEnvVar_To_v1alpha1_ExecEnvVar(in *config.ExecEnvVar, out
 func autoConvert_v1alpha1_ImagePullCredentials_To_config_ImagePullCredentials(in *configv1alpha1.ImagePullCredentials, out *config.ImagePullCredentials, s conversion.Scope) error {
 	out.KubernetesSecrets = *(*[]config.ImagePullSecret)(unsafe.Pointer(&in.KubernetesSecrets))
 	out.NodePodsAccessible = in.NodePodsAccessible
+	out.ExternalCredentialProviders = *(*[]config.ImagePullExternalCredentialProvider)(unsafe.Pointer(&in.ExternalCredentialProviders))
 	return nil
 }
 
@@ -213,6 +243,7 @@ func Convert_v1alpha1_ImagePullCredentials_To_config_ImagePullCredentials(in *co
 func autoConvert_config_ImagePullCredentials_To_v1alpha1_ImagePullCredentials(in *config.ImagePullCredentials, out *configv1alpha1.ImagePullCredentials, s conversion.Scope) error {
 	out.KubernetesSecrets = *(*[]configv1alpha1.ImagePullSecret)(unsafe.Pointer(&in.KubernetesSecrets))
 	out.NodePodsAccessible = in.NodePodsAccessible
+	out.ExternalCredentialProviders = *(*[]configv1alpha1.ImagePullExternalCredentialProvider)(unsafe.Pointer(&in.ExternalCredentialProviders))
 	return nil
 }
 
@@ -221,6 +252,34 @@ func Convert_config_ImagePullCredentials_To_v1alpha1_ImagePullCredentials(in *co
 	return autoConvert_config_ImagePullCredentials_To_v1alpha1_ImagePullCredentials(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImagePullExternalCredentialProvider_To_config_ImagePullExternalCredentialProvider(in *configv1alpha1.ImagePullExternalCredentialProvider, out *config.ImagePullExternalCredentialProvider, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.UID = in.UID
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.CredentialHash = in.CredentialHash
+	return nil
+}
+
+// Convert_v1alpha1_ImagePullExternalCredentialProvider_To_config_ImagePullExternalCredentialProvider is an autogenerated conversion function.
+func Convert_v1alpha1_ImagePullExternalCredentialProvider_To_config_ImagePullExternalCredentialProvider(in *configv1alpha1.ImagePullExternalCredentialProvider, out *config.ImagePullExternalCredentialProvider, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImagePullExternalCredentialProvider_To_config_ImagePullExternalCredentialProvider(in, out, s)
+}
+
+func autoConvert_config_ImagePullExternalCredentialProvider_To_v1alpha1_ImagePullExternalCredentialProvider(in *config.ImagePullExternalCredentialProvider, out *configv1alpha1.ImagePullExternalCredentialProvider, s conversion.Scope) error {
+	out.Provider = in.Provider
+	out.UID = in.UID
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.CredentialHash = in.CredentialHash
+	return nil
+}
+
+// Convert_config_ImagePullExternalCredentialProvider_To_v1alpha1_ImagePullExternalCredentialProvider is an autogenerated conversion function.
+func Convert_config_ImagePullExternalCredentialProvider_To_v1alpha1_ImagePullExternalCredentialProvider(in *config.ImagePullExternalCredentialProvider, out *configv1alpha1.ImagePullExternalCredentialProvider, s conversion.Scope) error {
+	return autoConvert_config_ImagePullExternalCredentialProvider_To_v1alpha1_ImagePullExternalCredentialProvider(in, out, s)
+}
+
 func autoConvert_v1alpha1_ImagePullIntent_To_config_ImagePullIntent(in *configv1alpha1.ImagePullIntent, out *config.ImagePullIntent, s conversion.Scope) error {
 	out.Image = in.Image
 	return nil
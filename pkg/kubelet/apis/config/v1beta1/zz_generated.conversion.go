@@ -60,11 +60,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*config.CredentialProviderConfig)(nil), (*configv1beta1.CredentialProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_config_CredentialProviderConfig_To_v1beta1_CredentialProviderConfig(a.(*config.CredentialProviderConfig), b.(*configv1beta1.CredentialProviderConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*configv1beta1.ExecEnvVar)(nil), (*config.ExecEnvVar)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExecEnvVar_To_config_ExecEnvVar(a.(*configv1beta1.ExecEnvVar), b.(*config.ExecEnvVar), scope)
 	}); err != nil {
@@ -200,6 +195,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*config.CredentialProviderConfig)(nil), (*configv1beta1.CredentialProviderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CredentialProviderConfig_To_v1beta1_CredentialProviderConfig(a.(*config.CredentialProviderConfig), b.(*configv1beta1.CredentialProviderConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -246,6 +246,27 @@ func autoConvert_config_CredentialProvider_To_v1beta1_CredentialProvider(in *con
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
 	out.Env = *(*[]configv1beta1.ExecEnvVar)(unsafe.Pointer(&in.Env))
 	// WARNING: in.TokenAttributes requires manual conversion: does not exist in peer-type
+	// WARNING: in.Command requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReuseProcess requires manual conversion: does not exist in peer-type
+	// WARNING: in.TmpDir requires manual conversion: does not exist in peer-type
+	// WARNING: in.BinaryByPlatform requires manual conversion: does not exist in peer-type
+	// WARNING: in.TolerateUnknownResponseFields requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeAttributes requires manual conversion: does not exist in peer-type
+	// WARNING: in.RefreshAheadPercent requires manual conversion: does not exist in peer-type
+	// WARNING: in.CacheJitterPercent requires manual conversion: does not exist in peer-type
+	// WARNING: in.RequiredPodAnnotationKeys requires manual conversion: does not exist in peer-type
+	// WARNING: in.OptionalPodAnnotationKeys requires manual conversion: does not exist in peer-type
+	// WARNING: in.Override requires manual conversion: does not exist in peer-type
+	// WARNING: in.MetricsLabels requires manual conversion: does not exist in peer-type
+	// WARNING: in.Isolation requires manual conversion: does not exist in peer-type
+	// WARNING: in.SystemdScope requires manual conversion: does not exist in peer-type
+	// WARNING: in.CredentialSharingPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.ProbeImage requires manual conversion: does not exist in peer-type
+	// WARNING: in.RequiredForNodeReady requires manual conversion: does not exist in peer-type
+	// WARNING: in.CacheKeyTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.Runtime requires manual conversion: does not exist in peer-type
+	// WARNING: in.BatchRequests requires manual conversion: does not exist in peer-type
+	// WARNING: in.ImageTransforms requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -281,14 +302,12 @@ func autoConvert_config_CredentialProviderConfig_To_v1beta1_CredentialProviderCo
 	} else {
 		out.Providers = nil
 	}
+	// WARNING: in.Pins requires manual conversion: does not exist in peer-type
+	// WARNING: in.Defaults requires manual conversion: does not exist in peer-type
+	// WARNING: in.DisableLegacyKeyring requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_config_CredentialProviderConfig_To_v1beta1_CredentialProviderConfig is an autogenerated conversion function.
-func Convert_config_CredentialProviderConfig_To_v1beta1_CredentialProviderConfig(in *config.CredentialProviderConfig, out *configv1beta1.CredentialProviderConfig, s conversion.Scope) error {
-	return autoConvert_config_CredentialProviderConfig_To_v1beta1_CredentialProviderConfig(in, out, s)
-}
-
 func autoConvert_v1beta1_ExecEnvVar_To_config_ExecEnvVar(in *configv1beta1.ExecEnvVar, out *config.ExecEnvVar, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Value = in.Value
@@ -429,6 +448,7 @@ func autoConvert_v1beta1_KubeletConfiguration_To_config_KubeletConfiguration(in
 	out.RegistryBurst = in.RegistryBurst
 	out.ImagePullCredentialsVerificationPolicy = string(in.ImagePullCredentialsVerificationPolicy)
 	out.PreloadedImagesVerificationAllowlist = *(*[]string)(unsafe.Pointer(&in.PreloadedImagesVerificationAllowlist))
+	out.CredentialSourceOrder = *(*[]string)(unsafe.Pointer(&in.CredentialSourceOrder))
 	if err := v1.Convert_Pointer_int32_To_int32(&in.EventRecordQPS, &out.EventRecordQPS, s); err != nil {
 		return err
 	}
@@ -452,6 +472,7 @@ func autoConvert_v1beta1_KubeletConfiguration_To_config_KubeletConfiguration(in
 	out.NodeLeaseDurationSeconds = in.NodeLeaseDurationSeconds
 	out.ImageMinimumGCAge = in.ImageMinimumGCAge
 	out.ImageMaximumGCAge = in.ImageMaximumGCAge
+	out.InvalidateCredentialsOnImageGC = in.InvalidateCredentialsOnImageGC
 	if err := v1.Convert_Pointer_int32_To_int32(&in.ImageGCHighThresholdPercent, &out.ImageGCHighThresholdPercent, s); err != nil {
 		return err
 	}
@@ -595,6 +616,17 @@ func autoConvert_v1beta1_KubeletConfiguration_To_config_KubeletConfiguration(in
 		return err
 	}
 	out.UserNamespaces = (*config.UserNamespaces)(unsafe.Pointer(in.UserNamespaces))
+	if in.CredentialProviders != nil {
+		in, out := &in.CredentialProviders, &out.CredentialProviders
+		*out = make([]config.CredentialProvider, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_CredentialProvider_To_config_CredentialProvider(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CredentialProviders = nil
+	}
 	return nil
 }
 
@@ -637,6 +669,7 @@ func autoConvert_config_KubeletConfiguration_To_v1beta1_KubeletConfiguration(in
 	out.RegistryBurst = in.RegistryBurst
 	out.ImagePullCredentialsVerificationPolicy = configv1beta1.ImagePullCredentialsVerificationPolicy(in.ImagePullCredentialsVerificationPolicy)
 	out.PreloadedImagesVerificationAllowlist = *(*[]string)(unsafe.Pointer(&in.PreloadedImagesVerificationAllowlist))
+	out.CredentialSourceOrder = *(*[]string)(unsafe.Pointer(&in.CredentialSourceOrder))
 	if err := v1.Convert_int32_To_Pointer_int32(&in.EventRecordQPS, &out.EventRecordQPS, s); err != nil {
 		return err
 	}
@@ -660,6 +693,7 @@ func autoConvert_config_KubeletConfiguration_To_v1beta1_KubeletConfiguration(in
 	out.NodeLeaseDurationSeconds = in.NodeLeaseDurationSeconds
 	out.ImageMinimumGCAge = in.ImageMinimumGCAge
 	out.ImageMaximumGCAge = in.ImageMaximumGCAge
+	out.InvalidateCredentialsOnImageGC = in.InvalidateCredentialsOnImageGC
 	if err := v1.Convert_int32_To_Pointer_int32(&in.ImageGCHighThresholdPercent, &out.ImageGCHighThresholdPercent, s); err != nil {
 		return err
 	}
@@ -801,6 +835,17 @@ func autoConvert_config_KubeletConfiguration_To_v1beta1_KubeletConfiguration(in
 		return err
 	}
 	out.UserNamespaces = (*configv1beta1.UserNamespaces)(unsafe.Pointer(in.UserNamespaces))
+	if in.CredentialProviders != nil {
+		in, out := &in.CredentialProviders, &out.CredentialProviders
+		*out = make([]configv1beta1.CredentialProvider, len(*in))
+		for i := range *in {
+			if err := Convert_config_CredentialProvider_To_v1beta1_CredentialProvider(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CredentialProviders = nil
+	}
 	return nil
 }
 
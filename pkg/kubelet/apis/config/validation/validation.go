@@ -313,6 +313,22 @@ func ValidateKubeletConfiguration(kc *kubeletconfig.KubeletConfiguration, featur
 		}
 	}
 
+	seenCredentialSources := map[string]bool{}
+	for _, source := range kc.CredentialSourceOrder {
+		switch kubeletconfig.CredentialSource(source) {
+		case kubeletconfig.PodImagePullSecretsSource,
+			kubeletconfig.NodeDockerConfigSource,
+			kubeletconfig.ExternalCredentialProvidersSource:
+		default:
+			allErrors = append(allErrors, fmt.Errorf("invalid configuration: option %q specified for credentialSourceOrder. Valid options are %q, %q or %q",
+				source, kubeletconfig.PodImagePullSecretsSource, kubeletconfig.NodeDockerConfigSource, kubeletconfig.ExternalCredentialProvidersSource))
+		}
+		if seenCredentialSources[source] {
+			allErrors = append(allErrors, fmt.Errorf("invalid configuration: duplicate entry %q in credentialSourceOrder", source))
+		}
+		seenCredentialSources[source] = true
+	}
+
 	if kc.ReservedSystemCPUs != "" {
 		// --reserved-cpus does not support --system-reserved-cgroup or --kube-reserved-cgroup
 		if kc.SystemReservedCgroup != "" || kc.KubeReservedCgroup != "" {
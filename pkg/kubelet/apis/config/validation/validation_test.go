@@ -750,6 +750,20 @@ func TestValidateKubeletConfiguration(t *testing.T) {
 				return conf
 			},
 			errMsg: "invalid configuration: `preloadedImagesVerificationAllowlist` must not be set if KubeletEnsureSecretPulledImages feature gate is not enabled",
+		}, {
+			name: "invalid credentialSourceOrder entry",
+			configure: func(conf *kubeletconfig.KubeletConfiguration) *kubeletconfig.KubeletConfiguration {
+				conf.CredentialSourceOrder = []string{"invalid"}
+				return conf
+			},
+			errMsg: `option "invalid" specified for credentialSourceOrder. Valid options are "PodImagePullSecrets", "NodeDockerConfig" or "ExternalCredentialProviders"`,
+		}, {
+			name: "duplicate credentialSourceOrder entry",
+			configure: func(conf *kubeletconfig.KubeletConfiguration) *kubeletconfig.KubeletConfiguration {
+				conf.CredentialSourceOrder = []string{"PodImagePullSecrets", "PodImagePullSecrets"}
+				return conf
+			},
+			errMsg: `duplicate entry "PodImagePullSecrets" in credentialSourceOrder`,
 		}, {
 			name: "invalid FeatureGate",
 			configure: func(conf *kubeletconfig.KubeletConfiguration) *kubeletconfig.KubeletConfiguration {
@@ -18,6 +18,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/pflag"
 )
@@ -40,11 +41,35 @@ type ContainerRuntimeOptions struct {
 	// to execute and what container images the plugin should be called for.
 	// +optional
 	ImageCredentialProviderConfigPath string
+	// ImageCredentialProviderConfigSHA256 is the hex-encoded SHA256 checksum the
+	// credential provider config must match when ImageCredentialProviderConfigPath
+	// is an https:// URL. Remote configs are only loaded when they verify against
+	// this pin.
+	// +optional
+	ImageCredentialProviderConfigSHA256 string
 	// ImageCredentialProviderBinDir is the path to the directory where credential provider plugin
 	// binaries exist. The name of each plugin binary is expected to match the name of the plugin
 	// specified in imageCredentialProviderConfigFile.
 	// +optional
 	ImageCredentialProviderBinDir string
+	// ImageCredentialProviderPublishConfigHash enables publishing a hash of the loaded
+	// credential provider configuration (provider names and apiVersions, no secrets) as a
+	// node annotation, so fleet-management controllers can verify the rollout of provider
+	// configuration across nodes.
+	// +optional
+	ImageCredentialProviderPublishConfigHash bool
+	// ImageCredentialProviderConfigStrict controls how invalid files are handled when
+	// ImageCredentialProviderConfigPath points to a directory. When true (the default),
+	// any invalid file fails kubelet startup. When false, invalid files are skipped with
+	// an error metric and log message and the remaining valid providers are loaded.
+	// +optional
+	ImageCredentialProviderConfigStrict bool
+	// ImageCredentialProviderMaxCacheDuration is a hard upper bound on how long
+	// credentials returned by credential provider plugins are cached by the kubelet,
+	// regardless of the cache duration in the plugin response or the provider
+	// configuration. Zero (the default) disables the cap.
+	// +optional
+	ImageCredentialProviderMaxCacheDuration time.Duration
 }
 
 // AddFlags adds flags to the container runtime, according to ContainerRuntimeOptions.
@@ -55,6 +80,10 @@ func (s *ContainerRuntimeOptions) AddFlags(fs *pflag.FlagSet) {
 	_ = fs.MarkDeprecated("pod-infra-container-image", "will be removed in 1.35. Image garbage collector will get sandbox image information from CRI.")
 
 	// Image credential provider settings.
-	fs.StringVar(&s.ImageCredentialProviderConfigPath, "image-credential-provider-config", s.ImageCredentialProviderConfigPath, "Path to a credential provider plugin config file (JSON/YAML/YML) or a directory of such files (merged in lexicographical order; non-recursive search).")
+	fs.StringVar(&s.ImageCredentialProviderConfigPath, "image-credential-provider-config", s.ImageCredentialProviderConfigPath, "Path to a credential provider plugin config file (JSON/YAML/YML), a directory of such files (merged in lexicographical order; non-recursive search), or an https:// URL (requires --image-credential-provider-config-sha256).")
+	fs.StringVar(&s.ImageCredentialProviderConfigSHA256, "image-credential-provider-config-sha256", s.ImageCredentialProviderConfigSHA256, "Hex-encoded SHA256 checksum the credential provider config must match when --image-credential-provider-config is an https:// URL.")
 	fs.StringVar(&s.ImageCredentialProviderBinDir, "image-credential-provider-bin-dir", s.ImageCredentialProviderBinDir, "The path to the directory where credential provider plugin binaries are located.")
+	fs.BoolVar(&s.ImageCredentialProviderPublishConfigHash, "image-credential-provider-publish-config-hash", s.ImageCredentialProviderPublishConfigHash, "Publish a hash of the loaded credential provider configuration as a node annotation. The hash covers provider names and apiVersions only and contains no secrets.")
+	fs.BoolVar(&s.ImageCredentialProviderConfigStrict, "image-credential-provider-config-strict", s.ImageCredentialProviderConfigStrict, "If false and --image-credential-provider-config points to a directory, invalid config files in the directory are skipped with an error instead of failing kubelet startup.")
+	fs.DurationVar(&s.ImageCredentialProviderMaxCacheDuration, "image-credential-provider-max-cache-duration", s.ImageCredentialProviderMaxCacheDuration, "Hard upper bound on how long credentials returned by credential provider plugins are cached, regardless of the cache duration in the plugin response or the provider configuration. 0 disables the cap.")
 }
@@ -46,6 +46,9 @@ const (
 	FailedToInspectImage    = "InspectFailed"
 	ErrImageNeverPullPolicy = "ErrImageNeverPull"
 	BackOffPullImage        = "BackOff"
+	// BackOffCredentialProvider distinguishes pull failures attributed to a
+	// failing credential provider plugin from ordinary image pull backoff.
+	BackOffCredentialProvider = "CredentialProviderBackOff"
 )
 
 // kubelet event reason list
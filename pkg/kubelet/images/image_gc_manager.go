@@ -36,6 +36,7 @@ import (
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/record"
 	statsapi "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+	credentialproviderplugin "k8s.io/kubernetes/pkg/credentialprovider/plugin"
 	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/events"
@@ -103,6 +104,11 @@ type ImageGCPolicy struct {
 	// Currently gated by MaximumImageGCAge feature gate and Kubelet configuration.
 	// If 0, the feature is disabled.
 	MaxAge time.Duration
+
+	// InvalidateCredentials controls whether cached image pull credentials from
+	// external credential provider plugins are evicted for a registry once the
+	// last image from that registry has been garbage collected.
+	InvalidateCredentials bool
 }
 
 type realImageGCManager struct {
@@ -135,6 +141,11 @@ type realImageGCManager struct {
 
 	postGCHooks []PostImageGCHook
 
+	// invalidateCredentials evicts cached image pull credentials for a registry
+	// once the last image from that registry has been garbage collected. Only
+	// set when the policy opts in via InvalidateCredentials.
+	invalidateCredentials func(registry string) error
+
 	// tracer for recording spans
 	tracer trace.Tracer
 }
@@ -185,6 +196,10 @@ type imageRecord struct {
 
 	// Pinned status of the image
 	pinned bool
+
+	// Registries the image's known names point at, used to evict cached
+	// credentials for registries whose images have all been garbage collected.
+	registries sets.Set[string]
 }
 
 // NewImageGCManager instantiates a new ImageGCManager object.
@@ -210,6 +225,11 @@ func NewImageGCManager(runtime container.Runtime, statsProvider StatsProvider, p
 		postGCHooks:   postGCHooks,
 		tracer:        tracer,
 	}
+	if policy.InvalidateCredentials {
+		im.invalidateCredentials = func(registry string) error {
+			return credentialproviderplugin.InvalidateCache("", registry)
+		}
+	}
 
 	return im, nil
 }
@@ -307,6 +327,8 @@ func (im *realImageGCManager) detectImages(ctx context.Context, detectTime time.
 
 		klog.V(5).InfoS("Image ID is pinned", "imageID", imageKey, "pinned", image.Pinned)
 		im.imageRecords[imageKey].pinned = image.Pinned
+
+		im.imageRecords[imageKey].registries = imageRegistries(image)
 	}
 
 	// Remove old images from our records.
@@ -527,9 +549,52 @@ func (im *realImageGCManager) freeImage(ctx context.Context, image evictionInfo,
 	im.imageRecordsLock.Unlock()
 
 	metrics.ImageGarbageCollectedTotal.WithLabelValues(reason).Inc()
+
+	im.maybeInvalidateCredentials(image.registries)
+
 	return err
 }
 
+// maybeInvalidateCredentials evicts cached plugin credentials for any of the
+// given registries that no longer have images on the node, freeing memory and
+// ensuring the next pull after a long idle period fetches fresh credentials.
+// It is a no-op unless the policy opted in via InvalidateCredentials.
+func (im *realImageGCManager) maybeInvalidateCredentials(registries sets.Set[string]) {
+	if im.invalidateCredentials == nil || registries.Len() == 0 {
+		return
+	}
+
+	im.imageRecordsLock.Lock()
+	remainingRegistries := sets.New[string]()
+	for _, record := range im.imageRecords {
+		remainingRegistries = remainingRegistries.Union(record.registries)
+	}
+	im.imageRecordsLock.Unlock()
+
+	for _, registry := range sets.List(registries.Difference(remainingRegistries)) {
+		if err := im.invalidateCredentials(registry); err != nil {
+			klog.ErrorS(err, "Failed to evict cached registry credentials after image garbage collection", "registry", registry)
+			continue
+		}
+		klog.V(2).InfoS("Evicted cached registry credentials after image garbage collection", "registry", registry)
+	}
+}
+
+// imageRegistries returns the registry hostnames (including port if specified)
+// of every name the image is known by. Names without a registry component are
+// skipped.
+func imageRegistries(image container.Image) sets.Set[string] {
+	registries := sets.New[string]()
+	for _, names := range [][]string{image.RepoTags, image.RepoDigests} {
+		for _, name := range names {
+			if registry, _, ok := strings.Cut(name, "/"); ok {
+				registries.Insert(registry)
+			}
+		}
+	}
+	return registries
+}
+
 // Queries all of the image records and arranges them in a slice of evictionInfo, sorted based on last time used, ignoring images pinned by the runtime.
 func (im *realImageGCManager) imagesInEvictionOrder(ctx context.Context, freeTime time.Time) ([]evictionInfo, error) {
 	isRuntimeClassInImageCriAPIEnabled := utilfeature.DefaultFeatureGate.Enabled(features.RuntimeClassInImageCriAPI)
@@ -497,6 +497,42 @@ func TestDeleteUnusedImagesRemoveAllUnusedImages(t *testing.T) {
 	assert.Len(fakeRuntime.ImageList, 1)
 }
 
+func TestDeleteUnusedImagesInvalidatesCredentialsForRemovedRegistries(t *testing.T) {
+	ctx := context.Background()
+	mockStatsProvider := statstest.NewMockProvider(t)
+
+	manager, fakeRuntime := newRealImageGCManager(ImageGCPolicy{}, mockStatsProvider)
+	var invalidated []string
+	manager.invalidateCredentials = func(registry string) error {
+		invalidated = append(invalidated, registry)
+		return nil
+	}
+
+	fakeRuntime.ImageList = []container.Image{
+		{ID: imageID(0), Size: 1024, RepoTags: []string{"test.registry.io/foo:latest"}},
+		{ID: imageID(1), Size: 2048, RepoTags: []string{"test.registry.io/bar:latest"}},
+		{ID: imageID(2), Size: 2048, RepoTags: []string{"other.registry.io/baz:latest"}},
+	}
+	// Image 1 is in use, so test.registry.io still has an image on the node after
+	// garbage collection and only other.registry.io's credentials are evicted.
+	fakeRuntime.AllPodList = []*containertest.FakePod{
+		{Pod: &container.Pod{
+			Containers: []*container.Container{
+				makeContainer(1),
+			},
+		}},
+	}
+
+	require.NoError(t, manager.DeleteUnusedImages(ctx))
+	assert.Equal(t, []string{"other.registry.io"}, invalidated)
+
+	// Once the last image from test.registry.io is garbage collected, its
+	// credentials are evicted too.
+	fakeRuntime.AllPodList = nil
+	require.NoError(t, manager.DeleteUnusedImages(ctx))
+	assert.Equal(t, []string{"other.registry.io", "test.registry.io"}, invalidated)
+}
+
 func TestDeleteUnusedImagesLimitByImageLiveTime(t *testing.T) {
 	ctx := context.Background()
 	mockStatsProvider := statstest.NewMockProvider(t)
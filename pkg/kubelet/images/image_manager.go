@@ -25,6 +25,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/flowcontrol"
@@ -61,6 +62,10 @@ type imageManager struct {
 	puller      imagePuller
 	nodeKeyring credentialprovider.DockerKeyring
 
+	// credentialSourceOrder defines the order in which credential sources are consulted
+	// for image pulls. An empty order preserves the historical ordering.
+	credentialSourceOrder []string
+
 	podPullingTimeRecorder ImagePodPullingTimeRecorder
 }
 
@@ -78,6 +83,7 @@ func NewImageManager(
 	qps float32,
 	burst int,
 	podPullingTimeRecorder ImagePodPullingTimeRecorder,
+	credentialSourceOrder []string,
 ) ImageManager {
 
 	imageService = throttleImagePulling(imageService, qps, burst)
@@ -96,6 +102,29 @@ func NewImageManager(
 		backOff:                imageBackOff,
 		puller:                 puller,
 		podPullingTimeRecorder: podPullingTimeRecorder,
+		credentialSourceOrder:  credentialSourceOrder,
+	}
+}
+
+// maxCredentialResolutionDelay bounds how long the start of an image pull is
+// delayed while credential provider plugin invocations are saturated.
+const maxCredentialResolutionDelay = 30 * time.Second
+
+// delayPullWhileCredentialResolutionSaturated waits, bounded, for the
+// credential provider plugin invocation queue to clear before a new pull
+// starts. When the bound is hit the pull proceeds anyway; the delay only
+// spreads out credential resolution, it never fails a pull.
+func (m *imageManager) delayPullWhileCredentialResolutionSaturated(ctx context.Context, image string) {
+	if !credentialproviderplugin.CredentialResolutionSaturated() {
+		return
+	}
+
+	metrics.ImagePullsDelayedByCredentialResolution.Inc()
+	klog.V(2).InfoS("Delaying image pull until credential resolution is no longer saturated", "image", image)
+	if err := wait.PollUntilContextTimeout(ctx, 250*time.Millisecond, maxCredentialResolutionDelay, false, func(context.Context) (bool, error) {
+		return !credentialproviderplugin.CredentialResolutionSaturated(), nil
+	}); err != nil {
+		klog.V(2).InfoS("Proceeding with image pull although credential resolution is still saturated", "image", image)
 	}
 }
 
@@ -146,6 +175,37 @@ func (m *imageManager) imageNotPresentOnNeverPolicyError(logPrefix string, objRe
 	return msg, ErrImageNeverPull
 }
 
+// makeKeyring assembles the keyring consulted for image pull credentials, honoring the
+// configured credential source order. An empty order preserves the kubelet's historical
+// ordering: pod imagePullSecrets first, then the node docker config, then the external
+// credential provider plugins.
+func (m *imageManager) makeKeyring(pullSecrets []v1.Secret, externalCredentialProviderKeyring credentialprovider.DockerKeyring) (credentialprovider.DockerKeyring, error) {
+	if len(m.credentialSourceOrder) == 0 {
+		return credentialprovidersecrets.MakeDockerKeyring(pullSecrets, credentialprovider.UnionDockerKeyring{m.nodeKeyring, externalCredentialProviderKeyring})
+	}
+
+	keyring := credentialprovider.UnionDockerKeyring{}
+	for _, source := range m.credentialSourceOrder {
+		switch kubeletconfiginternal.CredentialSource(source) {
+		case kubeletconfiginternal.PodImagePullSecretsSource:
+			secretsKeyring, err := credentialprovidersecrets.MakeDockerKeyring(pullSecrets, nil)
+			if err != nil {
+				return nil, err
+			}
+			keyring = append(keyring, secretsKeyring)
+		case kubeletconfiginternal.NodeDockerConfigSource:
+			keyring = append(keyring, m.nodeKeyring)
+		case kubeletconfiginternal.ExternalCredentialProvidersSource:
+			keyring = append(keyring, externalCredentialProviderKeyring)
+		default:
+			// unknown sources are rejected by kubelet config validation
+			klog.ErrorS(nil, "Ignoring unknown credential source", "source", source)
+		}
+	}
+
+	return keyring, nil
+}
+
 // EnsureImageExists pulls the image for the specified pod and requestedImage, and returns
 // (imageRef, error message, error).
 func (m *imageManager) EnsureImageExists(ctx context.Context, objRef *v1.ObjectReference, pod *v1.Pod, requestedImage string, pullSecrets []v1.Secret, podSandboxConfig *runtimeapi.PodSandboxConfig, podRuntimeHandler string, pullPolicy v1.PullPolicy) (imageRef, message string, err error) {
@@ -186,20 +246,30 @@ func (m *imageManager) EnsureImageExists(ctx context.Context, objRef *v1.ObjectR
 	// construct the dynamic keyring using the providers we have in the kubelet
 	var podName, podNamespace, podUID string
 	if utilfeature.DefaultFeatureGate.Enabled(features.KubeletServiceAccountTokenForCredentialProviders) {
-		sandboxMetadata := podSandboxConfig.GetMetadata()
-
-		podName = sandboxMetadata.Name
-		podNamespace = sandboxMetadata.Namespace
-		podUID = sandboxMetadata.Uid
+		podNamespace, podName, podUID = podIdentityForCredentialProviders(pod, podSandboxConfig)
 	}
 
+	// A mass pod placement onto a fresh node resolves credentials for many
+	// images at once. When the plugin invocation queue is saturated, delay
+	// starting the pull until it clears instead of letting registry tokens
+	// be minted mid-flight and time out.
+	m.delayPullWhileCredentialResolutionSaturated(ctx, requestedImage)
+
+	// The keyring gets the image reference as specified on the container, not
+	// repoToPull, so digest-pinned provider pins can match the exact reference.
+	// The pull's context is handed to the keyring so credential provider plugin
+	// invocations are bounded by the pull's deadline and cancellation.
 	externalCredentialProviderKeyring := credentialproviderplugin.NewExternalCredentialProviderDockerKeyring(
+		ctx,
+		spec.Image,
 		podNamespace,
 		podName,
 		podUID,
-		pod.Spec.ServiceAccountName)
+		pod.Spec.ServiceAccountName,
+		pod.Annotations,
+		podImagesForCredentialProviders(pod))
 
-	keyring, err := credentialprovidersecrets.MakeDockerKeyring(pullSecrets, credentialprovider.UnionDockerKeyring{m.nodeKeyring, externalCredentialProviderKeyring})
+	keyring, err := m.makeKeyring(pullSecrets, externalCredentialProviderKeyring)
 	if err != nil {
 		return "", err.Error(), err
 	}
@@ -242,10 +312,23 @@ func (m *imageManager) EnsureImageExists(ctx context.Context, objRef *v1.ObjectR
 		return "", msg, err
 	}
 
-	return m.pullImage(ctx, logPrefix, objRef, pod.UID, requestedImage, spec, pullCredentials, podSandboxConfig)
+	// Registry tokens can expire while a large image is still being pulled. If the
+	// pull fails with an authorization error, this re-resolves credentials for the
+	// registry with the credential provider plugin cache invalidated so the retry
+	// runs with freshly minted credentials.
+	reresolvePullCredentials := func() []credentialprovider.TrackedAuthConfig {
+		registry := strings.Split(repoToPull, "/")[0]
+		if err := credentialproviderplugin.InvalidateCache("", registry); err != nil {
+			klog.ErrorS(err, "Failed to invalidate credential provider cache", "registry", registry)
+		}
+		freshCredentials, _ := keyring.Lookup(repoToPull)
+		return freshCredentials
+	}
+
+	return m.pullImage(ctx, logPrefix, objRef, pod.UID, requestedImage, spec, pullCredentials, podSandboxConfig, reresolvePullCredentials)
 }
 
-func (m *imageManager) pullImage(ctx context.Context, logPrefix string, objRef *v1.ObjectReference, podUID types.UID, image string, imgSpec kubecontainer.ImageSpec, pullCredentials []credentialprovider.TrackedAuthConfig, podSandboxConfig *runtimeapi.PodSandboxConfig) (imageRef, message string, err error) {
+func (m *imageManager) pullImage(ctx context.Context, logPrefix string, objRef *v1.ObjectReference, podUID types.UID, image string, imgSpec kubecontainer.ImageSpec, pullCredentials []credentialprovider.TrackedAuthConfig, podSandboxConfig *runtimeapi.PodSandboxConfig, reresolvePullCredentials func() []credentialprovider.TrackedAuthConfig) (imageRef, message string, err error) {
 	var pullSucceeded bool
 	var finalPullCredentials *credentialprovider.TrackedAuthConfig
 
@@ -288,7 +371,30 @@ func (m *imageManager) pullImage(ctx context.Context, logPrefix string, objRef *
 	pullChan := make(chan pullResult)
 	m.puller.pullImage(ctx, imgSpec, pullCredentials, pullChan, podSandboxConfig)
 	imagePullResult := <-pullChan
+	if imagePullResult.err != nil && isRegistryAuthErr(imagePullResult.err) && reresolvePullCredentials != nil && len(pullCredentials) > 0 {
+		// The credentials may have expired while the image was being pulled, e.g. a
+		// short-lived registry token on a very large image. Re-resolve credentials
+		// and retry the pull once with the fresh set before declaring it failed.
+		if freshCredentials := reresolvePullCredentials(); len(freshCredentials) > 0 {
+			m.logIt(objRef, v1.EventTypeNormal, events.PullingImage, logPrefix, fmt.Sprintf("Retrying pull of image %q with refreshed registry credentials", image), klog.Info)
+			m.puller.pullImage(ctx, imgSpec, freshCredentials, pullChan, podSandboxConfig)
+			imagePullResult = <-pullChan
+		}
+	}
 	if imagePullResult.err != nil {
+		if isRegistryAuthErr(imagePullResult.err) && credentialproviderplugin.InCredentialProviderBackOff(image) {
+			// The registry rejected the credentials while the matching credential
+			// provider is itself backing off after failures, so the pull failure is
+			// attributed to credential resolution. The image pull backoff is left
+			// untouched: the pull should be retried as soon as the provider
+			// recovers, not after a backoff that kept doubling while the provider
+			// was the problem.
+			msg := fmt.Sprintf("Failed to resolve registry credentials for image %q: credential provider plugin is backing off after failures", image)
+			m.logIt(objRef, v1.EventTypeWarning, events.BackOffCredentialProvider, logPrefix, msg, klog.Warning)
+			m.prevPullErrMsg.Store(backOffKey, msg)
+			return "", msg, ErrImagePull
+		}
+
 		m.logIt(objRef, v1.EventTypeWarning, events.FailedToPullImage, logPrefix, fmt.Sprintf("Failed to pull image %q: %v", image, imagePullResult.err), klog.Warning)
 		m.backOff.Next(backOffKey, m.backOff.Clock.Now())
 		msg, err := evalCRIPullErr(image, imagePullResult.err)
@@ -303,7 +409,9 @@ func (m *imageManager) pullImage(ctx context.Context, logPrefix string, objRef *
 	imagePullDuration := time.Since(startTime).Truncate(time.Millisecond)
 	m.logIt(objRef, v1.EventTypeNormal, events.PulledImage, logPrefix, fmt.Sprintf("Successfully pulled image %q in %v (%v including waiting). Image size: %v bytes.",
 		image, imagePullResult.pullDuration.Truncate(time.Millisecond), imagePullDuration, imagePullResult.imageSize), klog.Info)
-	metrics.ImagePullDuration.WithLabelValues(metrics.GetImageSizeBucket(imagePullResult.imageSize)).Observe(imagePullDuration.Seconds())
+	// Observing through the context attaches a trace exemplar to the pull
+	// duration histogram when tracing is enabled and the pull's span is sampled.
+	metrics.ImagePullDuration.WithContext(ctx).WithLabelValues(metrics.GetImageSizeBucket(imagePullResult.imageSize)).Observe(imagePullDuration.Seconds())
 	m.backOff.GC()
 	finalPullCredentials = imagePullResult.credentialsUsed
 	pullSucceeded = true
@@ -311,6 +419,15 @@ func (m *imageManager) pullImage(ctx context.Context, logPrefix string, objRef *
 	return imagePullResult.imageRef, "", nil
 }
 
+// isRegistryAuthErr returns true if the image pull error indicates the registry
+// rejected the presented credentials. CRI runtimes do not surface a dedicated
+// error for this today, so this matches on the status text registries return
+// for expired or invalid credentials.
+func isRegistryAuthErr(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "unauthorized") || strings.Contains(msg, "authentication required")
+}
+
 func evalCRIPullErr(imgRef string, err error) (errMsg string, errRes error) {
 	// Error assertions via errors.Is is not supported by gRPC (remote runtime) errors right now.
 	// See https://github.com/grpc/grpc-go/issues/3616
@@ -342,6 +459,46 @@ func evalCRIPullErr(imgRef string, err error) (errMsg string, errRes error) {
 	return err.Error(), ErrImagePull
 }
 
+// podIdentityForCredentialProviders returns the pod identity passed to
+// service-account-aware credential provider plugins for an image pull. The
+// identity from the CRI sandbox config is preferred since it is what the
+// sandbox actually runs as, but pulls that happen without sandbox metadata —
+// such as pulls for user-namespaced pods, which the CRI can request before the
+// sandbox config exists — fall back to the pod object so token-scoped
+// credentials keep the correct pod identity instead of silently losing it.
+func podIdentityForCredentialProviders(pod *v1.Pod, podSandboxConfig *runtimeapi.PodSandboxConfig) (podNamespace, podName, podUID string) {
+	if sandboxMetadata := podSandboxConfig.GetMetadata(); sandboxMetadata != nil {
+		return sandboxMetadata.Namespace, sandboxMetadata.Name, sandboxMetadata.Uid
+	}
+	return pod.Namespace, pod.Name, string(pod.UID)
+}
+
+// podImagesForCredentialProviders returns the repositories of every image in
+// the pod's spec, in the same parsed form credential lookups use, so providers
+// configured with batchRequests can resolve credentials for all of a pod's
+// images in a single invocation. Images that fail to parse are skipped; their
+// own pulls surface the parse error.
+func podImagesForCredentialProviders(pod *v1.Pod) []string {
+	var podImages []string
+	appendImage := func(image string) {
+		repo, _, _, err := parsers.ParseImageName(image)
+		if err != nil {
+			return
+		}
+		podImages = append(podImages, repo)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		appendImage(container.Image)
+	}
+	for _, container := range pod.Spec.Containers {
+		appendImage(container.Image)
+	}
+	for _, container := range pod.Spec.EphemeralContainers {
+		appendImage(container.Image)
+	}
+	return podImages
+}
+
 // applyDefaultImageTag parses a docker image string, if it doesn't contain any tag or digest,
 // a default tag will be applied.
 func applyDefaultImageTag(image string) (string, error) {
@@ -366,6 +523,21 @@ func trackedToImagePullCreds(trackedCreds *credentialprovider.TrackedAuthConfig)
 	switch {
 	case trackedCreds == nil, trackedCreds.Source == nil:
 		ret.NodePodsAccessible = true
+	case trackedCreds.Source.Provider != "" || trackedCreds.Source.ServiceAccount != nil:
+		providerRecord := kubeletconfiginternal.ImagePullExternalCredentialProvider{
+			Provider:       trackedCreds.Source.Provider,
+			CredentialHash: trackedCreds.AuthConfigHash,
+		}
+		if sa := trackedCreds.Source.ServiceAccount; sa != nil {
+			providerRecord.UID = sa.UID
+			providerRecord.Namespace = sa.Namespace
+			providerRecord.Name = sa.Name
+		} else {
+			// The provider resolved the credentials without a pod identity
+			// scope, so they are accessible to every pod on the node.
+			ret.NodePodsAccessible = true
+		}
+		ret.ExternalCredentialProviders = []kubeletconfiginternal.ImagePullExternalCredentialProvider{providerRecord}
 	default:
 		sourceSecret := trackedCreds.Source.Secret
 		ret.KubernetesSecrets = []kubeletconfiginternal.ImagePullSecret{
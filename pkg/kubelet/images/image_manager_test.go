@@ -35,6 +35,7 @@ import (
 	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/component-base/featuregate"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
 	crierrors "k8s.io/cri-api/pkg/errors"
 	"k8s.io/kubernetes/pkg/controller/testutil"
 	"k8s.io/kubernetes/pkg/credentialprovider"
@@ -584,7 +585,7 @@ func pullerTestEnv(
 	for _, fg := range c.enableFeatures {
 		featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, fg, true)
 	}
-	puller = NewImageManager(fakeRecorder, &credentialprovider.BasicDockerKeyring{}, fakeRuntime, pullManager, backOff, serialized, maxParallelImagePulls, c.qps, c.burst, fakePodPullingTimeRecorder)
+	puller = NewImageManager(fakeRecorder, &credentialprovider.BasicDockerKeyring{}, fakeRuntime, pullManager, backOff, serialized, maxParallelImagePulls, c.qps, c.burst, fakePodPullingTimeRecorder, nil)
 	return
 }
 
@@ -913,6 +914,101 @@ func TestEvalCRIPullErr(t *testing.T) {
 	}
 }
 
+func TestPodIdentityForCredentialProviders(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test_pod",
+			Namespace: "test-ns",
+			UID:       "bar",
+		},
+		Spec: v1.PodSpec{
+			// A user-namespaced pod; the CRI can request its images before the
+			// sandbox config exists.
+			HostUsers: ptr.To(false),
+		},
+	}
+
+	for _, tc := range []struct {
+		name             string
+		podSandboxConfig *runtimeapi.PodSandboxConfig
+		wantNamespace    string
+		wantName         string
+		wantUID          string
+	}{
+		{
+			name: "sandbox metadata is preferred when present",
+			podSandboxConfig: &runtimeapi.PodSandboxConfig{
+				Metadata: &runtimeapi.PodSandboxMetadata{
+					Name:      "sandbox_pod",
+					Namespace: "sandbox-ns",
+					Uid:       "sandbox-uid",
+				},
+			},
+			wantNamespace: "sandbox-ns",
+			wantName:      "sandbox_pod",
+			wantUID:       "sandbox-uid",
+		},
+		{
+			name:             "userns pull without a sandbox config falls back to the pod",
+			podSandboxConfig: nil,
+			wantNamespace:    "test-ns",
+			wantName:         "test_pod",
+			wantUID:          "bar",
+		},
+		{
+			name:             "sandbox config without metadata falls back to the pod",
+			podSandboxConfig: &runtimeapi.PodSandboxConfig{},
+			wantNamespace:    "test-ns",
+			wantName:         "test_pod",
+			wantUID:          "bar",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			podNamespace, podName, podUID := podIdentityForCredentialProviders(pod, tc.podSandboxConfig)
+			assert.Equal(t, tc.wantNamespace, podNamespace)
+			assert.Equal(t, tc.wantName, podName)
+			assert.Equal(t, tc.wantUID, podUID)
+		})
+	}
+}
+
+func TestEnsureImageExistsForUserNamespacedPodWithoutSandboxConfig(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "test_pod",
+			Namespace:       "test-ns",
+			UID:             "bar",
+			ResourceVersion: "42",
+		},
+		Spec: v1.PodSpec{
+			HostUsers: ptr.To(false),
+		},
+	}
+	c := pullerTestCase{ // pull missing image without a sandbox config
+		testName:       "userns pod pull without a sandbox config stays on the service-account-aware path",
+		containerImage: "missing_image",
+		policy:         v1.PullIfNotPresent,
+		enableFeatures: []featuregate.Feature{features.KubeletServiceAccountTokenForCredentialProviders},
+		expected: []pullerExpects{
+			{[]string{"GetImageRef", "PullImage", "GetImageSize"}, nil, true, true, nil, ""},
+		}}
+
+	useSerializedEnv := true
+	t.Run(c.testName, func(t *testing.T) {
+		ctx := context.Background()
+		puller, fakeClock, fakeRuntime, container, fakePodPullingTimeRecorder, _ := pullerTestEnv(t, c, useSerializedEnv, nil)
+		fakeRuntime.CalledFunctions = nil
+		fakeRuntime.ImageList = []Image{}
+		fakeClock.Step(time.Second)
+
+		_, _, err := puller.EnsureImageExists(ctx, nil, pod, container.Image, c.pullSecrets, nil, "", container.ImagePullPolicy)
+		fakeRuntime.AssertCalls(c.expected[0].calls)
+		assert.Equal(t, c.expected[0].err, err)
+		assert.Equal(t, c.expected[0].shouldRecordStartedPullingTime, fakePodPullingTimeRecorder.startedPullingRecorded)
+		assert.Equal(t, c.expected[0].shouldRecordFinishedPullingTime, fakePodPullingTimeRecorder.finishedPullingRecorded)
+	})
+}
+
 func TestImagePullPrecheck(t *testing.T) {
 	pod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -961,3 +1057,19 @@ func makeDockercfgSecretForRepo(sMeta metav1.ObjectMeta, repo string) v1.Secret
 		},
 	}
 }
+
+func TestIsRegistryAuthErr(t *testing.T) {
+	for _, tc := range []struct {
+		err      error
+		expected bool
+	}{
+		{err: errors.New("rpc error: code = Unknown desc = failed to pull and unpack image: unexpected status from HEAD request: 401 Unauthorized"), expected: true},
+		{err: errors.New("pull access denied, repository does not exist or may require authorization: authentication required"), expected: true},
+		{err: errors.New("rpc error: code = Unknown desc = failed to pull and unpack image: connection refused"), expected: false},
+		{err: crierrors.ErrRegistryUnavailable, expected: false},
+	} {
+		if got := isRegistryAuthErr(tc.err); got != tc.expected {
+			t.Errorf("isRegistryAuthErr(%v) = %v, expected %v", tc.err, got, tc.expected)
+		}
+	}
+}
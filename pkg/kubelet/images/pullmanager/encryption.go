@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullmanager
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// encryptionKeyFile is the kubelet-managed key file holding the node-local
+	// keys pull records are encrypted with. It contains one hex-encoded 32-byte
+	// key per line; the first key encrypts new records and every key is tried
+	// when decrypting, so rotation is prepending a fresh key while records
+	// written under the previous key are still readable.
+	encryptionKeyFile = "record-encryption.key"
+
+	// encryptedRecordPrefix marks record files encrypted by the kubelet. Files
+	// without the prefix are read as plaintext, so records written before
+	// encryption was enabled stay readable; they are encrypted the next time
+	// they are written.
+	encryptedRecordPrefix = "k8s-image-record-aes-gcm-v1\x00"
+
+	// encryptionKeySize is the AES-256 key size used for record encryption.
+	encryptionKeySize = 32
+)
+
+// recordEncryptor encrypts pull record files at rest with AES-GCM using
+// node-local keys from a kubelet-managed key file.
+type recordEncryptor struct {
+	// keys are the decryption keys in the order they appear in the key file;
+	// keys[0] also encrypts new records.
+	keys [][]byte
+}
+
+// loadOrGenerateEncryptionKeys reads the record encryption keys from keyPath,
+// generating a fresh key file if none exists yet. A node that lost its key
+// file therefore comes up with a new key; records encrypted under the lost key
+// fail decryption and are treated like corrupt records, forcing credential
+// re-verification rather than failing the kubelet.
+func loadOrGenerateEncryptionKeys(keyPath string) ([][]byte, error) {
+	data, err := os.ReadFile(keyPath)
+	if os.IsNotExist(err) {
+		key := make([]byte, encryptionKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate a record encryption key: %w", err)
+		}
+		if err := os.WriteFile(keyPath, []byte(hex.EncodeToString(key)+"\n"), 0600); err != nil {
+			return nil, fmt.Errorf("failed to write the record encryption key file %q: %w", keyPath, err)
+		}
+		return [][]byte{key}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var keys [][]byte
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		key, err := hex.DecodeString(line)
+		if err != nil {
+			return nil, fmt.Errorf("record encryption key file %q line %d is not hex-encoded: %w", keyPath, i+1, err)
+		}
+		if len(key) != encryptionKeySize {
+			return nil, fmt.Errorf("record encryption key file %q line %d holds a %d-byte key, expected %d bytes", keyPath, i+1, len(key), encryptionKeySize)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("record encryption key file %q contains no keys", keyPath)
+	}
+	return keys, nil
+}
+
+// seal encrypts the record content with the current key.
+func (e *recordEncryptor) seal(content []byte) ([]byte, error) {
+	aead, err := newRecordAEAD(e.keys[0])
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate a nonce for record encryption: %w", err)
+	}
+
+	sealed := make([]byte, 0, len(encryptedRecordPrefix)+len(nonce)+len(content)+aead.Overhead())
+	sealed = append(sealed, encryptedRecordPrefix...)
+	sealed = append(sealed, nonce...)
+	return aead.Seal(sealed, nonce, content, nil), nil
+}
+
+// open decrypts the record content, trying every configured key so records
+// written before a key rotation stay readable. Content without the encrypted
+// record prefix is returned as-is, so plaintext records written before
+// encryption was enabled stay readable too.
+func (e *recordEncryptor) open(content []byte) ([]byte, error) {
+	if !bytes.HasPrefix(content, []byte(encryptedRecordPrefix)) {
+		return content, nil
+	}
+	content = content[len(encryptedRecordPrefix):]
+
+	for _, key := range e.keys {
+		aead, err := newRecordAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(content) < aead.NonceSize() {
+			return nil, fmt.Errorf("encrypted record is shorter than the %d-byte nonce", aead.NonceSize())
+		}
+		if plaintext, err := aead.Open(nil, content[:aead.NonceSize()], content[aead.NonceSize():], nil); err == nil {
+			return plaintext, nil
+		}
+	}
+	return nil, fmt.Errorf("no configured record encryption key can decrypt the record")
+}
+
+func newRecordAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
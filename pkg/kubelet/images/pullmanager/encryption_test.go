@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullmanager
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	kubeletconfiginternal "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+func Test_loadOrGenerateEncryptionKeys(t *testing.T) {
+	t.Run("missing key file generates a fresh key", func(t *testing.T) {
+		keyPath := filepath.Join(t.TempDir(), encryptionKeyFile)
+
+		keys, err := loadOrGenerateEncryptionKeys(keyPath)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		require.Len(t, keys[0], encryptionKeySize)
+
+		// the generated key must be readable back from the key file
+		reloaded, err := loadOrGenerateEncryptionKeys(keyPath)
+		require.NoError(t, err)
+		require.Equal(t, keys, reloaded)
+
+		info, err := os.Stat(keyPath)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+
+	t.Run("rotated key file yields the new key first and keeps the old key", func(t *testing.T) {
+		keyPath := filepath.Join(t.TempDir(), encryptionKeyFile)
+		oldKey := bytes.Repeat([]byte{0x01}, encryptionKeySize)
+		newKey := bytes.Repeat([]byte{0x02}, encryptionKeySize)
+		keyFile := hex.EncodeToString(newKey) + "\n" + hex.EncodeToString(oldKey) + "\n"
+		require.NoError(t, os.WriteFile(keyPath, []byte(keyFile), 0600))
+
+		keys, err := loadOrGenerateEncryptionKeys(keyPath)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{newKey, oldKey}, keys)
+	})
+
+	t.Run("malformed key file is rejected", func(t *testing.T) {
+		keyPath := filepath.Join(t.TempDir(), encryptionKeyFile)
+		require.NoError(t, os.WriteFile(keyPath, []byte("not-a-hex-key\n"), 0600))
+
+		if _, err := loadOrGenerateEncryptionKeys(keyPath); err == nil {
+			t.Fatal("expected an error for a malformed key file")
+		}
+	})
+}
+
+func Test_recordEncryptorRoundtrip(t *testing.T) {
+	currentKey := bytes.Repeat([]byte{0x01}, encryptionKeySize)
+	rotatedOutKey := bytes.Repeat([]byte{0x02}, encryptionKeySize)
+	record := []byte(`{"imageRef":"testimageref"}`)
+
+	encryptor := &recordEncryptor{keys: [][]byte{currentKey}}
+	sealed, err := encryptor.seal(record)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(sealed, []byte(encryptedRecordPrefix)), "sealed records must carry the encrypted record prefix")
+	require.NotContains(t, string(sealed), "testimageref", "sealed records must not leak plaintext")
+
+	opened, err := encryptor.open(sealed)
+	require.NoError(t, err)
+	require.Equal(t, record, opened)
+
+	t.Run("plaintext records are returned as-is", func(t *testing.T) {
+		opened, err := encryptor.open(record)
+		require.NoError(t, err)
+		require.Equal(t, record, opened)
+	})
+
+	t.Run("records sealed under an older key stay readable after rotation", func(t *testing.T) {
+		rotated := &recordEncryptor{keys: [][]byte{rotatedOutKey, currentKey}}
+		opened, err := rotated.open(sealed)
+		require.NoError(t, err)
+		require.Equal(t, record, opened)
+	})
+
+	t.Run("records sealed under a lost key fail decryption", func(t *testing.T) {
+		regenerated := &recordEncryptor{keys: [][]byte{rotatedOutKey}}
+		if _, err := regenerated.open(sealed); err == nil {
+			t.Fatal("expected an error opening a record sealed under a lost key")
+		}
+	})
+}
+
+func TestEncryptedFSPullRecordsAccessor(t *testing.T) {
+	kubeletDir := t.TempDir()
+	accessor, err := NewEncryptedFSPullRecordsAccessor(kubeletDir)
+	require.NoError(t, err)
+
+	require.NoError(t, accessor.WriteImagePullIntent("test.registry.io/test/image:latest"))
+	require.NoError(t, accessor.WriteImagePulledRecord(&kubeletconfiginternal.ImagePulledRecord{
+		ImageRef: "testimageref",
+		CredentialMapping: map[string]kubeletconfiginternal.ImagePullCredentials{
+			"test.registry.io/test/image": {
+				KubernetesSecrets: []kubeletconfiginternal.ImagePullSecret{
+					{UID: "uid", Namespace: "ns", Name: "secret-name", CredentialHash: "credential-hash"},
+				},
+			},
+		},
+	}))
+
+	// the on-disk artifacts must not contain any recognizable record content
+	for _, dir := range []string{accessor.pullingDir, accessor.pulledDir} {
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		content, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(content, []byte(encryptedRecordPrefix)))
+		for _, needle := range []string{"test.registry.io", "testimageref", "credential-hash"} {
+			require.NotContains(t, string(content), needle)
+		}
+	}
+
+	exists, err := accessor.ImagePullIntentExists("test.registry.io/test/image:latest")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	record, exists, err := accessor.GetImagePulledRecord("testimageref")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, "credential-hash", record.CredentialMapping["test.registry.io/test/image"].KubernetesSecrets[0].CredentialHash)
+
+	intents, err := accessor.ListImagePullIntents()
+	require.NoError(t, err)
+	require.Len(t, intents, 1)
+	records, err := accessor.ListImagePulledRecords()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	t.Run("plaintext records written before encryption was enabled stay readable", func(t *testing.T) {
+		plaintextAccessor, err := NewFSPullRecordsAccessor(kubeletDir)
+		require.NoError(t, err)
+		require.NoError(t, plaintextAccessor.WriteImagePullIntent("test.registry.io/plain/image:latest"))
+
+		exists, err := accessor.ImagePullIntentExists("test.registry.io/plain/image:latest")
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	t.Run("records become unreadable after the key file is lost and regenerated", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(kubeletDir, "image_manager", encryptionKeyFile)))
+		regenerated, err := NewEncryptedFSPullRecordsAccessor(kubeletDir)
+		require.NoError(t, err)
+
+		_, exists, err := regenerated.GetImagePulledRecord("testimageref")
+		require.Error(t, err)
+		require.True(t, exists, "an undecryptable record still exists on disk")
+
+		// listing surfaces the decryption failure instead of silently dropping records
+		_, err = regenerated.ListImagePulledRecords()
+		require.Error(t, err)
+		require.True(t, strings.Contains(err.Error(), "failed to decrypt"), "expected a decryption error, got %v", err)
+	})
+}
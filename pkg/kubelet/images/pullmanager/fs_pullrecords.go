@@ -48,6 +48,10 @@ type fsPullRecordsAccessor struct {
 
 	encoder runtime.Encoder
 	decoder runtime.Decoder
+
+	// encryptor encrypts record files at rest when set; a nil encryptor means
+	// records are stored in plaintext.
+	encryptor *recordEncryptor
 }
 
 // NewFSPullRecordsAccessor returns an accessor for the ImagePullIntent/ImagePulledRecord
@@ -77,6 +81,42 @@ func NewFSPullRecordsAccessor(kubeletDir string) (*fsPullRecordsAccessor, error)
 	return accessor, nil
 }
 
+// NewEncryptedFSPullRecordsAccessor returns a records accessor like
+// NewFSPullRecordsAccessor, but one that encrypts the record files at rest
+// with node-local keys from a kubelet-managed key file. The key file is
+// created on first use; records that no configured key can decrypt, for
+// example after the key file was lost and regenerated, are treated like
+// corrupt records and force credential re-verification.
+func NewEncryptedFSPullRecordsAccessor(kubeletDir string) (*fsPullRecordsAccessor, error) {
+	accessor, err := NewFSPullRecordsAccessor(kubeletDir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := loadOrGenerateEncryptionKeys(filepath.Join(kubeletDir, "image_manager", encryptionKeyFile))
+	if err != nil {
+		return nil, err
+	}
+	accessor.encryptor = &recordEncryptor{keys: keys}
+	return accessor, nil
+}
+
+// sealRecord encrypts the record content if encryption is enabled.
+func (f *fsPullRecordsAccessor) sealRecord(content []byte) ([]byte, error) {
+	if f.encryptor == nil {
+		return content, nil
+	}
+	return f.encryptor.seal(content)
+}
+
+// openRecord decrypts the record content if encryption is enabled.
+func (f *fsPullRecordsAccessor) openRecord(content []byte) ([]byte, error) {
+	if f.encryptor == nil {
+		return content, nil
+	}
+	return f.encryptor.open(content)
+}
+
 func (f *fsPullRecordsAccessor) WriteImagePullIntent(image string) error {
 	intent := kubeletconfiginternal.ImagePullIntent{
 		Image: image,
@@ -87,7 +127,11 @@ func (f *fsPullRecordsAccessor) WriteImagePullIntent(image string) error {
 		return err
 	}
 
-	return writeFile(f.pullingDir, cacheFilename(image), intentBytes.Bytes())
+	content, err := f.sealRecord(intentBytes.Bytes())
+	if err != nil {
+		return err
+	}
+	return writeFile(f.pullingDir, cacheFilename(image), content)
 }
 
 func (f *fsPullRecordsAccessor) ListImagePullIntents() ([]*kubeletconfiginternal.ImagePullIntent, error) {
@@ -95,6 +139,10 @@ func (f *fsPullRecordsAccessor) ListImagePullIntents() ([]*kubeletconfiginternal
 	// walk the pulling directory for any pull intent records
 	err := processDirFiles(f.pullingDir,
 		func(filePath string, fileContent []byte) error {
+			fileContent, err := f.openRecord(fileContent)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt content of file %q: %w", filePath, err)
+			}
 			intent, err := decodeIntent(f.decoder, fileContent)
 			if err != nil {
 				return fmt.Errorf("failed to deserialize content of file %q into ImagePullIntent: %w", filePath, err)
@@ -115,6 +163,11 @@ func (f *fsPullRecordsAccessor) ImagePullIntentExists(image string) (bool, error
 		return false, err
 	}
 
+	intentBytes, err = f.openRecord(intentBytes)
+	if err != nil {
+		return false, err
+	}
+
 	intent, err := decodeIntent(f.decoder, intentBytes)
 	if err != nil {
 		return false, err
@@ -139,6 +192,11 @@ func (f *fsPullRecordsAccessor) GetImagePulledRecord(imageRef string) (*kubeletc
 		return nil, false, err
 	}
 
+	recordBytes, err = f.openRecord(recordBytes)
+	if err != nil {
+		return nil, true, err
+	}
+
 	pulledRecord, err := decodePulledRecord(f.decoder, recordBytes)
 	if err != nil {
 		return nil, true, err
@@ -153,6 +211,10 @@ func (f *fsPullRecordsAccessor) ListImagePulledRecords() ([]*kubeletconfigintern
 	var pullRecords []*kubeletconfiginternal.ImagePulledRecord
 	err := processDirFiles(f.pulledDir,
 		func(filePath string, fileContent []byte) error {
+			fileContent, err := f.openRecord(fileContent)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt content of file %q: %w", filePath, err)
+			}
 			pullRecord, err := decodePulledRecord(f.decoder, fileContent)
 			if err != nil {
 				return fmt.Errorf("failed to deserialize content of file %q into ImagePulledRecord: %w", filePath, err)
@@ -170,7 +232,11 @@ func (f *fsPullRecordsAccessor) WriteImagePulledRecord(pulledRecord *kubeletconf
 		return fmt.Errorf("failed to serialize ImagePulledRecord: %w", err)
 	}
 
-	return writeFile(f.pulledDir, cacheFilename(pulledRecord.ImageRef), recordBytes.Bytes())
+	content, err := f.sealRecord(recordBytes.Bytes())
+	if err != nil {
+		return err
+	}
+	return writeFile(f.pulledDir, cacheFilename(pulledRecord.ImageRef), content)
 }
 
 func (f *fsPullRecordsAccessor) DeleteImagePulledRecord(imageRef string) error {
@@ -297,6 +363,36 @@ func decodePulledRecord(d runtime.Decoder, objBytes []byte) (*kubeletconfiginter
 	if !ok {
 		return nil, fmt.Errorf("failed to convert object to *ImagePulledRecord: %T", obj)
 	}
+	migratePulledRecord(pulledRecord)
 
 	return pulledRecord, nil
 }
+
+// migratePulledRecord converts records written by a schema version that did not
+// track external credential providers. Such versions recorded provider-sourced
+// credentials as a pseudo-secret with empty UID/Namespace/Name coordinates and
+// only the credential hash set. These entries are moved to the
+// `externalCredentialProviders` field, with an empty provider name since the old
+// schema did not record it.
+//
+// The migration is performed in-memory only; the record is rewritten on disk in
+// the new schema the next time a credential merge changes it.
+func migratePulledRecord(pulledRecord *kubeletconfiginternal.ImagePulledRecord) {
+	for image, creds := range pulledRecord.CredentialMapping {
+		var secrets []kubeletconfiginternal.ImagePullSecret
+		var migrated bool
+		for _, secret := range creds.KubernetesSecrets {
+			if len(secret.UID) == 0 && len(secret.Namespace) == 0 && len(secret.Name) == 0 {
+				creds.ExternalCredentialProviders, _ = mergeExternalProviders(creds.ExternalCredentialProviders,
+					[]kubeletconfiginternal.ImagePullExternalCredentialProvider{{CredentialHash: secret.CredentialHash}})
+				migrated = true
+				continue
+			}
+			secrets = append(secrets, secret)
+		}
+		if migrated {
+			creds.KubernetesSecrets = secrets
+			pulledRecord.CredentialMapping[image] = creds
+		}
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullmanager
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kubeletconfiginternal "k8s.io/kubernetes/pkg/kubelet/apis/config"
+)
+
+func Test_migratePulledRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		record   *kubeletconfiginternal.ImagePulledRecord
+		expected *kubeletconfiginternal.ImagePulledRecord
+	}{
+		{
+			name: "record without legacy provider pseudo-secrets is unchanged",
+			record: &kubeletconfiginternal.ImagePulledRecord{
+				ImageRef: "testImageRef",
+				CredentialMapping: map[string]kubeletconfiginternal.ImagePullCredentials{
+					"test-image": {
+						KubernetesSecrets: []kubeletconfiginternal.ImagePullSecret{
+							{UID: "uid1", Namespace: "namespace1", Name: "name1", CredentialHash: "hash1"},
+						},
+					},
+					"test-nodewide": {
+						NodePodsAccessible: true,
+					},
+				},
+			},
+			expected: &kubeletconfiginternal.ImagePulledRecord{
+				ImageRef: "testImageRef",
+				CredentialMapping: map[string]kubeletconfiginternal.ImagePullCredentials{
+					"test-image": {
+						KubernetesSecrets: []kubeletconfiginternal.ImagePullSecret{
+							{UID: "uid1", Namespace: "namespace1", Name: "name1", CredentialHash: "hash1"},
+						},
+					},
+					"test-nodewide": {
+						NodePodsAccessible: true,
+					},
+				},
+			},
+		},
+		{
+			name: "legacy provider pseudo-secret becomes a provider record without a provider name",
+			record: &kubeletconfiginternal.ImagePulledRecord{
+				ImageRef: "testImageRef",
+				CredentialMapping: map[string]kubeletconfiginternal.ImagePullCredentials{
+					"test-image": {
+						KubernetesSecrets: []kubeletconfiginternal.ImagePullSecret{
+							{CredentialHash: "providerhash"},
+						},
+					},
+				},
+			},
+			expected: &kubeletconfiginternal.ImagePulledRecord{
+				ImageRef: "testImageRef",
+				CredentialMapping: map[string]kubeletconfiginternal.ImagePullCredentials{
+					"test-image": {
+						ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+							{CredentialHash: "providerhash"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "legacy provider pseudo-secret is split off from proper secret records",
+			record: &kubeletconfiginternal.ImagePulledRecord{
+				ImageRef: "testImageRef",
+				CredentialMapping: map[string]kubeletconfiginternal.ImagePullCredentials{
+					"test-image": {
+						KubernetesSecrets: []kubeletconfiginternal.ImagePullSecret{
+							{CredentialHash: "providerhash"},
+							{UID: "uid1", Namespace: "namespace1", Name: "name1", CredentialHash: "hash1"},
+						},
+					},
+				},
+			},
+			expected: &kubeletconfiginternal.ImagePulledRecord{
+				ImageRef: "testImageRef",
+				CredentialMapping: map[string]kubeletconfiginternal.ImagePullCredentials{
+					"test-image": {
+						KubernetesSecrets: []kubeletconfiginternal.ImagePullSecret{
+							{UID: "uid1", Namespace: "namespace1", Name: "name1", CredentialHash: "hash1"},
+						},
+						ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+							{CredentialHash: "providerhash"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migratePulledRecord(tt.record)
+			if !reflect.DeepEqual(tt.record, tt.expected) {
+				t.Errorf("migratePulledRecord() difference between got/expected: %v", cmp.Diff(tt.expected, tt.record))
+			}
+		})
+	}
+}
@@ -429,9 +429,8 @@ func pulledRecordMergeNewCreds(orig *kubeletconfiginternal.ImagePulledRecord, im
 		return orig, false
 	}
 
-	if !newCreds.NodePodsAccessible && len(newCreds.KubernetesSecrets) == 0 {
-		// we don't have any secret credentials or node-wide access to record
-		// TODO(stlaz,aramase): add in a serviceaccount dimension check
+	if !newCreds.NodePodsAccessible && len(newCreds.KubernetesSecrets) == 0 && len(newCreds.ExternalCredentialProviders) == 0 {
+		// we don't have any secret or provider credentials or node-wide access to record
 		return orig, false
 	}
 	selectedCreds, found := orig.CredentialMapping[imageNoTagDigest]
@@ -445,11 +444,16 @@ func pulledRecordMergeNewCreds(orig *kubeletconfiginternal.ImagePulledRecord, im
 		return ret, true
 	}
 
-	if selectedCreds.NodePodsAccessible {
+	// provider records carry provenance that is worth keeping even once the
+	// image is known to be node-accessible
+	var providersChanged bool
+	selectedCreds.ExternalCredentialProviders, providersChanged = mergeExternalProviders(selectedCreds.ExternalCredentialProviders, newCreds.ExternalCredentialProviders)
+
+	if selectedCreds.NodePodsAccessible && !providersChanged {
 		return orig, false
 	}
 
-	if newCreds.NodePodsAccessible {
+	if newCreds.NodePodsAccessible || selectedCreds.NodePodsAccessible {
 		selectedCreds.NodePodsAccessible = true
 		selectedCreds.KubernetesSecrets = nil
 
@@ -461,7 +465,7 @@ func pulledRecordMergeNewCreds(orig *kubeletconfiginternal.ImagePulledRecord, im
 
 	var secretsChanged bool
 	selectedCreds.KubernetesSecrets, secretsChanged = mergePullSecrets(selectedCreds.KubernetesSecrets, newCreds.KubernetesSecrets)
-	if !secretsChanged {
+	if !secretsChanged && !providersChanged {
 		return orig, false
 	}
 
@@ -517,6 +521,80 @@ func mergePullSecrets(orig, new []kubeletconfiginternal.ImagePullSecret) ([]kube
 	return ret, true
 }
 
+type externalProviderCoordinates struct {
+	Provider  string
+	UID       string
+	Namespace string
+	Name      string
+}
+
+// mergeExternalProviders merges two slices of ImagePullExternalCredentialProvider
+// objects into one while keeping the objects unique per
+// `Provider, Namespace, Name, UID` key.
+//
+// In case an object from the `new` slice has the same `Provider, Namespace, Name, UID`
+// combination as an object from `orig`, the result will use the CredentialHash
+// value of the object from `new`.
+//
+// The returned slice is sorted by Provider, Namespace, Name and UID (in this
+// order). Also returns an indicator whether the set of input records changed.
+func mergeExternalProviders(orig, new []kubeletconfiginternal.ImagePullExternalCredentialProvider) ([]kubeletconfiginternal.ImagePullExternalCredentialProvider, bool) {
+	credSet := make(map[externalProviderCoordinates]string)
+	for _, provider := range orig {
+		credSet[externalProviderCoordinates{
+			Provider:  provider.Provider,
+			UID:       provider.UID,
+			Namespace: provider.Namespace,
+			Name:      provider.Name,
+		}] = provider.CredentialHash
+	}
+
+	changed := false
+	for _, p := range new {
+		key := externalProviderCoordinates{Provider: p.Provider, UID: p.UID, Namespace: p.Namespace, Name: p.Name}
+		if existingHash, ok := credSet[key]; !ok || existingHash != p.CredentialHash {
+			changed = true
+			credSet[key] = p.CredentialHash
+		}
+	}
+	if !changed {
+		return orig, false
+	}
+
+	ret := make([]kubeletconfiginternal.ImagePullExternalCredentialProvider, 0, len(credSet))
+	for coords, hash := range credSet {
+		ret = append(ret, kubeletconfiginternal.ImagePullExternalCredentialProvider{
+			Provider:       coords.Provider,
+			UID:            coords.UID,
+			Namespace:      coords.Namespace,
+			Name:           coords.Name,
+			CredentialHash: hash,
+		})
+	}
+	// we don't need to use the stable version because provider coordinates used for ordering are unique in the set
+	slices.SortFunc(ret, imagePullExternalProviderLess)
+
+	return ret, true
+}
+
+// imagePullExternalProviderLess is a helper function to define ordering in a
+// slice of ImagePullExternalCredentialProvider objects.
+func imagePullExternalProviderLess(a, b kubeletconfiginternal.ImagePullExternalCredentialProvider) int {
+	if cmp := strings.Compare(a.Provider, b.Provider); cmp != 0 {
+		return cmp
+	}
+
+	if cmp := strings.Compare(a.Namespace, b.Namespace); cmp != 0 {
+		return cmp
+	}
+
+	if cmp := strings.Compare(a.Name, b.Name); cmp != 0 {
+		return cmp
+	}
+
+	return strings.Compare(a.UID, b.UID)
+}
+
 // imagePullSecretLess is a helper function to define ordering in a slice of
 // ImagePullSecret objects.
 func imagePullSecretLess(a, b kubeletconfiginternal.ImagePullSecret) int {
@@ -55,6 +55,11 @@ func Test_pulledRecordMergeNewCreds(t *testing.T) {
 			"test-nodewide": {
 				NodePodsAccessible: true,
 			},
+			"test-provider": {
+				ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+					{Provider: "test-provider-plugin", UID: "sa-uid1", Namespace: "namespace1", Name: "sa1", CredentialHash: "hash1"},
+				},
+			},
 		},
 	}
 
@@ -177,6 +182,89 @@ func Test_pulledRecordMergeNewCreds(t *testing.T) {
 			),
 			wantUpdate: true,
 		},
+		{
+			name:    "merge a provider record with an existing provider record",
+			image:   "test-provider",
+			current: testRecord.DeepCopy(),
+			credsForMerging: &kubeletconfiginternal.ImagePullCredentials{
+				ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+					{Provider: "test-provider-plugin", Namespace: "namespace2", CredentialHash: "hash2"},
+				},
+			},
+			expectedRecord: withImageRecord(testRecord.DeepCopy(), "test-provider",
+				kubeletconfiginternal.ImagePullCredentials{
+					ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+						{Provider: "test-provider-plugin", Namespace: "namespace1", UID: "sa-uid1", Name: "sa1", CredentialHash: "hash1"},
+						{Provider: "test-provider-plugin", Namespace: "namespace2", CredentialHash: "hash2"},
+					},
+				},
+			),
+			wantUpdate: true,
+		},
+		{
+			name:    "same provider record does not cause an update",
+			image:   "test-provider",
+			current: testRecord.DeepCopy(),
+			credsForMerging: &kubeletconfiginternal.ImagePullCredentials{
+				ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+					{Provider: "test-provider-plugin", UID: "sa-uid1", Namespace: "namespace1", Name: "sa1", CredentialHash: "hash1"},
+				},
+			},
+			expectedRecord: testRecord.DeepCopy(),
+			wantUpdate:     false,
+		},
+		{
+			name:    "provider record is kept when node-accessible overrides secrets",
+			image:   "test-image2",
+			current: testRecord.DeepCopy(),
+			credsForMerging: &kubeletconfiginternal.ImagePullCredentials{
+				NodePodsAccessible: true,
+				ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+					{Provider: "test-provider-plugin", CredentialHash: "hash3"},
+				},
+			},
+			expectedRecord: withImageRecord(testRecord.DeepCopy(), "test-image2",
+				kubeletconfiginternal.ImagePullCredentials{
+					NodePodsAccessible: true,
+					ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+						{Provider: "test-provider-plugin", CredentialHash: "hash3"},
+					},
+				},
+			),
+			wantUpdate: true,
+		},
+		{
+			name:    "provider record merges into a node-accessible image record",
+			image:   "test-nodewide",
+			current: testRecord.DeepCopy(),
+			credsForMerging: &kubeletconfiginternal.ImagePullCredentials{
+				NodePodsAccessible: true,
+				ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+					{Provider: "test-provider-plugin", CredentialHash: "hash3"},
+				},
+			},
+			expectedRecord: withImageRecord(testRecord.DeepCopy(), "test-nodewide",
+				kubeletconfiginternal.ImagePullCredentials{
+					NodePodsAccessible: true,
+					ExternalCredentialProviders: []kubeletconfiginternal.ImagePullExternalCredentialProvider{
+						{Provider: "test-provider-plugin", CredentialHash: "hash3"},
+					},
+				},
+			),
+			wantUpdate: true,
+		},
+		{
+			name:    "node-accessible image record ignores new secrets",
+			image:   "test-nodewide",
+			current: testRecord.DeepCopy(),
+			credsForMerging: &kubeletconfiginternal.ImagePullCredentials{
+				KubernetesSecrets: []kubeletconfiginternal.ImagePullSecret{
+					{UID: "newuid", Namespace: "newnamespace", Name: "newname", CredentialHash: "newhash"},
+				},
+			},
+			expectedRecord: testRecord.DeepCopy(),
+			wantUpdate:     false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -76,6 +76,7 @@ import (
 	pluginwatcherapi "k8s.io/kubelet/pkg/apis/pluginregistration/v1"
 	statsapi "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	credentialproviderplugin "k8s.io/kubernetes/pkg/credentialprovider/plugin"
 	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/kubelet/allocation"
 	kubeletconfiginternal "k8s.io/kubernetes/pkg/kubelet/apis/config"
@@ -112,6 +113,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/server"
 	servermetrics "k8s.io/kubernetes/pkg/kubelet/server/metrics"
 	serverstats "k8s.io/kubernetes/pkg/kubelet/server/stats"
+	"k8s.io/kubernetes/pkg/kubelet/serviceaccount"
 	"k8s.io/kubernetes/pkg/kubelet/stats"
 	"k8s.io/kubernetes/pkg/kubelet/status"
 	"k8s.io/kubernetes/pkg/kubelet/sysctl"
@@ -419,6 +421,7 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 	podLogsDirectory string,
 	imageCredentialProviderConfigPath string,
 	imageCredentialProviderBinDir string,
+	imageCredentialProviderConfigStrict bool,
 	registerNode bool,
 	registerWithTaints []v1.Taint,
 	allowedUnsafeSysctls []string,
@@ -492,9 +495,10 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 	}
 
 	imageGCPolicy := images.ImageGCPolicy{
-		MinAge:               kubeCfg.ImageMinimumGCAge.Duration,
-		HighThresholdPercent: int(kubeCfg.ImageGCHighThresholdPercent),
-		LowThresholdPercent:  int(kubeCfg.ImageGCLowThresholdPercent),
+		MinAge:                kubeCfg.ImageMinimumGCAge.Duration,
+		HighThresholdPercent:  int(kubeCfg.ImageGCHighThresholdPercent),
+		LowThresholdPercent:   int(kubeCfg.ImageGCLowThresholdPercent),
+		InvalidateCredentials: kubeCfg.InvalidateCredentialsOnImageGC,
 	}
 
 	if utilfeature.DefaultFeatureGate.Enabled(features.ImageMaximumGCAge) {
@@ -587,52 +591,53 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 	tracer := kubeDeps.TracerProvider.Tracer(instrumentationScope)
 
 	klet := &Kubelet{
-		hostname:                     hostname,
-		nodeName:                     nodeName,
-		kubeClient:                   kubeDeps.KubeClient,
-		heartbeatClient:              kubeDeps.HeartbeatClient,
-		onRepeatedHeartbeatFailure:   kubeDeps.OnHeartbeatFailure,
-		rootDirectory:                filepath.Clean(rootDirectory),
-		podLogsDirectory:             podLogsDirectory,
-		resyncInterval:               kubeCfg.SyncFrequency.Duration,
-		sourcesReady:                 config.NewSourcesReady(kubeDeps.PodConfig.SeenAllSources),
-		registerNode:                 registerNode,
-		registerWithTaints:           registerWithTaints,
-		dnsConfigurer:                dns.NewConfigurer(kubeDeps.Recorder, nodeRef, nodeIPs, clusterDNS, kubeCfg.ClusterDomain, kubeCfg.ResolverConfig),
-		serviceLister:                serviceLister,
-		serviceHasSynced:             serviceHasSynced,
-		nodeLister:                   nodeLister,
-		nodeHasSynced:                nodeHasSynced,
-		recorder:                     kubeDeps.Recorder,
-		cadvisor:                     kubeDeps.CAdvisorInterface,
-		externalCloudProvider:        cloudprovider.IsExternal(cloudProvider),
-		providerID:                   providerID,
-		nodeRef:                      nodeRef,
-		nodeLabels:                   nodeLabels,
-		nodeStatusUpdateFrequency:    kubeCfg.NodeStatusUpdateFrequency.Duration,
-		nodeStatusReportFrequency:    kubeCfg.NodeStatusReportFrequency.Duration,
-		os:                           kubeDeps.OSInterface,
-		oomWatcher:                   oomWatcher,
-		cgroupsPerQOS:                kubeCfg.CgroupsPerQOS,
-		cgroupRoot:                   kubeCfg.CgroupRoot,
-		mounter:                      kubeDeps.Mounter,
-		hostutil:                     kubeDeps.HostUtil,
-		subpather:                    kubeDeps.Subpather,
-		maxPods:                      int(kubeCfg.MaxPods),
-		podsPerCore:                  int(kubeCfg.PodsPerCore),
-		syncLoopMonitor:              atomic.Value{},
-		daemonEndpoints:              daemonEndpoints,
-		containerManager:             kubeDeps.ContainerManager,
-		nodeIPs:                      nodeIPs,
-		nodeIPValidator:              validateNodeIP,
-		clock:                        clock.RealClock{},
-		enableControllerAttachDetach: kubeCfg.EnableControllerAttachDetach,
-		makeIPTablesUtilChains:       kubeCfg.MakeIPTablesUtilChains,
-		nodeStatusMaxImages:          nodeStatusMaxImages,
-		tracer:                       tracer,
-		nodeStartupLatencyTracker:    kubeDeps.NodeStartupLatencyTracker,
-		healthChecker:                kubeDeps.HealthChecker,
-		flagz:                        kubeDeps.Flagz,
+		hostname:                            hostname,
+		nodeName:                            nodeName,
+		kubeClient:                          kubeDeps.KubeClient,
+		heartbeatClient:                     kubeDeps.HeartbeatClient,
+		onRepeatedHeartbeatFailure:          kubeDeps.OnHeartbeatFailure,
+		rootDirectory:                       filepath.Clean(rootDirectory),
+		podLogsDirectory:                    podLogsDirectory,
+		resyncInterval:                      kubeCfg.SyncFrequency.Duration,
+		sourcesReady:                        config.NewSourcesReady(kubeDeps.PodConfig.SeenAllSources),
+		registerNode:                        registerNode,
+		registerWithTaints:                  registerWithTaints,
+		dnsConfigurer:                       dns.NewConfigurer(kubeDeps.Recorder, nodeRef, nodeIPs, clusterDNS, kubeCfg.ClusterDomain, kubeCfg.ResolverConfig),
+		serviceLister:                       serviceLister,
+		serviceHasSynced:                    serviceHasSynced,
+		nodeLister:                          nodeLister,
+		nodeHasSynced:                       nodeHasSynced,
+		recorder:                            kubeDeps.Recorder,
+		cadvisor:                            kubeDeps.CAdvisorInterface,
+		externalCloudProvider:               cloudprovider.IsExternal(cloudProvider),
+		providerID:                          providerID,
+		nodeRef:                             nodeRef,
+		nodeLabels:                          nodeLabels,
+		publishCredentialProviderConfigHash: crOptions.ImageCredentialProviderPublishConfigHash,
+		nodeStatusUpdateFrequency:           kubeCfg.NodeStatusUpdateFrequency.Duration,
+		nodeStatusReportFrequency:           kubeCfg.NodeStatusReportFrequency.Duration,
+		os:                                  kubeDeps.OSInterface,
+		oomWatcher:                          oomWatcher,
+		cgroupsPerQOS:                       kubeCfg.CgroupsPerQOS,
+		cgroupRoot:                          kubeCfg.CgroupRoot,
+		mounter:                             kubeDeps.Mounter,
+		hostutil:                            kubeDeps.HostUtil,
+		subpather:                           kubeDeps.Subpather,
+		maxPods:                             int(kubeCfg.MaxPods),
+		podsPerCore:                         int(kubeCfg.PodsPerCore),
+		syncLoopMonitor:                     atomic.Value{},
+		daemonEndpoints:                     daemonEndpoints,
+		containerManager:                    kubeDeps.ContainerManager,
+		nodeIPs:                             nodeIPs,
+		nodeIPValidator:                     validateNodeIP,
+		clock:                               clock.RealClock{},
+		enableControllerAttachDetach:        kubeCfg.EnableControllerAttachDetach,
+		makeIPTablesUtilChains:              kubeCfg.MakeIPTablesUtilChains,
+		nodeStatusMaxImages:                 nodeStatusMaxImages,
+		tracer:                              tracer,
+		nodeStartupLatencyTracker:           kubeDeps.NodeStartupLatencyTracker,
+		healthChecker:                       kubeDeps.HealthChecker,
+		flagz:                               kubeDeps.Flagz,
 	}
 
 	var secretManager secret.Manager
@@ -656,6 +661,23 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 
 		klet.secretManager = secretManager
 		klet.configMapManager = configMapManager
+
+		if utilfeature.DefaultFeatureGate.Enabled(features.KubeletServiceAccountTokenForCredentialProviders) {
+			// Credential provider plugins consume service account annotations on
+			// every image pull, so lookups are served from a cache of the service
+			// accounts referenced by running pods, maintained with the same change
+			// detection strategy as secrets and config maps, instead of an API GET
+			// per pull.
+			switch kubeCfg.ConfigMapAndSecretChangeDetectionStrategy {
+			case kubeletconfiginternal.WatchChangeDetectionStrategy:
+				klet.serviceAccountManager = serviceaccount.NewWatchingServiceAccountManager(klet.kubeClient, klet.resyncInterval)
+			case kubeletconfiginternal.TTLCacheChangeDetectionStrategy:
+				klet.serviceAccountManager = serviceaccount.NewCachingServiceAccountManager(
+					klet.kubeClient, manager.GetObjectTTLFromNodeFunc(klet.GetNode))
+			case kubeletconfiginternal.GetChangeDetectionStrategy:
+				klet.serviceAccountManager = serviceaccount.NewSimpleServiceAccountManager(klet.kubeClient)
+			}
+		}
 	}
 
 	machineInfo, err := klet.cadvisor.MachineInfo()
@@ -742,13 +764,21 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 	}
 	if utilfeature.DefaultFeatureGate.Enabled(features.KubeletServiceAccountTokenForCredentialProviders) {
 		getServiceAccount = func(namespace, name string) (*v1.ServiceAccount, error) {
-			if klet.kubeClient == nil {
+			if klet.serviceAccountManager == nil {
 				return nil, errors.New("cannot get ServiceAccounts when kubelet is in standalone mode")
 			}
-			return klet.kubeClient.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
+			return klet.serviceAccountManager.GetServiceAccount(namespace, name)
 		}
 	}
 
+	// The kubelet-level cap on credential provider cache durations must be in
+	// place before the runtime manager registers the plugins.
+	credentialproviderplugin.SetMaxCacheDuration(crOptions.ImageCredentialProviderMaxCacheDuration)
+
+	// The pinned checksum for a remote credential provider config must likewise
+	// be recorded before the runtime manager loads the configuration.
+	credentialproviderplugin.SetRemoteConfigChecksum(crOptions.ImageCredentialProviderConfigSHA256)
+
 	runtime, postImageGCHooks, err := kuberuntime.NewKubeGenericRuntimeManager(
 		kubecontainer.FilterEventRecorder(kubeDeps.Recorder),
 		klet.livenessManager,
@@ -768,8 +798,11 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 		int(kubeCfg.RegistryBurst),
 		kubeCfg.ImagePullCredentialsVerificationPolicy,
 		kubeCfg.PreloadedImagesVerificationAllowlist,
+		kubeCfg.CredentialSourceOrder,
 		imageCredentialProviderConfigPath,
 		imageCredentialProviderBinDir,
+		imageCredentialProviderConfigStrict,
+		kubeCfg.CredentialProviders,
 		singleProcessOOMKill,
 		kubeCfg.CPUCFSQuota,
 		kubeCfg.CPUCFSQuotaPeriod,
@@ -794,6 +827,10 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 	klet.containerRuntime = runtime
 	klet.streamingRuntime = runtime
 	klet.runner = runtime
+
+	// Let credential provider plugins that opt in to node-derived request
+	// fields read the node object without calling the API server themselves.
+	credentialproviderplugin.SetNodeGetter(klet.GetNode)
 	klet.allocationManager.SetContainerRuntime(runtime)
 
 	runtimeCache, err := kubecontainer.NewRuntimeCache(klet.containerRuntime, runtimeCacheRefreshPeriod)
@@ -1144,6 +1181,13 @@ type Kubelet struct {
 	// configMapManager must then keep the needed config maps up-to-date as they change.
 	configMapManager configmap.Manager
 
+	// serviceAccountManager caches the service accounts referenced by running pods on this
+	// node, so credential provider plugins consuming service account annotations do not
+	// trigger an API GET per image pull. It is registered and unregistered for pods
+	// alongside the secret and config map managers, and is nil when service account tokens
+	// for credential providers are disabled.
+	serviceAccountManager serviceaccount.Manager
+
 	// volumeManager observes the set of running pods and is responsible for attaching, mounting,
 	// unmounting, and detaching as those pods move through their lifecycle. It periodically
 	// synchronizes the set of known volumes to the set of actually desired volumes and cleans up
@@ -1201,6 +1245,10 @@ type Kubelet struct {
 	// a list of node labels to register
 	nodeLabels map[string]string
 
+	// publishCredentialProviderConfigHash indicates whether the kubelet should publish a
+	// hash of the loaded image credential provider configuration as a node annotation.
+	publishCredentialProviderConfigHash bool
+
 	// Last timestamp when runtime responded on ping.
 	// Mutex is used to protect this value.
 	runtimeState *runtimeState
@@ -1929,7 +1977,8 @@ func (kl *Kubelet) SyncPod(ctx context.Context, updateType kubetypes.SyncPodType
 		return false, fmt.Errorf("%s: %v", NetworkNotReadyErrorMsg, err)
 	}
 
-	// ensure the kubelet knows about referenced secrets or configmaps used by the pod
+	// ensure the kubelet knows about referenced secrets, configmaps and service
+	// accounts used by the pod
 	if !kl.podWorkers.IsPodTerminationRequested(pod.UID) {
 		if kl.secretManager != nil {
 			kl.secretManager.RegisterPod(pod)
@@ -1937,6 +1986,9 @@ func (kl *Kubelet) SyncPod(ctx context.Context, updateType kubetypes.SyncPodType
 		if kl.configMapManager != nil {
 			kl.configMapManager.RegisterPod(pod)
 		}
+		if kl.serviceAccountManager != nil {
+			kl.serviceAccountManager.RegisterPod(pod)
+		}
 	}
 
 	// Create Cgroups for the pod and apply resource parameters
@@ -2233,13 +2285,17 @@ func (kl *Kubelet) SyncTerminatedPod(ctx context.Context, pod *v1.Pod, podStatus
 	}
 	klog.V(3).InfoS("Pod termination cleaned up volume paths", "pod", klog.KObj(pod), "podUID", pod.UID)
 
-	// After volume unmount is complete, let the secret and configmap managers know we're done with this pod
+	// After volume unmount is complete, let the secret, configmap and service
+	// account managers know we're done with this pod
 	if kl.secretManager != nil {
 		kl.secretManager.UnregisterPod(pod)
 	}
 	if kl.configMapManager != nil {
 		kl.configMapManager.UnregisterPod(pod)
 	}
+	if kl.serviceAccountManager != nil {
+		kl.serviceAccountManager.UnregisterPod(pod)
+	}
 
 	// Note: we leave pod containers to be reclaimed in the background since dockershim requires the
 	// container for retrieving logs and we want to make sure logs are available until the pod is
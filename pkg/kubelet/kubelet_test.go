@@ -3016,6 +3016,7 @@ func TestNewMainKubeletStandAlone(t *testing.T) {
 		"",
 		"",
 		false,
+		false,
 		[]v1.Taint{},
 		[]string{},
 		"",
@@ -3119,8 +3120,11 @@ func TestSyncPodSpans(t *testing.T) {
 		int(kubeCfg.RegistryBurst),
 		string(kubeletconfiginternal.NeverVerify),
 		nil,
+		nil,
 		"",
 		"",
+		true,
+		nil,
 		nil,
 		kubeCfg.CPUCFSQuota,
 		kubeCfg.CPUCFSQuotaPeriod,
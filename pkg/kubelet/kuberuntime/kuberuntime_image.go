@@ -18,6 +18,8 @@ package kuberuntime
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -34,7 +36,24 @@ func (m *kubeGenericRuntimeManager) PullImage(ctx context.Context, image kubecon
 	img := image.Image
 	imgSpec := toRuntimeAPIImageSpec(image)
 
-	if len(credentials) == 0 {
+	// Credentials scoped to other purposes, such as signature fetching, must not
+	// be presented to the registry for image pulls.
+	pullCredentials := make([]crededentialprovider.TrackedAuthConfig, 0, len(credentials))
+	for _, currentCreds := range credentials {
+		if !currentCreds.UsableFor(crededentialprovider.PullAuthConfigCredentialUse) {
+			klog.V(4).InfoS("Skipping credentials not usable for image pulls", "image", img)
+			continue
+		}
+		// Credentials carrying signing parameters hold no usable static material;
+		// without a signer registered for the algorithm they cannot be presented.
+		if signing := currentCreds.Signing; signing != nil && crededentialprovider.RequestSignerFor(signing.Algorithm) == nil {
+			klog.V(2).InfoS("Skipping credentials requiring a request signing algorithm with no registered signer", "image", img, "algorithm", signing.Algorithm)
+			continue
+		}
+		pullCredentials = append(pullCredentials, currentCreds)
+	}
+
+	if len(pullCredentials) == 0 {
 		klog.V(3).InfoS("Pulling image without credentials", "image", img)
 
 		imageRef, err := m.imageService.PullImage(ctx, imgSpec, nil, podSandboxConfig)
@@ -47,7 +66,7 @@ func (m *kubeGenericRuntimeManager) PullImage(ctx context.Context, image kubecon
 	}
 
 	var pullErrs []error
-	for _, currentCreds := range credentials {
+	for _, currentCreds := range pullCredentials {
 		auth := &runtimeapi.AuthConfig{
 			Username:      currentCreds.Username,
 			Password:      currentCreds.Password,
@@ -55,6 +74,60 @@ func (m *kubeGenericRuntimeManager) PullImage(ctx context.Context, image kubecon
 			ServerAddress: currentCreds.ServerAddress,
 			IdentityToken: currentCreds.IdentityToken,
 			RegistryToken: currentCreds.RegistryToken,
+			// PullEndpoint steers runtimes that support endpoint overrides at a
+			// registry replica, such as a geo-local mirror, known to the
+			// credential provider plugin that issued the credential.
+			PullEndpoint: currentCreds.PullEndpoint,
+		}
+
+		// Resolve signing parameters through the signer registered for the
+		// algorithm into credentials the runtime can present; credentials with
+		// unregistered algorithms were filtered out above.
+		if signing := currentCreds.Signing; signing != nil {
+			signer := crededentialprovider.RequestSignerFor(signing.Algorithm)
+			if signer == nil {
+				continue
+			}
+			signed, err := signer.SignPullRequest(ctx, img, *signing)
+			if err != nil {
+				pullErrs = append(pullErrs, fmt.Errorf("error signing pull request for image %s with algorithm %s: %w", img, signing.Algorithm, err))
+				continue
+			}
+			auth.Username = signed.Username
+			auth.Password = signed.Password
+			auth.Auth = signed.Auth
+			auth.IdentityToken = signed.IdentityToken
+			auth.RegistryToken = signed.RegistryToken
+		}
+
+		// Credentials scoped to a service account carry that identity to the
+		// runtime, so runtimes implementing credential-aware image access
+		// checks can enforce policy consistently with the kubelet.
+		if source := currentCreds.Source; source != nil && source.ServiceAccount != nil {
+			auth.ServiceAccountNamespace = source.ServiceAccount.Namespace
+			auth.ServiceAccountName = source.ServiceAccount.Name
+			auth.ServiceAccountUid = source.ServiceAccount.UID
+		}
+
+		// Client TLS material for registries requiring mutual TLS, known only to
+		// the credential provider plugin that issued the credential. Runtimes that
+		// do not support client certificates ignore these fields.
+		if tls := currentCreds.TLS; tls != nil {
+			auth.ClientCertificate = tls.ClientCertificate
+			auth.ClientKey = tls.ClientKey
+			auth.ClientCertificateFile = tls.ClientCertificateFile
+			auth.ClientKeyFile = tls.ClientKeyFile
+		}
+
+		// The CRI auth config has no general header mechanism; a bearer Authorization
+		// header from a credential provider plugin maps onto the registry token field,
+		// other headers cannot be forwarded to the runtime and are dropped.
+		for _, header := range currentCreds.Headers {
+			if strings.EqualFold(header.Name, "Authorization") && strings.HasPrefix(header.Value, "Bearer ") && auth.RegistryToken == "" {
+				auth.RegistryToken = strings.TrimPrefix(header.Value, "Bearer ")
+				continue
+			}
+			klog.V(4).InfoS("Dropping credential header not representable in the CRI auth config", "image", img, "header", header.Name)
 		}
 
 		imageRef, err := m.imageService.PullImage(ctx, imgSpec, auth, podSandboxConfig)
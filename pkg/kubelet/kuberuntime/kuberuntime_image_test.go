@@ -69,6 +69,140 @@ func TestPullImageWithError(t *testing.T) {
 	assert.Empty(t, images)
 }
 
+func TestPullImageWithServiceAccountScopedCredentials(t *testing.T) {
+	ctx := context.Background()
+	_, fakeImageService, fakeManager, err := createTestRuntimeManager()
+	assert.NoError(t, err)
+
+	credentials := []credentialprovider.TrackedAuthConfig{
+		{
+			AuthConfig: credentialprovider.AuthConfig{
+				Username:     "user",
+				Password:     "password",
+				PullEndpoint: "mirror.registry.io",
+				TLS: &credentialprovider.AuthConfigTLS{
+					ClientCertificateFile: "/var/lib/kubelet/pki/registry-client.crt",
+					ClientKeyFile:         "/var/lib/kubelet/pki/registry-client.key",
+				},
+			},
+			Source: &credentialprovider.CredentialSource{
+				ServiceAccount: &credentialprovider.ServiceAccountCoordinates{
+					UID:       "sa-uid",
+					Namespace: "test-ns",
+					Name:      "test-sa",
+				},
+			},
+		},
+	}
+
+	imageRef, creds, err := fakeManager.PullImage(ctx, kubecontainer.ImageSpec{Image: "busybox"}, credentials, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "busybox", imageRef)
+	require.NotNil(t, creds)
+
+	fakeImageService.AssertImagePulledWithAuth(t, &runtimeapi.ImageSpec{Image: "busybox", Annotations: make(map[string]string)}, &runtimeapi.AuthConfig{
+		Username:                "user",
+		Password:                "password",
+		ServiceAccountNamespace: "test-ns",
+		ServiceAccountName:      "test-sa",
+		ServiceAccountUid:       "sa-uid",
+		PullEndpoint:            "mirror.registry.io",
+		ClientCertificateFile:   "/var/lib/kubelet/pki/registry-client.crt",
+		ClientKeyFile:           "/var/lib/kubelet/pki/registry-client.key",
+	}, "service account identity, pull endpoint and client TLS material should be forwarded in the CRI auth config")
+}
+
+type fakeRequestSigner struct {
+	signed     *credentialprovider.AuthConfig
+	err        error
+	gotImage   string
+	gotSigning credentialprovider.AuthConfigSigning
+}
+
+func (s *fakeRequestSigner) SignPullRequest(_ context.Context, image string, signing credentialprovider.AuthConfigSigning) (*credentialprovider.AuthConfig, error) {
+	s.gotImage = image
+	s.gotSigning = signing
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.signed, nil
+}
+
+func TestPullImageWithSigningCredentials(t *testing.T) {
+	ctx := context.Background()
+	_, fakeImageService, fakeManager, err := createTestRuntimeManager()
+	assert.NoError(t, err)
+
+	signer := &fakeRequestSigner{signed: &credentialprovider.AuthConfig{RegistryToken: "signed-token"}}
+	credentialprovider.RegisterRequestSigner("test-sigv4", signer)
+
+	credentials := []credentialprovider.TrackedAuthConfig{
+		{
+			AuthConfig: credentialprovider.AuthConfig{
+				Signing: &credentialprovider.AuthConfigSigning{
+					Algorithm:    "test-sigv4",
+					KeyReference: "arn:aws:iam::123456789:role/pull",
+				},
+			},
+		},
+	}
+
+	imageRef, creds, err := fakeManager.PullImage(ctx, kubecontainer.ImageSpec{Image: "busybox"}, credentials, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "busybox", imageRef)
+	require.NotNil(t, creds)
+
+	assert.Equal(t, "busybox", signer.gotImage)
+	assert.Equal(t, "arn:aws:iam::123456789:role/pull", signer.gotSigning.KeyReference)
+	fakeImageService.AssertImagePulledWithAuth(t, &runtimeapi.ImageSpec{Image: "busybox", Annotations: make(map[string]string)}, &runtimeapi.AuthConfig{
+		RegistryToken: "signed-token",
+	}, "credentials resolved by the signer should be forwarded in the CRI auth config")
+}
+
+func TestPullImageWithUnregisteredSigningAlgorithm(t *testing.T) {
+	ctx := context.Background()
+	_, fakeImageService, fakeManager, err := createTestRuntimeManager()
+	assert.NoError(t, err)
+
+	credentials := []credentialprovider.TrackedAuthConfig{
+		{
+			AuthConfig: credentialprovider.AuthConfig{
+				Signing: &credentialprovider.AuthConfigSigning{Algorithm: "unregistered-algorithm"},
+			},
+		},
+	}
+
+	// Credentials requiring a signer the kubelet does not have are skipped and
+	// the pull proceeds anonymously.
+	imageRef, creds, err := fakeManager.PullImage(ctx, kubecontainer.ImageSpec{Image: "busybox"}, credentials, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "busybox", imageRef)
+	assert.Nil(t, creds)
+	fakeImageService.AssertImagePulledWithAuth(t, &runtimeapi.ImageSpec{Image: "busybox", Annotations: make(map[string]string)}, nil, "the pull should fall back to anonymous when no signer is registered")
+}
+
+func TestPullImageWithSignerError(t *testing.T) {
+	ctx := context.Background()
+	_, _, fakeManager, err := createTestRuntimeManager()
+	assert.NoError(t, err)
+
+	signer := &fakeRequestSigner{err: fmt.Errorf("sts unavailable")}
+	credentialprovider.RegisterRequestSigner("test-sigv4-failing", signer)
+
+	credentials := []credentialprovider.TrackedAuthConfig{
+		{
+			AuthConfig: credentialprovider.AuthConfig{
+				Signing: &credentialprovider.AuthConfigSigning{Algorithm: "test-sigv4-failing"},
+			},
+		},
+	}
+
+	imageRef, creds, err := fakeManager.PullImage(ctx, kubecontainer.ImageSpec{Image: "busybox"}, credentials, nil)
+	assert.ErrorContains(t, err, "sts unavailable")
+	assert.Equal(t, "", imageRef)
+	assert.Nil(t, creds)
+}
+
 func TestListImages(t *testing.T) {
 	ctx := context.Background()
 	_, fakeImageService, fakeManager, err := createTestRuntimeManager()
@@ -333,6 +467,7 @@ func TestPullWithSecrets(t *testing.T) {
 			0,                // Disable image pull throttling by setting QPS to 0,
 			0,
 			&fakePodPullingTimeRecorder{},
+			nil,
 		)
 
 		_, _, err = fakeManager.imagePuller.EnsureImageExists(ctx, nil, makeTestPod("testpod", "testpod-ns", "testpod-uid", []v1.Container{}), test.imageName, test.passedSecrets, nil, "", v1.PullAlways)
@@ -407,6 +542,7 @@ func TestPullWithSecretsWithError(t *testing.T) {
 				0,                // Disable image pull throttling by setting QPS to 0,
 				0,
 				&fakePodPullingTimeRecorder{},
+				nil,
 			)
 
 			imageRef, _, err := fakeManager.imagePuller.EnsureImageExists(ctx, nil, makeTestPod("testpod", "testpod-ns", "testpod-uid", []v1.Container{}), test.imageName, test.passedSecrets, nil, "", v1.PullAlways)
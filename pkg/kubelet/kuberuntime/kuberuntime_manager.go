@@ -209,8 +209,11 @@ func NewKubeGenericRuntimeManager(
 	imagePullBurst int,
 	imagePullsCredentialVerificationPolicy string,
 	preloadedImagesCredentialVerificationWhitelist []string,
+	credentialSourceOrder []string,
 	imageCredentialProviderConfigPath string,
 	imageCredentialProviderBinDir string,
+	imageCredentialProviderConfigStrict bool,
+	credentialProviders []kubeletconfiginternal.CredentialProvider,
 	singleProcessOOMKill *bool,
 	cpuCFSQuota bool,
 	cpuCFSQuotaPeriod metav1.Duration,
@@ -281,11 +284,14 @@ func NewKubeGenericRuntimeManager(
 		"version", typedVersion.RuntimeVersion,
 		"apiVersion", typedVersion.RuntimeApiVersion)
 
-	if imageCredentialProviderConfigPath != "" || imageCredentialProviderBinDir != "" {
-		if err := plugin.RegisterCredentialProviderPlugins(imageCredentialProviderConfigPath, imageCredentialProviderBinDir, tokenManager.GetServiceAccountToken, getServiceAccount); err != nil {
+	if imageCredentialProviderConfigPath != "" || imageCredentialProviderBinDir != "" || len(credentialProviders) > 0 {
+		if err := plugin.RegisterCredentialProviderPlugins(imageCredentialProviderConfigPath, imageCredentialProviderBinDir, imageCredentialProviderConfigStrict, credentialProviders, tokenManager.GetServiceAccountToken, getServiceAccount); err != nil {
 			klog.ErrorS(err, "Failed to register CRI auth plugins")
 			os.Exit(1)
 		}
+		// Drop cached plugin credentials as soon as the service account token they
+		// were obtained with is rotated or its pod is deleted.
+		tokenManager.RegisterTokenInvalidatedCallback(plugin.InvalidateCacheForServiceAccount)
 	}
 
 	var imageGCHooks []images.PostImageGCHook
@@ -299,7 +305,11 @@ func NewKubeGenericRuntimeManager(
 			return nil, nil, err
 		}
 
-		fsRecordAccessor, err := imagepullmanager.NewFSPullRecordsAccessor(rootDirectory)
+		newRecordsAccessor := imagepullmanager.NewFSPullRecordsAccessor
+		if utilfeature.DefaultFeatureGate.Enabled(features.KubeletImagePullRecordEncryption) {
+			newRecordsAccessor = imagepullmanager.NewEncryptedFSPullRecordsAccessor
+		}
+		fsRecordAccessor, err := newRecordsAccessor(rootDirectory)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to setup the FSPullRecordsAccessor: %w", err)
 		}
@@ -313,6 +323,12 @@ func NewKubeGenericRuntimeManager(
 	}
 
 	nodeKeyring := credentialprovider.NewDefaultDockerKeyring()
+	if plugin.LegacyKeyringDisabled() {
+		// The credential provider configuration opted out of the legacy
+		// node-wide docker credential search, so don't probe
+		// /var/lib/kubelet/config.json or HOME paths at all.
+		nodeKeyring = &credentialprovider.BasicDockerKeyring{}
+	}
 	kubeRuntimeManager.imagePuller = images.NewImageManager(
 		kubecontainer.FilterEventRecorder(recorder),
 		nodeKeyring,
@@ -323,7 +339,8 @@ func NewKubeGenericRuntimeManager(
 		maxParallelImagePulls,
 		imagePullQPS,
 		imagePullBurst,
-		podPullingTimeRecorder)
+		podPullingTimeRecorder,
+		credentialSourceOrder)
 	kubeRuntimeManager.runner = lifecycle.NewHandlerRunner(insecureContainerLifecycleHTTPClient, kubeRuntimeManager, kubeRuntimeManager, recorder)
 	kubeRuntimeManager.containerGC = newContainerGC(runtimeService, podStateProvider, kubeRuntimeManager, tracer)
 	kubeRuntimeManager.podStateProvider = podStateProvider
@@ -989,6 +989,18 @@ var (
 		[]string{"image_size_in_bytes"},
 	)
 
+	// ImagePullsDelayedByCredentialResolution is a Counter of image pulls whose
+	// start was delayed because credential provider plugin invocations were
+	// saturated at the time the pull was requested.
+	ImagePullsDelayedByCredentialResolution = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Subsystem:      KubeletSubsystem,
+			Name:           "image_pulls_delayed_by_credential_resolution_total",
+			Help:           "Number of image pulls delayed because credential provider plugin invocations were saturated.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
 	LifecycleHandlerSleepTerminated = metrics.NewCounter(
 		&metrics.CounterOpts{
 			Subsystem:      KubeletSubsystem,
@@ -1094,6 +1106,7 @@ func Register(collectors ...metrics.StableCollector) {
 		legacyregistry.MustRegister(PodStartSLIDuration)
 		legacyregistry.MustRegister(PodStartTotalDuration)
 		legacyregistry.MustRegister(ImagePullDuration)
+		legacyregistry.MustRegister(ImagePullsDelayedByCredentialResolution)
 		legacyregistry.MustRegister(ImageGarbageCollectedTotal)
 		legacyregistry.MustRegister(NodeStartupPreKubeletDuration)
 		legacyregistry.MustRegister(NodeStartupPreRegistrationDuration)
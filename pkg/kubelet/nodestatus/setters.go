@@ -669,6 +669,80 @@ func PIDPressureCondition(nowFunc func() time.Time, // typically Kubelet.clock.N
 	}
 }
 
+// CredentialProviderNotReady is the node condition the kubelet reports as true
+// while a credential provider plugin marked requiredForNodeReady has not
+// passed its probe invocation, so schedulers can steer image-pulling workloads
+// away from nodes that cannot authenticate to critical registries.
+const CredentialProviderNotReady v1.NodeConditionType = "CredentialProviderNotReady"
+
+// CredentialProviderNotReadyCondition returns a Setter that updates the
+// CredentialProviderNotReady condition on the node. The condition is only
+// published when at least one credential provider is marked
+// requiredForNodeReady.
+func CredentialProviderNotReadyCondition(nowFunc func() time.Time, // typically Kubelet.clock.Now
+	anyProviderRequiredFunc func() bool, // typically credentialproviderplugin.AnyProviderRequiredForNodeReady
+	notReadyProvidersFunc func() []string, // typically credentialproviderplugin.NotReadyProviders
+	recordEventFunc func(eventType, event string), // typically Kubelet.recordNodeStatusEvent
+) Setter {
+	return func(ctx context.Context, node *v1.Node) error {
+		// Nodes without a provider gating readiness do not carry the condition
+		// at all, instead of reporting a permanently false condition.
+		if !anyProviderRequiredFunc() {
+			return nil
+		}
+
+		currentTime := metav1.NewTime(nowFunc())
+		var condition *v1.NodeCondition
+
+		// Check if CredentialProviderNotReady condition already exists and if it does, just pick it up for update.
+		for i := range node.Status.Conditions {
+			if node.Status.Conditions[i].Type == CredentialProviderNotReady {
+				condition = &node.Status.Conditions[i]
+			}
+		}
+
+		newCondition := false
+		// If the CredentialProviderNotReady condition doesn't exist, create one
+		if condition == nil {
+			condition = &v1.NodeCondition{
+				Type:   CredentialProviderNotReady,
+				Status: v1.ConditionUnknown,
+			}
+			// cannot be appended to node.Status.Conditions here because it gets
+			// copied to the slice. So if we append to the slice here none of the
+			// updates we make below are reflected in the slice.
+			newCondition = true
+		}
+
+		// Update the heartbeat time
+		condition.LastHeartbeatTime = currentTime
+
+		if notReadyProviders := notReadyProvidersFunc(); len(notReadyProviders) > 0 {
+			if condition.Status != v1.ConditionTrue {
+				condition.Status = v1.ConditionTrue
+				condition.Reason = "KubeletCredentialProviderNotReady"
+				condition.LastTransitionTime = currentTime
+				recordEventFunc(v1.EventTypeWarning, "NodeCredentialProviderNotReady")
+			}
+			// The message names the providers holding back readiness and is kept
+			// current even while the condition stays true, since the set can
+			// change across probe invocations.
+			condition.Message = fmt.Sprintf("credential provider plugins required for node readiness have not passed their probe: %s", strings.Join(notReadyProviders, ", "))
+		} else if condition.Status != v1.ConditionFalse {
+			condition.Status = v1.ConditionFalse
+			condition.Reason = "KubeletCredentialProvidersReady"
+			condition.Message = "all credential provider plugins required for node readiness have passed their probe"
+			condition.LastTransitionTime = currentTime
+			recordEventFunc(v1.EventTypeNormal, "NodeCredentialProvidersReady")
+		}
+
+		if newCondition {
+			node.Status.Conditions = append(node.Status.Conditions, *condition)
+		}
+		return nil
+	}
+}
+
 // DiskPressureCondition returns a Setter that updates the v1.NodeDiskPressure condition on the node.
 func DiskPressureCondition(nowFunc func() time.Time, // typically Kubelet.clock.Now
 	pressureFunc func() bool, // typically Kubelet.evictionManager.IsUnderDiskPressure
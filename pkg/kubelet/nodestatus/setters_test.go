@@ -23,6 +23,7 @@ import (
 	"net"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -1487,6 +1488,128 @@ func TestMemoryPressureCondition(t *testing.T) {
 	}
 }
 
+func TestCredentialProviderNotReadyCondition(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Second)
+	nowFunc := func() time.Time { return now }
+
+	cases := []struct {
+		desc              string
+		node              *v1.Node
+		anyRequired       bool
+		notReadyProviders []string
+		expectConditions  []v1.NodeCondition
+		expectEvents      []testEvent
+	}{
+		{
+			desc:             "no provider marked required, condition not published",
+			node:             &v1.Node{},
+			anyRequired:      false,
+			expectConditions: nil,
+			expectEvents:     []testEvent{},
+		},
+		{
+			desc:              "new, providers ready",
+			node:              &v1.Node{},
+			anyRequired:       true,
+			notReadyProviders: nil,
+			expectConditions:  []v1.NodeCondition{*makeCredentialProviderNotReadyCondition(nil, now, now)},
+			expectEvents: []testEvent{
+				{
+					eventType: v1.EventTypeNormal,
+					event:     "NodeCredentialProvidersReady",
+				},
+			},
+		},
+		{
+			desc:              "new, provider not ready",
+			node:              &v1.Node{},
+			anyRequired:       true,
+			notReadyProviders: []string{"acr"},
+			expectConditions:  []v1.NodeCondition{*makeCredentialProviderNotReadyCondition([]string{"acr"}, now, now)},
+			expectEvents: []testEvent{
+				{
+					eventType: v1.EventTypeWarning,
+					event:     "NodeCredentialProviderNotReady",
+				},
+			},
+		},
+		{
+			desc: "transition to ready",
+			node: &v1.Node{
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{*makeCredentialProviderNotReadyCondition([]string{"acr"}, before, before)},
+				},
+			},
+			anyRequired:       true,
+			notReadyProviders: nil,
+			expectConditions:  []v1.NodeCondition{*makeCredentialProviderNotReadyCondition(nil, now, now)},
+			expectEvents: []testEvent{
+				{
+					eventType: v1.EventTypeNormal,
+					event:     "NodeCredentialProvidersReady",
+				},
+			},
+		},
+		{
+			desc: "not ready, no transition, message tracks the provider set",
+			node: &v1.Node{
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{*makeCredentialProviderNotReadyCondition([]string{"acr"}, before, before)},
+				},
+			},
+			anyRequired:       true,
+			notReadyProviders: []string{"acr", "ecr"},
+			expectConditions:  []v1.NodeCondition{*makeCredentialProviderNotReadyCondition([]string{"acr", "ecr"}, before, now)},
+			expectEvents:      []testEvent{},
+		},
+		{
+			desc: "ready, no transition",
+			node: &v1.Node{
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{*makeCredentialProviderNotReadyCondition(nil, before, before)},
+				},
+			},
+			anyRequired:       true,
+			notReadyProviders: nil,
+			expectConditions:  []v1.NodeCondition{*makeCredentialProviderNotReadyCondition(nil, before, now)},
+			expectEvents:      []testEvent{},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := context.Background()
+			events := []testEvent{}
+			recordEventFunc := func(eventType, event string) {
+				events = append(events, testEvent{
+					eventType: eventType,
+					event:     event,
+				})
+			}
+			anyRequiredFunc := func() bool {
+				return tc.anyRequired
+			}
+			notReadyProvidersFunc := func() []string {
+				return tc.notReadyProviders
+			}
+			// construct setter
+			setter := CredentialProviderNotReadyCondition(nowFunc, anyRequiredFunc, notReadyProvidersFunc, recordEventFunc)
+			// call setter on node
+			if err := setter(ctx, tc.node); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// check expected condition
+			assert.True(t, apiequality.Semantic.DeepEqual(tc.expectConditions, tc.node.Status.Conditions),
+				"Diff: %s", cmp.Diff(tc.expectConditions, tc.node.Status.Conditions))
+			// check expected events
+			require.Equal(t, len(tc.expectEvents), len(events))
+			for i := range tc.expectEvents {
+				assert.Equal(t, tc.expectEvents[i], events[i])
+			}
+		})
+	}
+}
+
 func TestPIDPressureCondition(t *testing.T) {
 	now := time.Now()
 	before := now.Add(-time.Second)
@@ -1919,6 +2042,27 @@ func makeMemoryPressureCondition(pressure bool, transition, heartbeat time.Time)
 	}
 }
 
+func makeCredentialProviderNotReadyCondition(notReadyProviders []string, transition, heartbeat time.Time) *v1.NodeCondition {
+	if len(notReadyProviders) > 0 {
+		return &v1.NodeCondition{
+			Type:               CredentialProviderNotReady,
+			Status:             v1.ConditionTrue,
+			Reason:             "KubeletCredentialProviderNotReady",
+			Message:            "credential provider plugins required for node readiness have not passed their probe: " + strings.Join(notReadyProviders, ", "),
+			LastTransitionTime: metav1.NewTime(transition),
+			LastHeartbeatTime:  metav1.NewTime(heartbeat),
+		}
+	}
+	return &v1.NodeCondition{
+		Type:               CredentialProviderNotReady,
+		Status:             v1.ConditionFalse,
+		Reason:             "KubeletCredentialProvidersReady",
+		Message:            "all credential provider plugins required for node readiness have passed their probe",
+		LastTransitionTime: metav1.NewTime(transition),
+		LastHeartbeatTime:  metav1.NewTime(heartbeat),
+	}
+}
+
 func makePIDPressureCondition(pressure bool, transition, heartbeat time.Time) *v1.NodeCondition {
 	if pressure {
 		return &v1.NodeCondition{
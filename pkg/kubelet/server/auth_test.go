@@ -128,10 +128,11 @@ func AuthzTestCases(fineGrained bool) []AuthzTestCase {
 		"/flagz":   {"configz"},
 		"/statusz": {"statusz"},
 		"/containerLogs/{podNamespace}/{podID}/{containerName}": {"proxy"},
-		"/debug/flags/v":                                     {"proxy"},
-		"/debug/pprof/{subpath:*}":                           {"proxy"},
-		"/exec/{podNamespace}/{podID}/{containerName}":       {"proxy"},
-		"/exec/{podNamespace}/{podID}/{uid}/{containerName}": {"proxy"},
+		"/debug/credentialproviders/invalidate":                 {"proxy"},
+		"/debug/flags/v":                                        {"proxy"},
+		"/debug/pprof/{subpath:*}":                              {"proxy"},
+		"/exec/{podNamespace}/{podID}/{containerName}":          {"proxy"},
+		"/exec/{podNamespace}/{podID}/{uid}/{containerName}":    {"proxy"},
 		"/healthz":                            {"proxy"},
 		"/healthz/log":                        {"proxy"},
 		"/healthz/ping":                       {"proxy"},
@@ -82,6 +82,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/legacyscheme"
 	api "k8s.io/kubernetes/pkg/apis/core"
 	"k8s.io/kubernetes/pkg/apis/core/v1/validation"
+	credentialproviderplugin "k8s.io/kubernetes/pkg/credentialprovider/plugin"
 	"k8s.io/kubernetes/pkg/features"
 	kubeletconfiginternal "k8s.io/kubernetes/pkg/kubelet/apis/config"
 	apisgrpc "k8s.io/kubernetes/pkg/kubelet/apis/grpc"
@@ -98,17 +99,18 @@ func init() {
 }
 
 const (
-	metricsPath         = "/metrics"
-	cadvisorMetricsPath = "/metrics/cadvisor"
-	resourceMetricsPath = "/metrics/resource"
-	proberMetricsPath   = "/metrics/probes"
-	statsPath           = "/stats/"
-	logsPath            = "/logs/"
-	checkpointPath      = "/checkpoint/"
-	pprofBasePath       = "/debug/pprof/"
-	debugFlagPath       = "/debug/flags/v"
-	podsPath            = "/pods"
-	runningPodsPath     = "/runningpods/"
+	metricsPath                           = "/metrics"
+	cadvisorMetricsPath                   = "/metrics/cadvisor"
+	resourceMetricsPath                   = "/metrics/resource"
+	proberMetricsPath                     = "/metrics/probes"
+	statsPath                             = "/stats/"
+	logsPath                              = "/logs/"
+	checkpointPath                        = "/checkpoint/"
+	pprofBasePath                         = "/debug/pprof/"
+	debugFlagPath                         = "/debug/flags/v"
+	credentialProviderCacheInvalidatePath = "/debug/credentialproviders/invalidate"
+	podsPath                              = "/pods"
+	runningPodsPath                       = "/runningpods/"
 )
 
 const (
@@ -314,6 +316,7 @@ func NewServer(
 		server.InstallSystemLogHandler(kubeCfg.EnableSystemLogHandler, kubeCfg.EnableSystemLogQuery)
 		server.InstallProfilingHandler(kubeCfg.EnableProfilingHandler, kubeCfg.EnableContentionProfiling)
 		server.InstallDebugFlagsHandler(kubeCfg.EnableDebugFlagsHandler)
+		server.InstallCredentialProviderCacheInvalidateHandler()
 	} else {
 		server.InstallDebuggingDisabledHandlers()
 	}
@@ -681,6 +684,29 @@ func (s *Server) InstallDebugFlagsHandler(enableDebugFlagsHandler bool) {
 	}
 }
 
+// InstallCredentialProviderCacheInvalidateHandler registers the HTTP request pattern for
+// the /debug/credentialproviders/invalidate endpoint. A PUT request to this endpoint
+// removes credentials cached by the exec credential provider plugins, optionally scoped
+// by the "provider" and "registry" query parameters, so operators can force a credential
+// refresh after rotating registry secrets.
+func (s *Server) InstallCredentialProviderCacheInvalidateHandler() {
+	s.restfulCont.Handle(credentialProviderCacheInvalidatePath, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			http.Error(w, "only PUT requests are allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		providerName := req.URL.Query().Get("provider")
+		registry := req.URL.Query().Get("registry")
+		if err := credentialproviderplugin.InvalidateCache(providerName, registry); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
 // InstallProfilingHandler registers the HTTP request patterns for /debug/pprof endpoint.
 func (s *Server) InstallProfilingHandler(enableProfilingLogHandler bool, enableContentionProfiling bool) {
 	s.addMetricsBucketMatcher("debug")
@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	statsapi "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+	credentialproviderplugin "k8s.io/kubernetes/pkg/credentialprovider/plugin"
 	"k8s.io/kubernetes/pkg/features"
 	"k8s.io/kubernetes/pkg/kubelet/util"
 )
@@ -104,16 +105,17 @@ func (sp *summaryProviderImpl) Get(ctx context.Context, updateStats bool) (*stat
 	}
 
 	nodeStats := statsapi.NodeStats{
-		NodeName:         node.Name,
-		CPU:              rootStats.CPU,
-		Memory:           rootStats.Memory,
-		Swap:             rootStats.Swap,
-		Network:          networkStats,
-		StartTime:        sp.systemBootTime,
-		Fs:               rootFsStats,
-		Runtime:          &statsapi.RuntimeStats{ContainerFs: containerFsStats, ImageFs: imageFsStats},
-		Rlimit:           rlimit,
-		SystemContainers: sp.GetSystemContainersStats(nodeConfig, podStats, updateStats),
+		NodeName:            node.Name,
+		CPU:                 rootStats.CPU,
+		Memory:              rootStats.Memory,
+		Swap:                rootStats.Swap,
+		Network:             networkStats,
+		StartTime:           sp.systemBootTime,
+		Fs:                  rootFsStats,
+		Runtime:             &statsapi.RuntimeStats{ContainerFs: containerFsStats, ImageFs: imageFsStats},
+		Rlimit:              rlimit,
+		SystemContainers:    sp.GetSystemContainersStats(nodeConfig, podStats, updateStats),
+		CredentialProviders: credentialProviderStats(),
 	}
 	if utilfeature.DefaultFeatureGate.Enabled(features.KubeletPSI) {
 		nodeStats.IO = rootStats.IO
@@ -157,3 +159,25 @@ func (sp *summaryProviderImpl) GetCPUAndMemoryStats(ctx context.Context) (*stats
 	}
 	return &summary, nil
 }
+
+// credentialProviderStats converts the per-registry credential resolution
+// latency recorded by the external credential provider plugins into the
+// summary API representation. It returns nil when no plugin has been invoked.
+func credentialProviderStats() []statsapi.CredentialProviderStats {
+	summaries := credentialproviderplugin.RegistryLatencySummaries()
+	if len(summaries) == 0 {
+		return nil
+	}
+
+	stats := make([]statsapi.CredentialProviderStats, 0, len(summaries))
+	for _, summary := range summaries {
+		stats = append(stats, statsapi.CredentialProviderStats{
+			Registry:   summary.Registry,
+			Count:      summary.Count,
+			LatencyP50: metav1.Duration{Duration: summary.P50},
+			LatencyP90: metav1.Duration{Duration: summary.P90},
+			LatencyP99: metav1.Duration{Duration: summary.P99},
+		})
+	}
+	return stats
+}
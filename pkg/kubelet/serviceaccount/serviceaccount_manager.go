@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/kubernetes/pkg/apis/core/v1"
+	"k8s.io/kubernetes/pkg/kubelet/util/manager"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/clock"
+)
+
+// Manager manages the service accounts referenced by pods on the node. It
+// serves service account lookups for the credential provider flow, where
+// plugins consume service account annotations on every image pull, without
+// requiring an API GET per pull.
+type Manager interface {
+	// Get service account by namespace and name.
+	GetServiceAccount(namespace, name string) (*v1.ServiceAccount, error)
+
+	// WARNING: Register/UnregisterPod functions should be efficient,
+	// i.e. should not block on network operations.
+
+	// RegisterPod registers the service account of a given pod.
+	RegisterPod(pod *v1.Pod)
+
+	// UnregisterPod unregisters the service account of a given pod if it is
+	// not used by any other registered pod.
+	UnregisterPod(pod *v1.Pod)
+}
+
+// simpleServiceAccountManager implements Manager interface with
+// simple operations to apiserver.
+type simpleServiceAccountManager struct {
+	kubeClient clientset.Interface
+}
+
+// NewSimpleServiceAccountManager creates a new ServiceAccountManager instance.
+func NewSimpleServiceAccountManager(kubeClient clientset.Interface) Manager {
+	return &simpleServiceAccountManager{kubeClient: kubeClient}
+}
+
+func (s *simpleServiceAccountManager) GetServiceAccount(namespace, name string) (*v1.ServiceAccount, error) {
+	return s.kubeClient.CoreV1().ServiceAccounts(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+func (s *simpleServiceAccountManager) RegisterPod(pod *v1.Pod) {
+}
+
+func (s *simpleServiceAccountManager) UnregisterPod(pod *v1.Pod) {
+}
+
+// serviceAccountManager keeps a store with the service accounts necessary for
+// registered pods. Different implementations of the store may result in
+// different semantics for freshness of service accounts (e.g. ttl-based
+// implementation vs watch-based implementation).
+type serviceAccountManager struct {
+	manager manager.Manager
+}
+
+func (s *serviceAccountManager) GetServiceAccount(namespace, name string) (*v1.ServiceAccount, error) {
+	object, err := s.manager.GetObject(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if serviceAccount, ok := object.(*v1.ServiceAccount); ok {
+		return serviceAccount, nil
+	}
+	return nil, fmt.Errorf("unexpected object type: %v", object)
+}
+
+func (s *serviceAccountManager) RegisterPod(pod *v1.Pod) {
+	s.manager.RegisterPod(pod)
+}
+
+func (s *serviceAccountManager) UnregisterPod(pod *v1.Pod) {
+	s.manager.UnregisterPod(pod)
+}
+
+func getServiceAccountNames(pod *v1.Pod) sets.Set[string] {
+	result := sets.New[string]()
+	if len(pod.Spec.ServiceAccountName) > 0 {
+		result.Insert(pod.Spec.ServiceAccountName)
+	}
+	return result
+}
+
+const (
+	defaultTTL = time.Minute
+)
+
+// NewCachingServiceAccountManager creates a manager that keeps a cache of the
+// service accounts necessary for registered pods.
+// It implements the following logic:
+//   - whenever a pod is created or updated, the cached version of its service
+//     account is invalidated
+//   - every GetServiceAccount() call tries to fetch the value from local cache; if it is
+//     not there, invalidated or too old, we fetch it from apiserver and refresh the
+//     value in cache; otherwise it is just fetched from cache
+func NewCachingServiceAccountManager(kubeClient clientset.Interface, getTTL manager.GetObjectTTLFunc) Manager {
+	getServiceAccount := func(namespace, name string, opts metav1.GetOptions) (runtime.Object, error) {
+		return kubeClient.CoreV1().ServiceAccounts(namespace).Get(context.TODO(), name, opts)
+	}
+	serviceAccountStore := manager.NewObjectStore(getServiceAccount, clock.RealClock{}, getTTL, defaultTTL)
+	return &serviceAccountManager{
+		manager: manager.NewCacheBasedManager(serviceAccountStore, getServiceAccountNames),
+	}
+}
+
+// NewWatchingServiceAccountManager creates a manager that keeps a cache of the
+// service accounts necessary for registered pods.
+// It implements the following logic:
+//   - whenever a pod is created or updated, we start an individual watch for its
+//     referenced service account if it isn't referenced from other registered pods
+//   - every GetServiceAccount() returns a value from local cache propagated via watches
+func NewWatchingServiceAccountManager(kubeClient clientset.Interface, resyncInterval time.Duration) Manager {
+	listServiceAccount := func(namespace string, opts metav1.ListOptions) (runtime.Object, error) {
+		return kubeClient.CoreV1().ServiceAccounts(namespace).List(context.TODO(), opts)
+	}
+	watchServiceAccount := func(namespace string, opts metav1.ListOptions) (watch.Interface, error) {
+		return kubeClient.CoreV1().ServiceAccounts(namespace).Watch(context.TODO(), opts)
+	}
+	newServiceAccount := func() runtime.Object {
+		return &v1.ServiceAccount{}
+	}
+	// Service accounts are always mutable: their annotations can change at any
+	// time, and credential provider plugins key off them, so the watch is never
+	// stopped early.
+	isImmutable := func(object runtime.Object) bool {
+		return false
+	}
+	gr := corev1.Resource("serviceaccount")
+	return &serviceAccountManager{
+		manager: manager.NewWatchBasedManager(listServiceAccount, watchServiceAccount, newServiceAccount, isImmutable, gr, resyncInterval, getServiceAccountNames),
+	}
+}
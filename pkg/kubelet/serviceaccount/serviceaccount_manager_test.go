@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/kubelet/util/manager"
+	"k8s.io/utils/clock"
+)
+
+func noObjectTTL() (time.Duration, bool) {
+	return time.Duration(0), false
+}
+
+func getServiceAccount(fakeClient clientset.Interface) manager.GetObjectFunc {
+	return func(namespace, name string, opts metav1.GetOptions) (runtime.Object, error) {
+		return fakeClient.CoreV1().ServiceAccounts(namespace).Get(context.TODO(), name, opts)
+	}
+}
+
+func podWithServiceAccount(ns, podName, serviceAccountName string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      podName,
+		},
+		Spec: v1.PodSpec{
+			ServiceAccountName: serviceAccountName,
+		},
+	}
+}
+
+func TestGetServiceAccountNames(t *testing.T) {
+	if names := getServiceAccountNames(podWithServiceAccount("ns1", "pod1", "sa1")); !names.Equal(sets.New[string]("sa1")) {
+		t.Errorf("unexpected service account names: %v", names)
+	}
+	if names := getServiceAccountNames(podWithServiceAccount("ns1", "pod2", "")); names.Len() != 0 {
+		t.Errorf("expected no service account names for a pod without a service account, got: %v", names)
+	}
+}
+
+func TestCacheBasedServiceAccountManager(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset(&v1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns1",
+			Name:        "sa1",
+			Annotations: map[string]string{"domain.io/identity": "workload-1"},
+		},
+	})
+	store := manager.NewObjectStore(getServiceAccount(fakeClient), clock.RealClock{}, noObjectTTL, 0)
+	manager := &serviceAccountManager{
+		manager: manager.NewCacheBasedManager(store, getServiceAccountNames),
+	}
+
+	pod := podWithServiceAccount("ns1", "pod1", "sa1")
+	manager.RegisterPod(pod)
+
+	serviceAccount, err := manager.GetServiceAccount("ns1", "sa1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if serviceAccount.Annotations["domain.io/identity"] != "workload-1" {
+		t.Errorf("unexpected service account: %#v", serviceAccount)
+	}
+
+	// A service account not referenced by any registered pod is not served.
+	if _, err := manager.GetServiceAccount("ns1", "sa2"); err == nil || !strings.Contains(err.Error(), "not registered") {
+		t.Errorf("expected a not registered error, got: %v", err)
+	}
+
+	// Unregistering the only pod referencing the service account drops it.
+	manager.UnregisterPod(pod)
+	if _, err := manager.GetServiceAccount("ns1", "sa1"); err == nil || !strings.Contains(err.Error(), "not registered") {
+		t.Errorf("expected a not registered error, got: %v", err)
+	}
+}
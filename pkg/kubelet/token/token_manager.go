@@ -74,7 +74,7 @@ func NewManager(c clientset.Interface) *Manager {
 			}
 			return tokenRequest, err
 		},
-		cache: make(map[string]*authenticationv1.TokenRequest),
+		cache: make(map[string]*tokenCacheEntry),
 		clock: clock.RealClock{},
 	}
 	go wait.Forever(m.cleanup, gcPeriod)
@@ -86,13 +86,46 @@ type Manager struct {
 
 	// cacheMutex guards the cache
 	cacheMutex sync.RWMutex
-	cache      map[string]*authenticationv1.TokenRequest
+	cache      map[string]*tokenCacheEntry
+
+	// callbackMutex guards the invalidation callbacks
+	callbackMutex             sync.RWMutex
+	tokenInvalidatedCallbacks []func(namespace, name string)
 
 	// mocked for testing
 	getToken func(name, namespace string, tr *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error)
 	clock    clock.Clock
 }
 
+// tokenCacheEntry retains the service account coordinates alongside the cached
+// token request so invalidation callbacks can identify the identity a rotated
+// or deleted token belonged to.
+type tokenCacheEntry struct {
+	tokenRequest *authenticationv1.TokenRequest
+	namespace    string
+	name         string
+}
+
+// RegisterTokenInvalidatedCallback registers a function that is invoked with the
+// namespace and name of a service account whenever a cached token for that
+// service account is rotated, or removed because the pod it was bound to was
+// deleted. Consumers caching state derived from service account tokens (such as
+// the image credential provider plugins) use this to drop that state as soon as
+// the underlying identity changes.
+func (m *Manager) RegisterTokenInvalidatedCallback(cb func(namespace, name string)) {
+	m.callbackMutex.Lock()
+	defer m.callbackMutex.Unlock()
+	m.tokenInvalidatedCallbacks = append(m.tokenInvalidatedCallbacks, cb)
+}
+
+func (m *Manager) notifyTokenInvalidated(namespace, name string) {
+	m.callbackMutex.RLock()
+	defer m.callbackMutex.RUnlock()
+	for _, cb := range m.tokenInvalidatedCallbacks {
+		cb(namespace, name)
+	}
+}
+
 // GetServiceAccountToken gets a service account token for a pod from cache or
 // from the TokenRequest API. This process is as follows:
 // * Check the cache for the current token request.
@@ -126,7 +159,11 @@ func (m *Manager) GetServiceAccountToken(namespace, name string, tr *authenticat
 		}
 	}
 
-	m.set(key, tr)
+	m.set(key, namespace, name, tr)
+	if ok {
+		// An existing cached token was replaced: the pod's identity rotated.
+		m.notifyTokenInvalidated(namespace, name)
+	}
 	return tr, nil
 }
 
@@ -134,19 +171,27 @@ func (m *Manager) GetServiceAccountToken(namespace, name string, tr *authenticat
 // clean token manager cache.
 func (m *Manager) DeleteServiceAccountToken(podUID types.UID) {
 	m.cacheMutex.Lock()
-	defer m.cacheMutex.Unlock()
-	for k, tr := range m.cache {
-		if tr.Spec.BoundObjectRef.UID == podUID {
+	var invalidated []*tokenCacheEntry
+	for k, entry := range m.cache {
+		if entry.tokenRequest.Spec.BoundObjectRef.UID == podUID {
 			delete(m.cache, k)
+			invalidated = append(invalidated, entry)
 		}
 	}
+	m.cacheMutex.Unlock()
+
+	// Callbacks are invoked without holding cacheMutex so they may call back
+	// into the manager.
+	for _, entry := range invalidated {
+		m.notifyTokenInvalidated(entry.namespace, entry.name)
+	}
 }
 
 func (m *Manager) cleanup() {
 	m.cacheMutex.Lock()
 	defer m.cacheMutex.Unlock()
-	for k, tr := range m.cache {
-		if m.expired(tr) {
+	for k, entry := range m.cache {
+		if m.expired(entry.tokenRequest) {
 			delete(m.cache, k)
 		}
 	}
@@ -155,14 +200,17 @@ func (m *Manager) cleanup() {
 func (m *Manager) get(key string) (*authenticationv1.TokenRequest, bool) {
 	m.cacheMutex.RLock()
 	defer m.cacheMutex.RUnlock()
-	ctr, ok := m.cache[key]
-	return ctr, ok
+	entry, ok := m.cache[key]
+	if !ok {
+		return nil, false
+	}
+	return entry.tokenRequest, true
 }
 
-func (m *Manager) set(key string, tr *authenticationv1.TokenRequest) {
+func (m *Manager) set(key, namespace, name string, tr *authenticationv1.TokenRequest) {
 	m.cacheMutex.Lock()
 	defer m.cacheMutex.Unlock()
-	m.cache[key] = tr
+	m.cache[key] = &tokenCacheEntry{tokenRequest: tr, namespace: namespace, name: name}
 }
 
 func (m *Manager) expired(t *authenticationv1.TokenRequest) bool {
@@ -494,7 +494,7 @@ func TestCleanup(t *testing.T) {
 			mgr := NewManager(nil)
 			mgr.clock = clock
 
-			mgr.set("key", &authenticationv1.TokenRequest{
+			mgr.set("key", "default", "sa", &authenticationv1.TokenRequest{
 				Status: authenticationv1.TokenRequestStatus{
 					ExpirationTimestamp: metav1.Time{Time: mgr.clock.Now().Add(c.relativeExp)},
 				},
@@ -818,7 +818,7 @@ func TestKeyFunc(t *testing.T) {
 			mgr := NewManager(nil)
 			mgr.clock = testingclock.NewFakeClock(time.Time{}.Add(30 * 24 * time.Hour))
 			for _, tru := range c.trus {
-				mgr.set(getKeyFunc(tru), &authenticationv1.TokenRequest{
+				mgr.set(getKeyFunc(tru), "default", "sa", &authenticationv1.TokenRequest{
 					Status: authenticationv1.TokenRequestStatus{
 						//make sure the token cache would not be cleaned by token manager clenaup func
 						ExpirationTimestamp: metav1.Time{Time: mgr.clock.Now().Add(50 * time.Minute)},
@@ -940,3 +940,53 @@ func getTokenRequest() *authenticationv1.TokenRequest {
 func getInt64Point(v int64) *int64 {
 	return &v
 }
+
+func TestTokenInvalidatedCallback(t *testing.T) {
+	clock := testingclock.NewFakeClock(time.Time{}.Add(30 * 24 * time.Hour))
+	expSecs := int64(600)
+	mgr := NewManager(nil)
+	mgr.clock = clock
+	mgr.getToken = func(name, namespace string, tr *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
+		tr = tr.DeepCopy()
+		tr.Status.ExpirationTimestamp = metav1.Time{Time: clock.Now().Add(time.Duration(expSecs) * time.Second)}
+		return tr, nil
+	}
+
+	var invalidated []string
+	mgr.RegisterTokenInvalidatedCallback(func(namespace, name string) {
+		invalidated = append(invalidated, namespace+"/"+name)
+	})
+
+	podUID := types.UID("pod-uid")
+	tr := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expSecs,
+			BoundObjectRef: &authenticationv1.BoundObjectReference{
+				Kind: "Pod",
+				Name: "pod",
+				UID:  podUID,
+			},
+		},
+	}
+
+	if _, err := mgr.GetServiceAccountToken("default", "sa", tr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(invalidated) != 0 {
+		t.Fatalf("expected no invalidation after initial fetch, got %v", invalidated)
+	}
+
+	// Advance past the refresh threshold so the next fetch rotates the token.
+	clock.SetTime(clock.Now().Add(10 * time.Minute))
+	if _, err := mgr.GetServiceAccountToken("default", "sa", tr); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(invalidated) != 1 || invalidated[0] != "default/sa" {
+		t.Fatalf("expected invalidation after token rotation, got %v", invalidated)
+	}
+
+	mgr.DeleteServiceAccountToken(podUID)
+	if len(invalidated) != 2 || invalidated[1] != "default/sa" {
+		t.Fatalf("expected invalidation after pod deletion, got %v", invalidated)
+	}
+}
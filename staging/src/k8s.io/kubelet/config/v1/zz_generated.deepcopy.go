@@ -54,6 +54,67 @@ func (in *CredentialProvider) DeepCopyInto(out *CredentialProvider) {
 		*out = new(ServiceAccountTokenAttributes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BinaryByPlatform != nil {
+		in, out := &in.BinaryByPlatform, &out.BinaryByPlatform
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeAttributes != nil {
+		in, out := &in.NodeAttributes, &out.NodeAttributes
+		*out = new(NodeAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RefreshAheadPercent != nil {
+		in, out := &in.RefreshAheadPercent, &out.RefreshAheadPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CacheJitterPercent != nil {
+		in, out := &in.CacheJitterPercent, &out.CacheJitterPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequiredPodAnnotationKeys != nil {
+		in, out := &in.RequiredPodAnnotationKeys, &out.RequiredPodAnnotationKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OptionalPodAnnotationKeys != nil {
+		in, out := &in.OptionalPodAnnotationKeys, &out.OptionalPodAnnotationKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MetricsLabels != nil {
+		in, out := &in.MetricsLabels, &out.MetricsLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Isolation != nil {
+		in, out := &in.Isolation, &out.Isolation
+		*out = new(CredentialProviderIsolation)
+		**out = **in
+	}
+	if in.SystemdScope != nil {
+		in, out := &in.SystemdScope, &out.SystemdScope
+		*out = new(CredentialProviderSystemdScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageTransforms != nil {
+		in, out := &in.ImageTransforms, &out.ImageTransforms
+		*out = make([]ImageTransform, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -78,6 +139,16 @@ func (in *CredentialProviderConfig) DeepCopyInto(out *CredentialProviderConfig)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Pins != nil {
+		in, out := &in.Pins, &out.Pins
+		*out = make([]CredentialProviderPin, len(*in))
+		copy(*out, *in)
+	}
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(CredentialProviderConfigDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -99,6 +170,95 @@ func (in *CredentialProviderConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialProviderConfigDefaults) DeepCopyInto(out *CredentialProviderConfigDefaults) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]ExecEnvVar, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultCacheDuration != nil {
+		in, out := &in.DefaultCacheDuration, &out.DefaultCacheDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxCacheDuration != nil {
+		in, out := &in.MaxCacheDuration, &out.MaxCacheDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialProviderConfigDefaults.
+func (in *CredentialProviderConfigDefaults) DeepCopy() *CredentialProviderConfigDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialProviderConfigDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialProviderIsolation) DeepCopyInto(out *CredentialProviderIsolation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialProviderIsolation.
+func (in *CredentialProviderIsolation) DeepCopy() *CredentialProviderIsolation {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialProviderIsolation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialProviderPin) DeepCopyInto(out *CredentialProviderPin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialProviderPin.
+func (in *CredentialProviderPin) DeepCopy() *CredentialProviderPin {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialProviderPin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialProviderSystemdScope) DeepCopyInto(out *CredentialProviderSystemdScope) {
+	*out = *in
+	if in.CPUQuotaPercent != nil {
+		in, out := &in.CPUQuotaPercent, &out.CPUQuotaPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TasksMax != nil {
+		in, out := &in.TasksMax, &out.TasksMax
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialProviderSystemdScope.
+func (in *CredentialProviderSystemdScope) DeepCopy() *CredentialProviderSystemdScope {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialProviderSystemdScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecEnvVar) DeepCopyInto(out *ExecEnvVar) {
 	*out = *in
@@ -115,9 +275,72 @@ func (in *ExecEnvVar) DeepCopy() *ExecEnvVar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameMapping) DeepCopyInto(out *HostnameMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameMapping.
+func (in *HostnameMapping) DeepCopy() *HostnameMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageTransform) DeepCopyInto(out *ImageTransform) {
+	*out = *in
+	if in.MapHostname != nil {
+		in, out := &in.MapHostname, &out.MapHostname
+		*out = new(HostnameMapping)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageTransform.
+func (in *ImageTransform) DeepCopy() *ImageTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAttributes) DeepCopyInto(out *NodeAttributes) {
+	*out = *in
+	if in.AnnotationKeys != nil {
+		in, out := &in.AnnotationKeys, &out.AnnotationKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAttributes.
+func (in *NodeAttributes) DeepCopy() *NodeAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountTokenAttributes) DeepCopyInto(out *ServiceAccountTokenAttributes) {
 	*out = *in
+	if in.ServiceAccountTokenAudiences != nil {
+		in, out := &in.ServiceAccountTokenAudiences, &out.ServiceAccountTokenAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.RequireServiceAccount != nil {
 		in, out := &in.RequireServiceAccount, &out.RequireServiceAccount
 		*out = new(bool)
@@ -133,6 +356,21 @@ func (in *ServiceAccountTokenAttributes) DeepCopyInto(out *ServiceAccountTokenAt
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedServiceAccountNames != nil {
+		in, out := &in.AllowedServiceAccountNames, &out.AllowedServiceAccountNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenExpirySlack != nil {
+		in, out := &in.TokenExpirySlack, &out.TokenExpirySlack
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -155,6 +155,15 @@ type ImagePullCredentials struct {
 	// If true, it is mutually exclusive with the `kubernetesSecrets` field.
 	// +optional
 	NodePodsAccessible bool `json:"nodePodsAccessible,omitempty"`
+
+	// ExternalCredentialProviders is an index of records of the external
+	// credential provider plugins that supplied the credentials used to pull
+	// the image, along with the identity scope the credentials were resolved
+	// for. It may be set alongside `nodePodsAccessible` when the provider
+	// resolved node-wide credentials.
+	// +optional
+	// +listType=set
+	ExternalCredentialProviders []ImagePullExternalCredentialProvider `json:"externalCredentialProviders,omitempty"`
 }
 
 // ImagePullSecret is a representation of a Kubernetes secret object coordinates along
@@ -168,3 +177,26 @@ type ImagePullSecret struct {
 	// content of the secret specified by the UID/Namespace/Name coordinates.
 	CredentialHash string `json:"credentialHash"`
 }
+
+// ImagePullExternalCredentialProvider records that an image was pulled with
+// credentials from an external credential provider plugin, along with the
+// identity scope the credentials were resolved for.
+type ImagePullExternalCredentialProvider struct {
+	// Provider is the name of the credential provider plugin from the credential
+	// provider config. Empty for records migrated from a schema version that did
+	// not track the provider name.
+	Provider string `json:"provider,omitempty"`
+
+	// UID, Namespace and Name are the coordinates of the service account the
+	// credentials were resolved for. Only the fields the provider's identity
+	// scope partitions by are set: all three for service-account-scoped
+	// credentials, only Namespace for namespace-scoped ones, and none when the
+	// provider resolved node-wide credentials.
+	UID       string `json:"uid,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+
+	// CredentialHash is a SHA-256 hash of the image pull credentials the
+	// provider returned.
+	CredentialHash string `json:"credentialHash"`
+}
@@ -118,6 +118,11 @@ func (in *ImagePullCredentials) DeepCopyInto(out *ImagePullCredentials) {
 		*out = make([]ImagePullSecret, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExternalCredentialProviders != nil {
+		in, out := &in.ExternalCredentialProviders, &out.ExternalCredentialProviders
+		*out = make([]ImagePullExternalCredentialProvider, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -131,6 +136,22 @@ func (in *ImagePullCredentials) DeepCopy() *ImagePullCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullExternalCredentialProvider) DeepCopyInto(out *ImagePullExternalCredentialProvider) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullExternalCredentialProvider.
+func (in *ImagePullExternalCredentialProvider) DeepCopy() *ImagePullExternalCredentialProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullExternalCredentialProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImagePullIntent) DeepCopyInto(out *ImagePullIntent) {
 	*out = *in
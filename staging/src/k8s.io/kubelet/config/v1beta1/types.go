@@ -251,6 +251,16 @@ type KubeletConfiguration struct {
 	// +optional
 	// +listType=set
 	PreloadedImagesVerificationAllowlist []string `json:"preloadedImagesVerificationAllowlist,omitempty"`
+	// credentialSourceOrder optionally defines the order in which the kubelet consults
+	// image pull credential sources. Credentials from sources earlier in the list are
+	// attempted first. Valid entries are "PodImagePullSecrets", "NodeDockerConfig" and
+	// "ExternalCredentialProviders". Sources omitted from the list are not consulted.
+	// An empty list preserves the kubelet's historical ordering: pod imagePullSecrets
+	// first, then the node docker config, then the external credential provider plugins.
+	// Default: []
+	// +optional
+	// +listType=atomic
+	CredentialSourceOrder []string `json:"credentialSourceOrder,omitempty"`
 	// eventRecordQPS is the maximum event creations per second. If 0, there
 	// is no limit enforced. The value cannot be a negative number.
 	// Default: 50
@@ -344,6 +354,13 @@ type KubeletConfiguration struct {
 	// Default: "0s" (disabled)
 	// +optional
 	ImageMaximumGCAge metav1.Duration `json:"imageMaximumGCAge,omitempty"`
+	// invalidateCredentialsOnImageGC controls whether cached image pull credentials
+	// from external credential provider plugins are evicted for a registry once the
+	// last image from that registry has been garbage collected, so the next pull
+	// after a long idle period fetches fresh credentials.
+	// Default: false
+	// +optional
+	InvalidateCredentialsOnImageGC bool `json:"invalidateCredentialsOnImageGC,omitempty"`
 	// imageGCHighThresholdPercent is the percent of disk usage after which
 	// image garbage collection is always run. The percent is calculated by
 	// dividing this field value by 100, so this field must be between 0 and
@@ -926,6 +943,16 @@ type KubeletConfiguration struct {
 	// +featureGate=UserNamespacesSupport
 	// +optional
 	UserNamespaces *UserNamespaces `json:"userNamespaces,omitempty"`
+
+	// credentialProviders is a list of exec credential provider plugins enabled
+	// directly from the kubelet configuration, as an alternative to a separate
+	// config file or directory given via --image-credential-provider-config.
+	// Providers listed here are merged with the providers loaded from that path
+	// and are subject to the same validation; provider names must be unique
+	// across both sources.
+	// Default: nil
+	// +optional
+	CredentialProviders []CredentialProvider `json:"credentialProviders,omitempty"`
 }
 
 type KubeletAuthorizationMode string
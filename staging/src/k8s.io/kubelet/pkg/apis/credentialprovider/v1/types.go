@@ -33,6 +33,16 @@ type CredentialProviderRequest struct {
 	// to extract any information required to fetch credentials.
 	Image string `json:"image"`
 
+	// images is the full list of images the kubelet wants credentials for in this
+	// request. It is only set for providers configured with batchRequests, where a
+	// single invocation covers every image of a pod the provider matches; image
+	// remains the image being pulled and is always among the entries. The sorted
+	// list is the same for every pull of the pod. The response's auth map must cover every
+	// image in the list. requestHash covers only the image field, so plugins
+	// memoizing batched responses should key on the images themselves.
+	// +optional
+	Images []string `json:"images,omitempty"`
+
 	// serviceAccountToken is the service account token bound to the pod for which
 	// the image is being pulled. This token is only sent to the plugin if the
 	// tokenAttributes.serviceAccountTokenAudience field is configured in the kubelet's credential
@@ -44,6 +54,36 @@ type CredentialProviderRequest struct {
 	// that need to be passed to the plugin is configured in the kubelet's credential provider
 	// configuration.
 	ServiceAccountAnnotations map[string]string `json:"serviceAccountAnnotations,omitempty"`
+
+	// podAnnotations is a map of annotations on the pod for which the image is being
+	// pulled. The list of annotation keys in the pod that need to be passed to the
+	// plugin is configured via requiredPodAnnotationKeys and optionalPodAnnotationKeys
+	// in the kubelet's credential provider configuration.
+	// +optional
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+
+	// nodeTopologyLabels holds the node's topology.kubernetes.io/zone and
+	// topology.kubernetes.io/region labels, so plugins can pick regional registry
+	// or STS endpoints without calling the API server themselves. It is only sent
+	// to plugins that opt in via nodeAttributes.sendTopologyLabels in the kubelet's
+	// credential provider configuration; labels not present on the node are omitted.
+	NodeTopologyLabels map[string]string `json:"nodeTopologyLabels,omitempty"`
+
+	// nodeAnnotations is a map of annotations on the node the kubelet is running on.
+	// The list of annotation keys to pass to the plugin is configured via
+	// nodeAttributes.annotationKeys in the kubelet's credential provider
+	// configuration; keys absent from the node are omitted.
+	NodeAnnotations map[string]string `json:"nodeAnnotations,omitempty"`
+
+	// requestHash is a deterministic hash identifying the content of this request, so
+	// long-running plugins can deduplicate or memoize requests on their side. Two requests
+	// carry the same hash if and only if their image and serviceAccountAnnotations are
+	// equal; serviceAccountToken is excluded because it is rotated independently of the
+	// request content. The hash is computed as "sha256:" followed by the hex encoded
+	// sha256 of the image and the lexicographically sorted annotation key/value pairs,
+	// each terminated by a NUL byte. This canonical form is stable across kubelet
+	// versions, so plugins may persist hashes across kubelet upgrades.
+	RequestHash string `json:"requestHash,omitempty"`
 }
 
 type PluginCacheKeyType string
@@ -62,6 +102,21 @@ const (
 	GlobalPluginCacheKeyType PluginCacheKeyType = "Global"
 )
 
+// ServiceAccountTokenCacheType is the type of caching the kubelet applies to
+// credentials obtained using a service account token.
+type ServiceAccountTokenCacheType string
+
+const (
+	// ServiceAccountServiceAccountTokenCacheType means the kubelet may serve the returned
+	// credentials to any pod using the same service account (with the same annotations
+	// passed to the plugin). This is the default when the field is unset.
+	ServiceAccountServiceAccountTokenCacheType ServiceAccountTokenCacheType = "ServiceAccount"
+	// PodServiceAccountTokenCacheType means the returned credentials are bound to the pod
+	// whose service account token was used to obtain them and must not be served to any
+	// other pod, even one using the same service account.
+	PodServiceAccountTokenCacheType ServiceAccountTokenCacheType = "Pod"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // CredentialProviderResponse holds credentials that the kubelet should use for the specified
@@ -82,23 +137,49 @@ type CredentialProviderResponse struct {
 	// +optional
 	CacheDuration *metav1.Duration `json:"cacheDuration,omitempty"`
 
+	// serviceAccountTokenCacheType indicates whether credentials obtained using a service
+	// account token may be cached across pods sharing the service account, or strictly
+	// per-pod. It is only meaningful for plugins configured with tokenAttributes and is
+	// ignored otherwise. If unset, it defaults to ServiceAccount. If an invalid value is
+	// specified, the response will NOT be used by the kubelet.
+	// +optional
+	ServiceAccountTokenCacheType ServiceAccountTokenCacheType `json:"serviceAccountTokenCacheType,omitempty"`
+
+	// serviceAccountTokenAudience requests a service account token for the given
+	// audience instead of the one the request carried. The audience must be among
+	// the audiences configured in the provider's tokenAttributes; the kubelet
+	// re-mints the token and invokes the plugin again with it, and the follow-up
+	// response must not request another audience. This supports registries that
+	// federate across multiple identity providers. Naming the audience the token
+	// was already minted for is a no-op. The rest of a response requesting an
+	// audience switch is ignored.
+	// +optional
+	ServiceAccountTokenAudience string `json:"serviceAccountTokenAudience,omitempty"`
+
 	// auth is a map containing authentication information passed into the kubelet.
 	// Each key is a match image string (more on this below). The corresponding authConfig value
 	// should be valid for all images that match against this key. A plugin should set
 	// this field to null if no valid credentials can be returned for the requested image.
 	//
 	// Each key in the map is a pattern which can optionally contain a port and a path.
-	// Globs can be used in the domain, but not in the port or the path. Globs are supported
-	// as subdomains like '*.k8s.io' or 'k8s.*.io', and top-level-domains such as 'k8s.*'.
-	// Matching partial subdomains like 'app*.k8s.io' is also supported. Each glob can only match
-	// a single subdomain segment, so *.io does not match *.k8s.io.
+	// Globs can be used in the domain and in the path, but not in the port. Domain globs
+	// are supported as subdomains like '*.k8s.io' or 'k8s.*.io', and top-level-domains
+	// such as 'k8s.*'. Matching partial subdomains like 'app*.k8s.io' is also supported.
+	// Each glob can only match a single subdomain segment, so *.io does not match
+	// *.k8s.io. In the path, '*' matches exactly one path segment and '**', which may
+	// only appear as the final segment, matches any number of remaining segments,
+	// including none. This allows credentials scoped to a repository namespace, such as
+	// 'registry.io/team-a/*'.
 	//
 	// The kubelet will match images against the key when all of the below are true:
 	// - Both contain the same number of domain parts and each part matches.
-	// - The URL path of an imageMatch must be a prefix of the target image URL path.
+	// - The URL path of an imageMatch without wildcards must be a prefix of the target
+	//   image URL path; paths with wildcards are matched segment by segment.
 	// - If the imageMatch contains a port, then the port must match in the image as well.
 	//
-	// When multiple keys are returned, the kubelet will traverse all keys in reverse order so that:
+	// When multiple keys are returned, the kubelet will traverse all keys so that:
+	// - keys scoped to deeper repository paths come before shallower ones, so the most
+	//   specifically scoped credentials are attempted first (longest prefix wins)
 	// - longer keys come before shorter keys with the same prefix
 	// - non-wildcard keys come before wildcard keys with the same prefix.
 	//
@@ -126,4 +207,102 @@ type AuthConfig struct {
 	// password is the password used for authenticating to the container registry
 	// An empty password is valid.
 	Password string `json:"password"`
+
+	// headers is an optional list of HTTP headers to attach to registry requests,
+	// for registries fronted by bearer-token or custom-header auth schemes that
+	// cannot be expressed as basic auth. Headers the container runtime cannot
+	// represent are dropped by the kubelet.
+	// +optional
+	Headers []AuthConfigHeader `json:"headers,omitempty"`
+
+	// credentialUse lists the purposes the kubelet may use these credentials for.
+	// This allows plugins to return separate credentials for fetching signatures
+	// or attestations served from a different endpoint than the image registry.
+	// If empty, the credentials are treated as pull credentials.
+	// +optional
+	CredentialUse []AuthConfigCredentialUse `json:"credentialUse,omitempty"`
+
+	// pullEndpoint is an optional registry endpoint the kubelet should prefer
+	// when pulling images matching this key, for plugins that know about
+	// geo-local registry replicas. It is forwarded to the container runtime as
+	// a hint; runtimes that do not support endpoint overrides ignore it.
+	// +optional
+	PullEndpoint string `json:"pullEndpoint,omitempty"`
+
+	// tls is optional client TLS material to present to registries requiring
+	// mutual TLS. It is forwarded to the container runtime; runtimes that do
+	// not support client certificates ignore it.
+	// +optional
+	TLS *AuthConfigTLS `json:"tls,omitempty"`
+
+	// signing is an optional set of request signing parameters, for registries
+	// that refuse long-lived static credentials and require each request to be
+	// signed, such as registries fronted by AWS SigV4. When set, the kubelet
+	// resolves the parameters through a signer registered for the algorithm
+	// instead of presenting static credentials; credentials naming an
+	// algorithm the kubelet has no signer for are skipped.
+	// +optional
+	Signing *AuthConfigSigning `json:"signing,omitempty"`
+}
+
+// AuthConfigTLS is the client TLS material presented to a registry requiring
+// mutual TLS. Material may be given inline as PEM or as absolute paths to
+// files on the node; inline and file forms must not be mixed for the same
+// credential.
+type AuthConfigTLS struct {
+	// clientCertificate is the PEM-encoded client certificate chain.
+	// +optional
+	ClientCertificate string `json:"clientCertificate,omitempty"`
+
+	// clientKey is the PEM-encoded private key for clientCertificate.
+	// +optional
+	ClientKey string `json:"clientKey,omitempty"`
+
+	// clientCertificateFile is the absolute path on the node to the PEM-encoded
+	// client certificate chain.
+	// +optional
+	ClientCertificateFile string `json:"clientCertificateFile,omitempty"`
+
+	// clientKeyFile is the absolute path on the node to the PEM-encoded private
+	// key for clientCertificateFile.
+	// +optional
+	ClientKeyFile string `json:"clientKeyFile,omitempty"`
 }
+
+// AuthConfigSigning describes request signing parameters returned by a plugin
+// instead of static credentials, for registries that require each request to
+// be signed.
+type AuthConfigSigning struct {
+	// algorithm identifies the signing scheme, for example "aws-sigv4". The
+	// kubelet must have a signer registered for the algorithm for the
+	// credential to be usable.
+	Algorithm string `json:"algorithm"`
+
+	// keyReference names the signing key the kubelet-side signer should use,
+	// such as an IAM role ARN or a key alias. Its interpretation is specific
+	// to the algorithm.
+	// +optional
+	KeyReference string `json:"keyReference,omitempty"`
+}
+
+// AuthConfigHeader is a single HTTP header attached to registry requests.
+type AuthConfigHeader struct {
+	// name is the name of the HTTP header.
+	Name string `json:"name"`
+
+	// value is the value of the HTTP header.
+	Value string `json:"value"`
+}
+
+// AuthConfigCredentialUse is a purpose the kubelet may use returned credentials for.
+type AuthConfigCredentialUse string
+
+const (
+	// PullAuthConfigCredentialUse means the credentials may be presented to the
+	// image registry to pull image content.
+	PullAuthConfigCredentialUse AuthConfigCredentialUse = "Pull"
+	// SignatureFetchAuthConfigCredentialUse means the credentials may be presented
+	// when fetching image signatures or attestations, which may be served from a
+	// different endpoint than the image registry.
+	SignatureFetchAuthConfigCredentialUse AuthConfigCredentialUse = "SignatureFetch"
+)
@@ -47,6 +47,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuthConfigSigning)(nil), (*credentialprovider.AuthConfigSigning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuthConfigSigning_To_credentialprovider_AuthConfigSigning(a.(*AuthConfigSigning), b.(*credentialprovider.AuthConfigSigning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*credentialprovider.AuthConfigSigning)(nil), (*AuthConfigSigning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_credentialprovider_AuthConfigSigning_To_v1_AuthConfigSigning(a.(*credentialprovider.AuthConfigSigning), b.(*AuthConfigSigning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuthConfigTLS)(nil), (*credentialprovider.AuthConfigTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuthConfigTLS_To_credentialprovider_AuthConfigTLS(a.(*AuthConfigTLS), b.(*credentialprovider.AuthConfigTLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*credentialprovider.AuthConfigTLS)(nil), (*AuthConfigTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_credentialprovider_AuthConfigTLS_To_v1_AuthConfigTLS(a.(*credentialprovider.AuthConfigTLS), b.(*AuthConfigTLS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CredentialProviderRequest)(nil), (*credentialprovider.CredentialProviderRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CredentialProviderRequest_To_credentialprovider_CredentialProviderRequest(a.(*CredentialProviderRequest), b.(*credentialprovider.CredentialProviderRequest), scope)
 	}); err != nil {
@@ -73,6 +93,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 func autoConvert_v1_AuthConfig_To_credentialprovider_AuthConfig(in *AuthConfig, out *credentialprovider.AuthConfig, s conversion.Scope) error {
 	out.Username = in.Username
 	out.Password = in.Password
+	out.Headers = *(*[]credentialprovider.AuthConfigHeader)(unsafe.Pointer(&in.Headers))
+	out.CredentialUse = *(*[]credentialprovider.AuthConfigCredentialUse)(unsafe.Pointer(&in.CredentialUse))
+	out.PullEndpoint = in.PullEndpoint
+	out.TLS = (*credentialprovider.AuthConfigTLS)(unsafe.Pointer(in.TLS))
+	out.Signing = (*credentialprovider.AuthConfigSigning)(unsafe.Pointer(in.Signing))
 	return nil
 }
 
@@ -84,6 +109,11 @@ func Convert_v1_AuthConfig_To_credentialprovider_AuthConfig(in *AuthConfig, out
 func autoConvert_credentialprovider_AuthConfig_To_v1_AuthConfig(in *credentialprovider.AuthConfig, out *AuthConfig, s conversion.Scope) error {
 	out.Username = in.Username
 	out.Password = in.Password
+	out.Headers = *(*[]AuthConfigHeader)(unsafe.Pointer(&in.Headers))
+	out.CredentialUse = *(*[]AuthConfigCredentialUse)(unsafe.Pointer(&in.CredentialUse))
+	out.PullEndpoint = in.PullEndpoint
+	out.TLS = (*AuthConfigTLS)(unsafe.Pointer(in.TLS))
+	out.Signing = (*AuthConfigSigning)(unsafe.Pointer(in.Signing))
 	return nil
 }
 
@@ -92,10 +122,63 @@ func Convert_credentialprovider_AuthConfig_To_v1_AuthConfig(in *credentialprovid
 	return autoConvert_credentialprovider_AuthConfig_To_v1_AuthConfig(in, out, s)
 }
 
+func autoConvert_v1_AuthConfigSigning_To_credentialprovider_AuthConfigSigning(in *AuthConfigSigning, out *credentialprovider.AuthConfigSigning, s conversion.Scope) error {
+	out.Algorithm = in.Algorithm
+	out.KeyReference = in.KeyReference
+	return nil
+}
+
+// Convert_v1_AuthConfigSigning_To_credentialprovider_AuthConfigSigning is an autogenerated conversion function.
+func Convert_v1_AuthConfigSigning_To_credentialprovider_AuthConfigSigning(in *AuthConfigSigning, out *credentialprovider.AuthConfigSigning, s conversion.Scope) error {
+	return autoConvert_v1_AuthConfigSigning_To_credentialprovider_AuthConfigSigning(in, out, s)
+}
+
+func autoConvert_credentialprovider_AuthConfigSigning_To_v1_AuthConfigSigning(in *credentialprovider.AuthConfigSigning, out *AuthConfigSigning, s conversion.Scope) error {
+	out.Algorithm = in.Algorithm
+	out.KeyReference = in.KeyReference
+	return nil
+}
+
+// Convert_credentialprovider_AuthConfigSigning_To_v1_AuthConfigSigning is an autogenerated conversion function.
+func Convert_credentialprovider_AuthConfigSigning_To_v1_AuthConfigSigning(in *credentialprovider.AuthConfigSigning, out *AuthConfigSigning, s conversion.Scope) error {
+	return autoConvert_credentialprovider_AuthConfigSigning_To_v1_AuthConfigSigning(in, out, s)
+}
+
+func autoConvert_v1_AuthConfigTLS_To_credentialprovider_AuthConfigTLS(in *AuthConfigTLS, out *credentialprovider.AuthConfigTLS, s conversion.Scope) error {
+	out.ClientCertificate = in.ClientCertificate
+	out.ClientKey = in.ClientKey
+	out.ClientCertificateFile = in.ClientCertificateFile
+	out.ClientKeyFile = in.ClientKeyFile
+	return nil
+}
+
+// Convert_v1_AuthConfigTLS_To_credentialprovider_AuthConfigTLS is an autogenerated conversion function.
+func Convert_v1_AuthConfigTLS_To_credentialprovider_AuthConfigTLS(in *AuthConfigTLS, out *credentialprovider.AuthConfigTLS, s conversion.Scope) error {
+	return autoConvert_v1_AuthConfigTLS_To_credentialprovider_AuthConfigTLS(in, out, s)
+}
+
+func autoConvert_credentialprovider_AuthConfigTLS_To_v1_AuthConfigTLS(in *credentialprovider.AuthConfigTLS, out *AuthConfigTLS, s conversion.Scope) error {
+	out.ClientCertificate = in.ClientCertificate
+	out.ClientKey = in.ClientKey
+	out.ClientCertificateFile = in.ClientCertificateFile
+	out.ClientKeyFile = in.ClientKeyFile
+	return nil
+}
+
+// Convert_credentialprovider_AuthConfigTLS_To_v1_AuthConfigTLS is an autogenerated conversion function.
+func Convert_credentialprovider_AuthConfigTLS_To_v1_AuthConfigTLS(in *credentialprovider.AuthConfigTLS, out *AuthConfigTLS, s conversion.Scope) error {
+	return autoConvert_credentialprovider_AuthConfigTLS_To_v1_AuthConfigTLS(in, out, s)
+}
+
 func autoConvert_v1_CredentialProviderRequest_To_credentialprovider_CredentialProviderRequest(in *CredentialProviderRequest, out *credentialprovider.CredentialProviderRequest, s conversion.Scope) error {
 	out.Image = in.Image
+	out.Images = *(*[]string)(unsafe.Pointer(&in.Images))
 	out.ServiceAccountToken = in.ServiceAccountToken
 	out.ServiceAccountAnnotations = *(*map[string]string)(unsafe.Pointer(&in.ServiceAccountAnnotations))
+	out.PodAnnotations = *(*map[string]string)(unsafe.Pointer(&in.PodAnnotations))
+	out.NodeTopologyLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeTopologyLabels))
+	out.NodeAnnotations = *(*map[string]string)(unsafe.Pointer(&in.NodeAnnotations))
+	out.RequestHash = in.RequestHash
 	return nil
 }
 
@@ -106,8 +189,13 @@ func Convert_v1_CredentialProviderRequest_To_credentialprovider_CredentialProvid
 
 func autoConvert_credentialprovider_CredentialProviderRequest_To_v1_CredentialProviderRequest(in *credentialprovider.CredentialProviderRequest, out *CredentialProviderRequest, s conversion.Scope) error {
 	out.Image = in.Image
+	out.Images = *(*[]string)(unsafe.Pointer(&in.Images))
 	out.ServiceAccountToken = in.ServiceAccountToken
 	out.ServiceAccountAnnotations = *(*map[string]string)(unsafe.Pointer(&in.ServiceAccountAnnotations))
+	out.PodAnnotations = *(*map[string]string)(unsafe.Pointer(&in.PodAnnotations))
+	out.NodeTopologyLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeTopologyLabels))
+	out.NodeAnnotations = *(*map[string]string)(unsafe.Pointer(&in.NodeAnnotations))
+	out.RequestHash = in.RequestHash
 	return nil
 }
 
@@ -119,6 +207,8 @@ func Convert_credentialprovider_CredentialProviderRequest_To_v1_CredentialProvid
 func autoConvert_v1_CredentialProviderResponse_To_credentialprovider_CredentialProviderResponse(in *CredentialProviderResponse, out *credentialprovider.CredentialProviderResponse, s conversion.Scope) error {
 	out.CacheKeyType = credentialprovider.PluginCacheKeyType(in.CacheKeyType)
 	out.CacheDuration = (*metav1.Duration)(unsafe.Pointer(in.CacheDuration))
+	out.ServiceAccountTokenCacheType = credentialprovider.ServiceAccountTokenCacheType(in.ServiceAccountTokenCacheType)
+	out.ServiceAccountTokenAudience = in.ServiceAccountTokenAudience
 	out.Auth = *(*map[string]credentialprovider.AuthConfig)(unsafe.Pointer(&in.Auth))
 	return nil
 }
@@ -131,6 +221,8 @@ func Convert_v1_CredentialProviderResponse_To_credentialprovider_CredentialProvi
 func autoConvert_credentialprovider_CredentialProviderResponse_To_v1_CredentialProviderResponse(in *credentialprovider.CredentialProviderResponse, out *CredentialProviderResponse, s conversion.Scope) error {
 	out.CacheKeyType = PluginCacheKeyType(in.CacheKeyType)
 	out.CacheDuration = (*metav1.Duration)(unsafe.Pointer(in.CacheDuration))
+	out.ServiceAccountTokenCacheType = ServiceAccountTokenCacheType(in.ServiceAccountTokenCacheType)
+	out.ServiceAccountTokenAudience = in.ServiceAccountTokenAudience
 	out.Auth = *(*map[string]AuthConfig)(unsafe.Pointer(&in.Auth))
 	return nil
 }
@@ -84,6 +84,11 @@ func Convert_v1alpha1_AuthConfig_To_credentialprovider_AuthConfig(in *AuthConfig
 func autoConvert_credentialprovider_AuthConfig_To_v1alpha1_AuthConfig(in *credentialprovider.AuthConfig, out *AuthConfig, s conversion.Scope) error {
 	out.Username = in.Username
 	out.Password = in.Password
+	// WARNING: in.Headers requires manual conversion: does not exist in peer-type
+	// WARNING: in.CredentialUse requires manual conversion: does not exist in peer-type
+	// WARNING: in.PullEndpoint requires manual conversion: does not exist in peer-type
+	// WARNING: in.TLS requires manual conversion: does not exist in peer-type
+	// WARNING: in.Signing requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -104,15 +109,32 @@ func Convert_v1alpha1_CredentialProviderRequest_To_credentialprovider_Credential
 
 func autoConvert_credentialprovider_CredentialProviderRequest_To_v1alpha1_CredentialProviderRequest(in *credentialprovider.CredentialProviderRequest, out *CredentialProviderRequest, s conversion.Scope) error {
 	out.Image = in.Image
+	// WARNING: in.Images requires manual conversion: does not exist in peer-type
 	// WARNING: in.ServiceAccountToken requires manual conversion: does not exist in peer-type
 	// WARNING: in.ServiceAccountAnnotations requires manual conversion: does not exist in peer-type
+	// WARNING: in.PodAnnotations requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeTopologyLabels requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeAnnotations requires manual conversion: does not exist in peer-type
+	// WARNING: in.RequestHash requires manual conversion: does not exist in peer-type
 	return nil
 }
 
 func autoConvert_v1alpha1_CredentialProviderResponse_To_credentialprovider_CredentialProviderResponse(in *CredentialProviderResponse, out *credentialprovider.CredentialProviderResponse, s conversion.Scope) error {
 	out.CacheKeyType = credentialprovider.PluginCacheKeyType(in.CacheKeyType)
 	out.CacheDuration = (*v1.Duration)(unsafe.Pointer(in.CacheDuration))
-	out.Auth = *(*map[string]credentialprovider.AuthConfig)(unsafe.Pointer(&in.Auth))
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = make(map[string]credentialprovider.AuthConfig, len(*in))
+		for key, val := range *in {
+			newVal := new(credentialprovider.AuthConfig)
+			if err := Convert_v1alpha1_AuthConfig_To_credentialprovider_AuthConfig(&val, newVal, s); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.Auth = nil
+	}
 	return nil
 }
 
@@ -124,7 +146,21 @@ func Convert_v1alpha1_CredentialProviderResponse_To_credentialprovider_Credentia
 func autoConvert_credentialprovider_CredentialProviderResponse_To_v1alpha1_CredentialProviderResponse(in *credentialprovider.CredentialProviderResponse, out *CredentialProviderResponse, s conversion.Scope) error {
 	out.CacheKeyType = PluginCacheKeyType(in.CacheKeyType)
 	out.CacheDuration = (*v1.Duration)(unsafe.Pointer(in.CacheDuration))
-	out.Auth = *(*map[string]AuthConfig)(unsafe.Pointer(&in.Auth))
+	// WARNING: in.ServiceAccountTokenCacheType requires manual conversion: does not exist in peer-type
+	// WARNING: in.ServiceAccountTokenAudience requires manual conversion: does not exist in peer-type
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = make(map[string]AuthConfig, len(*in))
+		for key, val := range *in {
+			newVal := new(AuthConfig)
+			if err := Convert_credentialprovider_AuthConfig_To_v1alpha1_AuthConfig(&val, newVal, s); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.Auth = nil
+	}
 	return nil
 }
 
@@ -29,6 +29,26 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthConfig) DeepCopyInto(out *AuthConfig) {
 	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]AuthConfigHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialUse != nil {
+		in, out := &in.CredentialUse, &out.CredentialUse
+		*out = make([]AuthConfigCredentialUse, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(AuthConfigTLS)
+		**out = **in
+	}
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(AuthConfigSigning)
+		**out = **in
+	}
 	return
 }
 
@@ -42,10 +62,63 @@ func (in *AuthConfig) DeepCopy() *AuthConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthConfigHeader) DeepCopyInto(out *AuthConfigHeader) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthConfigHeader.
+func (in *AuthConfigHeader) DeepCopy() *AuthConfigHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthConfigHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthConfigSigning) DeepCopyInto(out *AuthConfigSigning) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthConfigSigning.
+func (in *AuthConfigSigning) DeepCopy() *AuthConfigSigning {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthConfigSigning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthConfigTLS) DeepCopyInto(out *AuthConfigTLS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthConfigTLS.
+func (in *AuthConfigTLS) DeepCopy() *AuthConfigTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthConfigTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CredentialProviderRequest) DeepCopyInto(out *CredentialProviderRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ServiceAccountAnnotations != nil {
 		in, out := &in.ServiceAccountAnnotations, &out.ServiceAccountAnnotations
 		*out = make(map[string]string, len(*in))
@@ -53,6 +126,27 @@ func (in *CredentialProviderRequest) DeepCopyInto(out *CredentialProviderRequest
 			(*out)[key] = val
 		}
 	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTopologyLabels != nil {
+		in, out := &in.NodeTopologyLabels, &out.NodeTopologyLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeAnnotations != nil {
+		in, out := &in.NodeAnnotations, &out.NodeAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -87,7 +181,7 @@ func (in *CredentialProviderResponse) DeepCopyInto(out *CredentialProviderRespon
 		in, out := &in.Auth, &out.Auth
 		*out = make(map[string]AuthConfig, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 	return
@@ -65,6 +65,32 @@ type NodeStats struct {
 	// Stats pertaining to swap resources. This is reported to non-windows systems only.
 	// +optional
 	Swap *SwapStats `json:"swap,omitempty"`
+	// Stats about registry credential resolution performed by the kubelet's
+	// external credential provider plugins, per registry. Only registries for
+	// which a plugin has been invoked are listed.
+	// +optional
+	// +patchMergeKey=registry
+	// +patchStrategy=merge
+	CredentialProviders []CredentialProviderStats `json:"credentialProviders,omitempty" patchStrategy:"merge" patchMergeKey:"registry"`
+}
+
+// CredentialProviderStats are stats about the registry credential resolution
+// the kubelet's external credential provider plugins performed for a single
+// registry.
+type CredentialProviderStats struct {
+	// The registry host credentials were resolved for.
+	Registry string `json:"registry"`
+	// The number of credential resolutions for this registry since kubelet start,
+	// including failed ones.
+	Count uint64 `json:"count"`
+	// Latency percentiles of credential resolution as observed by image pulls,
+	// computed over a sliding window of recent resolutions.
+	// +optional
+	LatencyP50 metav1.Duration `json:"latencyP50,omitempty"`
+	// +optional
+	LatencyP90 metav1.Duration `json:"latencyP90,omitempty"`
+	// +optional
+	LatencyP99 metav1.Duration `json:"latencyP99,omitempty"`
 }
 
 // RlimitStats are stats rlimit of OS.
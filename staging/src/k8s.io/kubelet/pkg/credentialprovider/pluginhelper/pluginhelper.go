@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pluginhelper implements the plugin side of the kubelet credential
+// provider protocol, so plugin authors only implement credential resolution
+// and leave decoding, encoding, version negotiation and the process main
+// loop to this package.
+//
+// A plugin implements the CredentialProvider interface against the internal
+// API types and calls Serve from its main function, or ServeDaemon if the
+// provider is configured with reuseProcess. The helper decodes requests from
+// any supported API version and encodes the response in the same version the
+// kubelet used, so a single plugin binary serves kubelets configured with any
+// of v1alpha1, v1beta1 or v1.
+package pluginhelper
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/kubelet/pkg/apis/credentialprovider"
+	"k8s.io/kubelet/pkg/apis/credentialprovider/install"
+	credentialproviderv1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1"
+	credentialproviderv1alpha1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1alpha1"
+	credentialproviderv1beta1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1beta1"
+)
+
+// maxFrameSize is the maximum size of a single length-prefixed frame accepted
+// in daemon mode. It matches the bound the kubelet enforces on responses.
+const maxFrameSize = 16 << 20
+
+var (
+	scheme = runtime.NewScheme()
+	// codecs is deliberately not strict: requests from a kubelet using a newer
+	// API minor version than this library may carry fields the library does not
+	// know about, and dropping them is always safe on the plugin side.
+	codecs = serializer.NewCodecFactory(scheme)
+
+	supportedVersions = map[schema.GroupVersion]bool{
+		credentialproviderv1alpha1.SchemeGroupVersion: true,
+		credentialproviderv1beta1.SchemeGroupVersion:  true,
+		credentialproviderv1.SchemeGroupVersion:       true,
+	}
+)
+
+func init() {
+	install.Install(scheme)
+}
+
+// CredentialProvider is the interface a credential provider plugin implements.
+// GetCredentials resolves registry credentials for the image in the request
+// and returns them along with caching instructions. The request and response
+// use the internal API types; the version spoken on the wire is negotiated by
+// this package and never visible to the implementation.
+type CredentialProvider interface {
+	GetCredentials(ctx context.Context, request *credentialprovider.CredentialProviderRequest) (*credentialprovider.CredentialProviderResponse, error)
+}
+
+// CredentialProviderFunc adapts a plain function to the CredentialProvider
+// interface.
+type CredentialProviderFunc func(ctx context.Context, request *credentialprovider.CredentialProviderRequest) (*credentialprovider.CredentialProviderResponse, error)
+
+// GetCredentials calls f.
+func (f CredentialProviderFunc) GetCredentials(ctx context.Context, request *credentialprovider.CredentialProviderRequest) (*credentialprovider.CredentialProviderResponse, error) {
+	return f(ctx, request)
+}
+
+// DecodeRequest decodes data into the internal CredentialProviderRequest type.
+// It accepts any supported API version and returns the version the request was
+// encoded in, which the response must be encoded in as well.
+func DecodeRequest(data []byte) (*credentialprovider.CredentialProviderRequest, schema.GroupVersion, error) {
+	obj, gvk, err := codecs.UniversalDecoder().Decode(data, nil, nil)
+	if err != nil {
+		return nil, schema.GroupVersion{}, err
+	}
+
+	if gvk.Kind != "CredentialProviderRequest" {
+		return nil, schema.GroupVersion{}, fmt.Errorf("failed to decode CredentialProviderRequest, unexpected Kind: %q", gvk.Kind)
+	}
+
+	if gvk.Group != credentialprovider.GroupName {
+		return nil, schema.GroupVersion{}, fmt.Errorf("failed to decode CredentialProviderRequest, unexpected Group: %s", gvk.Group)
+	}
+
+	request, ok := obj.(*credentialprovider.CredentialProviderRequest)
+	if !ok {
+		return nil, schema.GroupVersion{}, fmt.Errorf("unable to convert %T to *CredentialProviderRequest", obj)
+	}
+
+	return request, gvk.GroupVersion(), nil
+}
+
+// EncodeResponse encodes the internal CredentialProviderResponse type into the
+// given API version in json. The version must be the one the request was
+// decoded from, so the kubelet receives the response in the version it spoke.
+func EncodeResponse(response *credentialprovider.CredentialProviderResponse, gv schema.GroupVersion) ([]byte, error) {
+	if !supportedVersions[gv] {
+		return nil, fmt.Errorf("%s is not a supported credential provider API version", gv)
+	}
+
+	mediaType := "application/json"
+	info, ok := runtime.SerializerInfoForMediaType(codecs.SupportedMediaTypes(), mediaType)
+	if !ok {
+		return nil, fmt.Errorf("unsupported media type %q", mediaType)
+	}
+
+	data, err := runtime.Encode(codecs.EncoderForVersion(info.Serializer, gv), response)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding response: %w", err)
+	}
+
+	return data, nil
+}
+
+// handleRequest decodes a single encoded request, resolves credentials through
+// the provider and returns the encoded response in the request's API version.
+func handleRequest(ctx context.Context, provider CredentialProvider, data []byte) ([]byte, error) {
+	request, gv, err := DecodeRequest(data)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding request: %w", err)
+	}
+
+	response, err := provider.GetCredentials(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, errors.New("credential provider returned a nil response without error")
+	}
+
+	return EncodeResponse(response, gv)
+}
+
+// Serve handles a single request/response exchange over the plugin's stdin and
+// stdout, as the kubelet performs for providers without reuseProcess. It is
+// intended to be called from the plugin's main function; a returned error
+// should be printed to stderr before exiting non-zero, which the kubelet
+// records as a plugin failure.
+func Serve(ctx context.Context, provider CredentialProvider) error {
+	return serve(ctx, provider, os.Stdin, os.Stdout)
+}
+
+func serve(ctx context.Context, provider CredentialProvider, in io.Reader, out io.Writer) error {
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("error reading request: %w", err)
+	}
+
+	response, err := handleRequest(ctx, provider, data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := out.Write(response); err != nil {
+		return fmt.Errorf("error writing response: %w", err)
+	}
+
+	return nil
+}
+
+// ServeDaemon handles request/response exchanges over the plugin's stdin and
+// stdout as length-prefixed frames (a 4-byte big-endian length followed by
+// the encoded request or response) until stdin is closed, as the kubelet
+// performs for providers with reuseProcess. Diagnostics must go to stderr;
+// stdout carries the framing protocol. A resolution failure terminates the
+// process since the kubelet restarts the plugin on the next invocation, which
+// keeps the failure reporting identical to the one-shot mode.
+func ServeDaemon(ctx context.Context, provider CredentialProvider) error {
+	return serveDaemon(ctx, provider, os.Stdin, os.Stdout)
+}
+
+func serveDaemon(ctx context.Context, provider CredentialProvider, in io.Reader, out io.Writer) error {
+	for {
+		data, err := readFrame(in)
+		if err != nil {
+			// A closed stdin is the kubelet's shutdown signal.
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("error reading request frame: %w", err)
+		}
+
+		response, err := handleRequest(ctx, provider, data)
+		if err != nil {
+			return err
+		}
+
+		if err := writeFrame(out, response); err != nil {
+			return fmt.Errorf("error writing response frame: %w", err)
+		}
+	}
+}
+
+// writeFrame writes data prefixed with its length as a 4-byte big-endian integer.
+func writeFrame(w io.Writer, data []byte) error {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+	if _, err := w.Write(length[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// readFrame reads a single length-prefixed frame written by writeFrame.
+func readFrame(r io.Reader) ([]byte, error) {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size > maxFrameSize {
+		return nil, fmt.Errorf("frame of %d bytes exceeds maximum of %d bytes", size, maxFrameSize)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
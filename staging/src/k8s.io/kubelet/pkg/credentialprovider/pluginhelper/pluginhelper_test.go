@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pluginhelper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kubelet/pkg/apis/credentialprovider"
+	credentialproviderv1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1"
+	credentialproviderv1alpha1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1alpha1"
+	credentialproviderv1beta1 "k8s.io/kubelet/pkg/apis/credentialprovider/v1beta1"
+)
+
+// staticProvider returns the same credentials for every request.
+type staticProvider struct {
+	username string
+	password string
+}
+
+func (s *staticProvider) GetCredentials(_ context.Context, request *credentialprovider.CredentialProviderRequest) (*credentialprovider.CredentialProviderResponse, error) {
+	return &credentialprovider.CredentialProviderResponse{
+		CacheKeyType: credentialprovider.ImagePluginCacheKeyType,
+		Auth: map[string]credentialprovider.AuthConfig{
+			request.Image: {Username: s.username, Password: s.password},
+		},
+	}, nil
+}
+
+func encodedRequest(t *testing.T, apiVersion, image string) []byte {
+	t.Helper()
+	return []byte(fmt.Sprintf(`{"apiVersion":%q,"kind":"CredentialProviderRequest","image":%q}`, apiVersion, image))
+}
+
+func Test_DecodeRequest(t *testing.T) {
+	testcases := []struct {
+		name       string
+		data       []byte
+		expectedGV schema.GroupVersion
+		expectErr  string
+	}{
+		{
+			name:       "v1alpha1 request",
+			data:       encodedRequest(t, "credentialprovider.kubelet.k8s.io/v1alpha1", "test.registry.io/foo/bar"),
+			expectedGV: credentialproviderv1alpha1.SchemeGroupVersion,
+		},
+		{
+			name:       "v1beta1 request",
+			data:       encodedRequest(t, "credentialprovider.kubelet.k8s.io/v1beta1", "test.registry.io/foo/bar"),
+			expectedGV: credentialproviderv1beta1.SchemeGroupVersion,
+		},
+		{
+			name:       "v1 request",
+			data:       encodedRequest(t, "credentialprovider.kubelet.k8s.io/v1", "test.registry.io/foo/bar"),
+			expectedGV: credentialproviderv1.SchemeGroupVersion,
+		},
+		{
+			name: "v1 request with unknown fields is tolerated",
+			data: []byte(`{"apiVersion":"credentialprovider.kubelet.k8s.io/v1","kind":"CredentialProviderRequest","image":"test.registry.io/foo/bar","fieldFromTheFuture":true}`),
+
+			expectedGV: credentialproviderv1.SchemeGroupVersion,
+		},
+		{
+			name:      "unexpected kind",
+			data:      []byte(`{"apiVersion":"credentialprovider.kubelet.k8s.io/v1","kind":"CredentialProviderResponse"}`),
+			expectErr: `unexpected Kind: "CredentialProviderResponse"`,
+		},
+		{
+			name:      "unsupported version",
+			data:      []byte(`{"apiVersion":"credentialprovider.kubelet.k8s.io/v2","kind":"CredentialProviderRequest"}`),
+			expectErr: "no kind",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			request, gv, err := DecodeRequest(testcase.data)
+			if len(testcase.expectErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), testcase.expectErr) {
+					t.Fatalf("expected error containing %q, got: %v", testcase.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gv != testcase.expectedGV {
+				t.Errorf("expected group version %s, got %s", testcase.expectedGV, gv)
+			}
+			if request.Image != "test.registry.io/foo/bar" {
+				t.Errorf("unexpected image in decoded request: %q", request.Image)
+			}
+		})
+	}
+}
+
+func Test_EncodeResponse(t *testing.T) {
+	response := &credentialprovider.CredentialProviderResponse{
+		CacheKeyType: credentialprovider.RegistryPluginCacheKeyType,
+		Auth: map[string]credentialprovider.AuthConfig{
+			"test.registry.io": {Username: "user", Password: "password"},
+		},
+	}
+
+	for _, gv := range []schema.GroupVersion{
+		credentialproviderv1alpha1.SchemeGroupVersion,
+		credentialproviderv1beta1.SchemeGroupVersion,
+		credentialproviderv1.SchemeGroupVersion,
+	} {
+		t.Run(gv.Version, func(t *testing.T) {
+			data, err := EncodeResponse(response, gv)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			encoded := struct {
+				APIVersion string `json:"apiVersion"`
+				Kind       string `json:"kind"`
+			}{}
+			if err := json.Unmarshal(data, &encoded); err != nil {
+				t.Fatalf("unexpected error unmarshalling encoded response: %v", err)
+			}
+			if encoded.APIVersion != gv.String() {
+				t.Errorf("expected apiVersion %s, got %s", gv.String(), encoded.APIVersion)
+			}
+			if encoded.Kind != "CredentialProviderResponse" {
+				t.Errorf("expected kind CredentialProviderResponse, got %s", encoded.Kind)
+			}
+		})
+	}
+
+	t.Run("unsupported version", func(t *testing.T) {
+		if _, err := EncodeResponse(response, schema.GroupVersion{Group: credentialprovider.GroupName, Version: "v2"}); err == nil {
+			t.Fatal("expected error encoding to an unsupported version, got none")
+		}
+	})
+}
+
+func Test_serve(t *testing.T) {
+	in := bytes.NewReader(encodedRequest(t, "credentialprovider.kubelet.k8s.io/v1beta1", "test.registry.io/foo/bar"))
+	out := &bytes.Buffer{}
+
+	if err := serve(context.Background(), &staticProvider{username: "user", password: "password"}, in, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	response := struct {
+		APIVersion string `json:"apiVersion"`
+		Auth       map[string]struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		} `json:"auth"`
+	}{}
+	if err := json.Unmarshal(out.Bytes(), &response); err != nil {
+		t.Fatalf("unexpected error unmarshalling response: %v", err)
+	}
+	// The response must echo the request's API version.
+	if response.APIVersion != "credentialprovider.kubelet.k8s.io/v1beta1" {
+		t.Errorf("expected apiVersion credentialprovider.kubelet.k8s.io/v1beta1, got %s", response.APIVersion)
+	}
+	auth, ok := response.Auth["test.registry.io/foo/bar"]
+	if !ok {
+		t.Fatalf("expected auth entry for the requested image, got: %v", response.Auth)
+	}
+	if auth.Username != "user" || auth.Password != "password" {
+		t.Errorf("unexpected credentials in response: %+v", auth)
+	}
+}
+
+func Test_serve_providerError(t *testing.T) {
+	failingProvider := CredentialProviderFunc(func(_ context.Context, _ *credentialprovider.CredentialProviderRequest) (*credentialprovider.CredentialProviderResponse, error) {
+		return nil, errors.New("registry token endpoint unavailable")
+	})
+
+	in := bytes.NewReader(encodedRequest(t, "credentialprovider.kubelet.k8s.io/v1", "test.registry.io/foo/bar"))
+	out := &bytes.Buffer{}
+
+	err := serve(context.Background(), failingProvider, in, out)
+	if err == nil || !strings.Contains(err.Error(), "registry token endpoint unavailable") {
+		t.Fatalf("expected the provider error to be returned, got: %v", err)
+	}
+	if out.Len() > 0 {
+		t.Errorf("expected no response on error, got: %q", out.String())
+	}
+}
+
+func Test_serveDaemon(t *testing.T) {
+	in := &bytes.Buffer{}
+	for _, image := range []string{"test.registry.io/foo", "test.registry.io/bar"} {
+		if err := writeFrame(in, encodedRequest(t, "credentialprovider.kubelet.k8s.io/v1", image)); err != nil {
+			t.Fatalf("unexpected error writing request frame: %v", err)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	if err := serveDaemon(context.Background(), &staticProvider{username: "user", password: "password"}, in, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, image := range []string{"test.registry.io/foo", "test.registry.io/bar"} {
+		frame, err := readFrame(out)
+		if err != nil {
+			t.Fatalf("unexpected error reading response frame: %v", err)
+		}
+
+		response := struct {
+			Auth map[string]struct {
+				Username string `json:"username"`
+			} `json:"auth"`
+		}{}
+		if err := json.Unmarshal(frame, &response); err != nil {
+			t.Fatalf("unexpected error unmarshalling response: %v", err)
+		}
+		if _, ok := response.Auth[image]; !ok {
+			t.Errorf("expected auth entry for %s, got: %v", image, response.Auth)
+		}
+	}
+	if out.Len() > 0 {
+		t.Errorf("unexpected trailing data after the response frames: %q", out.String())
+	}
+}